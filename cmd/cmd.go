@@ -51,6 +51,7 @@ func init() {
 	CmdNDNd.AddCommand(tools.CmdPingServer())
 	CmdNDNd.AddCommand(tools.CmdCatChunks())
 	CmdNDNd.AddCommand(tools.CmdPutChunks())
+	CmdNDNd.AddCommand(tools.CmdDump())
 }
 
 // (AI GENERATED DESCRIPTION): Creates the top‑level “fw” command for managing the NDN Forwarding Daemon, adding a “run” subcommand to start the daemon and a set of “nfdc” control subcommands for configuring it.
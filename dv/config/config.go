@@ -9,8 +9,23 @@ import (
 	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
 )
 
-// CostInfinity is the maximum cost to a router.
-const CostInfinity = uint64(16)
+// DefaultCostInfinity is the default maximum cost to a router.
+const DefaultCostInfinity = uint64(16)
+
+// DefaultRttInfinity is the RTT of a path at which a router is unreachable,
+// which gives the default maximum cost to a router when link costs are RTTs.
+const DefaultRttInfinity = 16 * time.Second
+
+// MinRttInfinity is the minimum RTT of a path at which a router is unreachable
+// when link costs are RTTs, so that slow links do not make routers unreachable.
+const MinRttInfinity = time.Second
+
+// MaxNetworkNameLength is the maximum number of components of the network name,
+// which must match the #network alternatives of the trust schema.
+const MaxNetworkNameLength = 8
+
+// DefaultLinkCost is the cost of a link without a static or measured cost.
+const DefaultLinkCost = uint64(1)
 
 // CostPfxInfinity is the maximum cost to a name prefix.
 const CostPfxInfinity = uint64(0xFFFFFFFF)
@@ -31,6 +46,10 @@ type Config struct {
 	Network string `json:"network"`
 	// Router should be unique for each router in the network.
 	Router string `json:"router"`
+	// Area of the router, or empty if the network is not divided into areas.
+	Area string `json:"area"`
+	// Prefixes exported instead of the prefixes they cover in the summary of the area.
+	AreaSummary []string `json:"area_summary"`
 	// Period of sending Advertisement Sync Interests.
 	AdvertisementSyncInterval_ms uint64 `json:"advertise_interval"`
 	// Time after which a neighbor is considered dead.
@@ -41,11 +60,22 @@ type Config struct {
 	TrustAnchors []string `json:"trust_anchors"`
 	// List of permanent neighbors.
 	Neighbors []Neighbor `json:"neighbors"`
+	// Cost at which a router is unreachable. Must be the same for all routers.
+	// If zero, the default depends on whether link costs are RTTs.
+	CostInfinity uint64 `json:"cost_infinity"`
+	// RTT corresponding to a link cost of one, or zero to disable RTT costs.
+	RttCostUnit_ms uint64 `json:"rtt_cost_unit"`
 
 	// Parsed Global Prefix
 	networkNameN enc.Name
 	// Parsed Router Prefix
 	routerNameN enc.Name
+	// Prefix of all area names
+	areaPfxN enc.Name
+	// Area Name
+	areaNameN enc.Name
+	// Summary prefixes of the area
+	areaSummaryN []enc.Name
 	// Advertisement Sync Prefix
 	advSyncPfxN enc.Name
 	// Advertisement Sync Prefix (Active)
@@ -54,6 +84,8 @@ type Config struct {
 	advSyncPassivePfxN enc.Name
 	// Advertisement Data Prefix
 	advDataPfxN enc.Name
+	// Link Probe Prefix
+	probePfxN enc.Name
 	// Prefix Table Sync Prefix
 	pfxSyncGroupPfxN enc.Name
 	// NLSR readvertise prefix
@@ -67,6 +99,8 @@ type Neighbor struct {
 	Uri string `json:"uri"`
 	// MTU of the link face.
 	Mtu uint64 `json:"mtu"`
+	// Static cost of the link, or zero to use the default cost.
+	Cost uint64 `json:"cost"`
 
 	// FaceId of the neighbor.
 	FaceId uint64 `json:"-"`
@@ -82,6 +116,8 @@ func DefaultConfig() *Config {
 		AdvertisementSyncInterval_ms: 5000,
 		RouterDeadInterval_ms:        30000,
 		KeyChainUri:                  "undefined",
+		CostInfinity:                 0, // default
+		RttCostUnit_ms:               0,
 	}
 }
 
@@ -103,9 +139,9 @@ func (c *Config) Parse() (err error) {
 		return err
 	}
 
-	// Max 3 components in network name due to the trust schema
-	if len(c.networkNameN) > 3 {
-		return fmt.Errorf("network name can have at most 3 components")
+	// The trust schema only matches network names up to a maximum length
+	if len(c.networkNameN) > MaxNetworkNameLength {
+		return fmt.Errorf("network name can have at most %d components", MaxNetworkNameLength)
 	}
 
 	// Make sure router is in the network
@@ -118,6 +154,25 @@ func (c *Config) Parse() (err error) {
 		return fmt.Errorf("router name must be exactly one component longer than network name")
 	}
 
+	// Area names are not router names
+	c.areaPfxN = c.networkNameN.
+		Append(enc.NewKeywordComponent("AREA"))
+	c.areaNameN = c.areaPfxN
+	if c.Area != "" {
+		c.areaNameN = c.areaPfxN.Append(enc.NewGenericComponent(c.Area))
+	}
+	if c.IsAreaName(c.routerNameN) {
+		return fmt.Errorf("router name cannot be an area name")
+	}
+	c.areaSummaryN = make([]enc.Name, 0, len(c.AreaSummary))
+	for _, prefix := range c.AreaSummary {
+		name, err := enc.NameFromStr(prefix)
+		if err != nil {
+			return err
+		}
+		c.areaSummaryN = append(c.areaSummaryN, name)
+	}
+
 	// Validate intervals are not too short
 	if c.AdvertisementSyncInterval() < 1*time.Second {
 		return fmt.Errorf("AdvertisementSyncInterval must be at least 1 second")
@@ -128,6 +183,25 @@ func (c *Config) Parse() (err error) {
 		return fmt.Errorf("RouterDeadInterval must be at least 2*AdvertisementSyncInterval")
 	}
 
+	// Validate link costs
+	if c.CostInfinity == 0 {
+		c.CostInfinity = DefaultCostInfinity
+		if unit := c.RttCostUnit(); unit > 0 {
+			c.CostInfinity = max(DefaultCostInfinity, uint64(DefaultRttInfinity/unit))
+		}
+	}
+	if c.CostInfinity < 2 {
+		return fmt.Errorf("CostInfinity must be at least 2")
+	}
+	if unit := c.RttCostUnit(); unit > 0 && c.CostInfinity < uint64(MinRttInfinity/unit) {
+		return fmt.Errorf("CostInfinity must be at least %d, the cost of an RTT of %s", uint64(MinRttInfinity/unit), MinRttInfinity)
+	}
+	for _, neighbor := range c.Neighbors {
+		if neighbor.Cost >= c.CostInfinity {
+			return fmt.Errorf("cost of neighbor %s must be less than CostInfinity", neighbor.Uri)
+		}
+	}
+
 	// Validate trust anchors
 	c.trustAnchorsN = make([]enc.Name, 0, len(c.TrustAnchors))
 	for _, anchor := range c.TrustAnchors {
@@ -151,6 +225,10 @@ func (c *Config) Parse() (err error) {
 		Append(c.routerNameN...).
 		Append(enc.NewKeywordComponent("DV")).
 		Append(enc.NewKeywordComponent("ADV"))
+	c.probePfxN = enc.LOCALHOP.
+		Append(c.routerNameN...).
+		Append(enc.NewKeywordComponent("DV")).
+		Append(enc.NewKeywordComponent("PRB"))
 
 	// Prefix table sync prefix
 	c.pfxSyncGroupPfxN = c.networkNameN.
@@ -174,6 +252,28 @@ func (c *Config) RouterName() enc.Name {
 	return c.routerNameN
 }
 
+// AreaName returns the name of the area of the router. Routers without
+// an area are all in the same area, named by the prefix of area names.
+func (c *Config) AreaName() enc.Name {
+	return c.areaNameN
+}
+
+// AreaSummaryNames returns the prefixes that replace the prefixes they cover
+// in the summary of the area exported by border routers.
+func (c *Config) AreaSummaryNames() []enc.Name {
+	return c.areaSummaryN
+}
+
+// AreaPrefix returns the prefix of all area names.
+func (c *Config) AreaPrefix() enc.Name {
+	return c.areaPfxN
+}
+
+// IsAreaName checks if a destination is an area instead of a router.
+func (c *Config) IsAreaName(name enc.Name) bool {
+	return c.areaPfxN.IsPrefix(name)
+}
+
 // (AI GENERATED DESCRIPTION): Retrieves and returns the advertisement sync prefix stored in the configuration as an `enc.Name`.
 func (c *Config) AdvertisementSyncPrefix() enc.Name {
 	return c.advSyncPfxN
@@ -194,6 +294,11 @@ func (c *Config) AdvertisementDataPrefix() enc.Name {
 	return c.advDataPfxN
 }
 
+// LinkProbePrefix returns the prefix of the link probes answered by this router.
+func (c *Config) LinkProbePrefix() enc.Name {
+	return c.probePfxN
+}
+
 // (AI GENERATED DESCRIPTION): Retrieves the prefix table group prefix stored in the configuration.
 func (c *Config) PrefixTableGroupPrefix() enc.Name {
 	return c.pfxSyncGroupPfxN
@@ -214,6 +319,12 @@ func (c *Config) RouterDeadInterval() time.Duration {
 	return time.Duration(c.RouterDeadInterval_ms) * time.Millisecond
 }
 
+// RttCostUnit returns the RTT corresponding to a link cost of one,
+// or zero if link costs are not derived from the RTT.
+func (c *Config) RttCostUnit() time.Duration {
+	return time.Duration(c.RttCostUnit_ms) * time.Millisecond
+}
+
 // (AI GENERATED DESCRIPTION): Returns the slice of trust‑anchor names stored in the Config.
 func (c *Config) TrustAnchorNames() []enc.Name {
 	return c.trustAnchorsN
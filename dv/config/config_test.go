@@ -0,0 +1,107 @@
+package config_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/named-data/ndnd/dv/config"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/security/trust_schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testConfig returns a valid configuration of a router.
+func testConfig() *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.Network = "/ndn"
+	cfg.Router = "/ndn/router"
+	return cfg
+}
+
+func TestCostInfinity(t *testing.T) {
+	tests := []struct {
+		desc     string
+		infinity uint64
+		unit     uint64
+		expected uint64 // or zero if the configuration is invalid
+	}{
+		{"default", 0, 0, config.DefaultCostInfinity},
+		{"static", 32, 0, 32},
+		{"too small", 1, 0, 0},
+		{"default with RTT costs", 0, 10, 1600},
+		{"default with coarse RTT costs", 0, 2000, config.DefaultCostInfinity},
+		{"static with RTT costs", 500, 2, 500},
+		{"too small for RTT costs", 16, 10, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := testConfig()
+			cfg.CostInfinity = test.infinity
+			cfg.RttCostUnit_ms = test.unit
+			err := cfg.Parse()
+			if test.expected == 0 {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.expected, cfg.CostInfinity)
+			}
+		})
+	}
+}
+
+func TestNetworkName(t *testing.T) {
+	tests := []struct {
+		desc    string
+		network string
+		router  string
+		valid   bool
+	}{
+		{"one component", "/ndn", "/ndn/router", true},
+		{"maximum length", "/a/b/c/d/e/f/g/h", "/a/b/c/d/e/f/g/h/router", true},
+		{"too long", "/a/b/c/d/e/f/g/h/i", "/a/b/c/d/e/f/g/h/i/router", false},
+		{"router not in network", "/ndn", "/other/router", false},
+		{"router too long", "/ndn", "/ndn/site/router", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := testConfig()
+			cfg.Network = test.network
+			cfg.Router = test.router
+			assert.Equal(t, test.valid, cfg.Parse() == nil)
+		})
+	}
+}
+
+func TestSchemaNetworkLength(t *testing.T) {
+	schema, err := trust_schema.NewLvsSchema(config.SchemaBytes)
+	require.NoError(t, err)
+
+	network := enc.Name{}
+	for i := range config.MaxNetworkNameLength {
+		network = network.Append(enc.NewGenericComponent(fmt.Sprintf("n%d", i)))
+		router := network.Append(enc.NewGenericComponent("router"))
+		key := enc.Name{
+			enc.NewGenericComponent("KEY"),
+			enc.NewGenericComponent("id"),
+			enc.NewGenericComponent("issuer"),
+			enc.NewVersionComponent(1),
+		}
+		networkCert := network.Append(key...)
+		routerCert := router.Append(enc.NewKeywordComponent("DV")).Append(key...)
+		advData := enc.LOCALHOP.Append(router...).
+			Append(enc.NewKeywordComponent("DV")).
+			Append(enc.NewKeywordComponent("ADV")).
+			Append(enc.NewVersionComponent(1))
+
+		// Network names of every length up to the maximum are in the schema
+		assert.True(t, schema.Check(routerCert, networkCert), "network %s", network)
+		assert.True(t, schema.Check(advData, routerCert), "network %s", network)
+
+		// Certificates of other networks cannot sign router certificates
+		other := network[:i].Append(enc.NewGenericComponent("other")).Append(key...)
+		assert.False(t, schema.Check(routerCert, other), "network %s", network)
+	}
+}
@@ -23,7 +23,7 @@ func (a *advertModule) generate() {
 		WithVersion(a.seq)
 	name, err := a.dv.client.Produce(ndn.ProduceArgs{
 		Name:            name,
-		Content:         a.dv.rib.Advert().Encode(),
+		Content:         a.dv.advertisement().Encode(),
 		FreshnessPeriod: 10 * time.Second,
 	})
 	if err != nil {
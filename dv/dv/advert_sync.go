@@ -161,6 +161,10 @@ func (a *advertModule) onStateVector(sv *spec_svs.StateVector, faceId uint64, ac
 		if err != nil {
 			log.Warn(a, "Failed to update neighbor", "err", err)
 		}
+		if faceDirty {
+			// The cost of the link may be different on the new face
+			go a.dv.updateRib(ns)
+		}
 		fibDirty = fibDirty || faceDirty
 	}
 
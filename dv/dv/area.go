@@ -0,0 +1,151 @@
+package dv
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/named-data/ndnd/dv/config"
+	"github.com/named-data/ndnd/dv/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+)
+
+// advertArea returns the area of the router that sent an advertisement.
+// Advertisements without an area are from routers without an area.
+func (dv *Router) advertArea(advert *tlv.Advertisement) enc.Name {
+	if advert.Area == nil || advert.Area.Name == nil {
+		return dv.config.AreaPrefix()
+	}
+	return advert.Area.Name
+}
+
+// sameArea checks if a neighbor is in the area of the router.
+func (dv *Router) sameArea(advert *tlv.Advertisement) bool {
+	return dv.advertArea(advert).Equal(dv.config.AreaName())
+}
+
+// isBorder checks if the router has a neighbor in another area.
+func (dv *Router) isBorder() bool {
+	for _, ns := range dv.neighbors.GetAll() {
+		if ns.Advert != nil && !dv.sameArea(ns.Advert) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateSummaries computes the prefix summaries of all areas.
+// The summary of our own area is computed only by border routers, from the
+// prefixes of the routers in the area. The summaries of other areas are
+// taken from the advertisement of the best next hop to the area.
+// The caller must hold the router mutex.
+// Return => true if any summary has changed
+func (dv *Router) updateSummaries() bool {
+	summaries := make(map[uint64][]enc.Name)
+
+	if dv.isBorder() {
+		prefixes := make([]enc.Name, 0)
+		for router := range dv.pfx.Routers() {
+			if !dv.rib.Has(router.Name) {
+				continue
+			}
+			for _, entry := range router.Prefixes {
+				if entry.Cost < config.CostPfxInfinity {
+					prefixes = append(prefixes, entry.Name)
+				}
+			}
+		}
+		summaries[dv.config.AreaName().Hash()] = aggregate(prefixes, dv.config.AreaSummaryNames())
+	}
+
+	for hash, entry := range dv.rib.Entries() {
+		if !dv.config.IsAreaName(entry.Name()) || len(entry.NextHops()) == 0 {
+			continue
+		}
+		ns := dv.neighbors.GetH(entry.NextHops()[0].Neighbor)
+		if ns == nil || ns.Advert == nil {
+			continue
+		}
+		for _, adv := range ns.Advert.Entries {
+			if adv.Destination.Name.Equal(entry.Name()) {
+				prefixes := make([]enc.Name, 0, len(adv.Prefixes))
+				for _, prefix := range adv.Prefixes {
+					prefixes = append(prefixes, prefix.Name)
+				}
+				summaries[hash] = prefixes
+				break
+			}
+		}
+	}
+
+	dirty := !maps.EqualFunc(dv.summaries, summaries, func(a, b []enc.Name) bool {
+		return slices.EqualFunc(a, b, enc.Name.Equal)
+	})
+	dv.summaries = summaries
+	return dirty
+}
+
+// advertisement computes the advertisement of the router from the RIB,
+// with the prefix summaries of all areas.
+// The caller must hold the router mutex.
+func (dv *Router) advertisement() *tlv.Advertisement {
+	advert := dv.rib.Advert()
+	advert.Area = &tlv.Destination{Name: dv.config.AreaName()}
+
+	for _, entry := range advert.Entries {
+		if dv.config.IsAreaName(entry.Destination.Name) {
+			entry.Prefixes = destinations(dv.summaries[entry.Destination.Name.Hash()])
+		}
+	}
+
+	// Border routers originate the summary of their own area
+	if prefixes, ok := dv.summaries[dv.config.AreaName().Hash()]; ok {
+		advert.Entries = append(advert.Entries, &tlv.AdvEntry{
+			Destination: &tlv.Destination{Name: dv.config.AreaName()},
+			NextHop:     &tlv.Destination{Name: dv.config.RouterName()},
+			Cost:        0,
+			OtherCost:   dv.config.CostInfinity,
+			Prefixes:    destinations(prefixes),
+		})
+	}
+
+	return advert
+}
+
+// aggregate computes the summary of a list of prefixes. Prefixes covered by
+// a summary prefix are replaced by the shortest such summary prefix, so that
+// summary prefixes are only exported if they cover a reachable prefix.
+// The result is sorted, without the prefixes covered by a shorter prefix.
+func aggregate(prefixes []enc.Name, summary []enc.Name) []enc.Name {
+	aggregated := make([]enc.Name, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		for _, spfx := range summary {
+			if spfx.IsPrefix(prefix) && len(spfx) < len(prefix) {
+				prefix = spfx
+			}
+		}
+		aggregated = append(aggregated, prefix)
+	}
+
+	slices.SortFunc(aggregated, func(a, b enc.Name) int {
+		return a.Compare(b)
+	})
+
+	// Prefixes are contiguous with the names they cover in canonical order
+	result := make([]enc.Name, 0, len(aggregated))
+	for _, prefix := range aggregated {
+		if len(result) > 0 && result[len(result)-1].IsPrefix(prefix) {
+			continue
+		}
+		result = append(result, prefix)
+	}
+	return result
+}
+
+// destinations converts a list of names to destinations.
+func destinations(names []enc.Name) []*tlv.Destination {
+	dests := make([]*tlv.Destination, 0, len(names))
+	for _, name := range names {
+		dests = append(dests, &tlv.Destination{Name: name})
+	}
+	return dests
+}
@@ -0,0 +1,47 @@
+package dv
+
+import (
+	"testing"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/stretchr/testify/assert"
+)
+
+// names parses a list of names.
+func names(strs ...string) []enc.Name {
+	result := make([]enc.Name, 0, len(strs))
+	for _, str := range strs {
+		name, _ := enc.NameFromStr(str)
+		result = append(result, name)
+	}
+	return result
+}
+
+func TestAggregate(t *testing.T) {
+	tests := []struct {
+		desc     string
+		prefixes []enc.Name
+		summary  []enc.Name
+		expected []enc.Name
+	}{
+		{"empty", names(), names("/a"), names()},
+		{"sorted", names("/c", "/a", "/b"), names(), names("/a", "/b", "/c")},
+		{"covered", names("/a/b", "/a", "/a/c/d", "/b"), names(), names("/a", "/b")},
+		{"duplicates", names("/a", "/a", "/b"), names(), names("/a", "/b")},
+		{"summary", names("/a/b", "/a/c", "/b/c"), names("/a"), names("/a", "/b/c")},
+		{"summary not covering", names("/a/b", "/b"), names("/c", "/a/b/c"), names("/a/b", "/b")},
+		{"summary equal", names("/a", "/a/b"), names("/a"), names("/a")},
+		{"shortest summary", names("/a/b/c", "/a/b/d"), names("/a/b", "/a"), names("/a")},
+		{"several summaries", names("/a/1", "/b/1", "/b/2", "/c"), names("/a", "/b"), names("/a", "/b", "/c")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			result := aggregate(test.prefixes, test.summary)
+			assert.Len(t, result, len(test.expected), "got %v", result)
+			for i := range min(len(result), len(test.expected)) {
+				assert.True(t, test.expected[i].Equal(result[i]), "got %s, expected %s", result[i], test.expected[i])
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package dv
+
+import (
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	sig "github.com/named-data/ndnd/std/security/signer"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/named-data/ndnd/std/utils"
+)
+
+// probeNeighbors sends a link probe to each neighbor with a known face,
+// to derive the cost of the link from the RTT.
+func (dv *Router) probeNeighbors() {
+	dv.mutex.Lock()
+	names := make([]enc.Name, 0, dv.neighbors.Size())
+	for _, ns := range dv.neighbors.GetAll() {
+		if ns.FaceId() != 0 {
+			names = append(names, ns.Name)
+		}
+	}
+	dv.mutex.Unlock()
+
+	for _, name := range names {
+		if err := dv.probeNeighbor(name); err != nil {
+			log.Warn(dv, "Failed to send link probe", "neighbor", name, "err", err)
+		}
+	}
+}
+
+// probeNeighbor sends a link probe to a neighbor. The probe reaches the neighbor
+// through the route to its localhop prefix, and the Data comes back on the same link.
+func (dv *Router) probeNeighbor(nName enc.Name) error {
+	// /localhop/<neighbor>/32=DV/32=PRB/t=<now>
+	name := enc.LOCALHOP.
+		Append(nName...).
+		Append(enc.NewKeywordComponent("DV")).
+		Append(enc.NewKeywordComponent("PRB")).
+		Append(enc.NewTimestampComponent(uint64(time.Now().UnixMicro())))
+
+	cfg := &ndn.InterestConfig{
+		MustBeFresh: true,
+		Lifetime:    optional.Some(1 * time.Second),
+		Nonce:       utils.ConvertNonce(dv.engine.Timer().Nonce()),
+	}
+	interest, err := dv.engine.Spec().MakeInterest(name, cfg, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	return dv.engine.Express(interest, func(args ndn.ExpressCallbackArgs) {
+		if args.Result != ndn.InterestResultData {
+			log.Debug(dv, "Link probe failed", "neighbor", nName, "result", args.Result)
+			return
+		}
+		go dv.onProbeRtt(nName, time.Since(start))
+	})
+}
+
+// onProbeRtt updates the RTT of a neighbor, and the RIB if the cost of the link changes.
+func (dv *Router) onProbeRtt(nName enc.Name, rtt time.Duration) {
+	dv.mutex.Lock()
+	defer dv.mutex.Unlock()
+
+	ns := dv.neighbors.Get(nName)
+	if ns == nil {
+		return
+	}
+
+	if ns.RecvRtt(rtt) {
+		log.Info(dv, "Link cost changed", "neighbor", nName, "cost", ns.Cost(), "srtt", ns.Srtt())
+		go dv.updateRib(ns)
+	}
+}
+
+// onLinkProbe replies to a link probe from a neighbor.
+func (dv *Router) onLinkProbe(args ndn.InterestHandlerArgs) {
+	cfg := &ndn.DataConfig{
+		ContentType: optional.Some(ndn.ContentTypeBlob),
+	}
+	data, err := dv.engine.Spec().MakeData(args.Interest.Name(), cfg, nil, sig.NewSha256Signer())
+	if err != nil {
+		log.Warn(dv, "Failed to make link probe Data", "err", err)
+		return
+	}
+	args.Reply(data.Wire)
+}
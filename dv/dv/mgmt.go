@@ -1,6 +1,8 @@
 package dv
 
 import (
+	"cmp"
+	"slices"
 	"time"
 
 	"github.com/named-data/ndnd/dv/tlv"
@@ -8,6 +10,7 @@ import (
 	"github.com/named-data/ndnd/std/log"
 	"github.com/named-data/ndnd/std/ndn"
 	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	"github.com/named-data/ndnd/std/object"
 	sig "github.com/named-data/ndnd/std/security/signer"
 	"github.com/named-data/ndnd/std/types/optional"
 	"github.com/named-data/ndnd/std/utils"
@@ -24,6 +27,19 @@ func (dv *Router) mgmtOnInterest(args ndn.InterestHandlerArgs) {
 
 	log.Trace(dv, "Received management Interest", "name", name)
 
+	// Later segments of datasets are served from the store.
+	// We only use exact match here since RDR is unnecessary.
+	if segment, err := dv.client.Store().Get(name, false); err == nil && segment != nil {
+		args.Reply(enc.Wire{segment})
+		return
+	}
+
+	// Datasets are named /<module>/list
+	if len(name) == pfxLen+2 && name[pfxLen+1].String() == "list" {
+		dv.mgmtOnDataset(args)
+		return
+	}
+
 	switch name[pfxLen].String() {
 	case "status":
 		dv.mgmtOnStatus(args)
@@ -39,13 +55,17 @@ func (dv *Router) mgmtOnStatus(args ndn.InterestHandlerArgs) {
 	status := func() tlv.Status {
 		dv.mutex.Lock()
 		defer dv.mutex.Unlock()
+
 		return tlv.Status{
-			Version:     utils.NDNdVersion,
-			NetworkName: &tlv.Destination{Name: dv.config.NetworkName()},
-			RouterName:  &tlv.Destination{Name: dv.config.RouterName()},
-			NRibEntries: uint64(dv.rib.Size()),
-			NNeighbors:  uint64(dv.neighbors.Size()),
-			NFibEntries: uint64(dv.fib.Size()),
+			Version:      utils.NDNdVersion,
+			NetworkName:  &tlv.Destination{Name: dv.config.NetworkName()},
+			RouterName:   &tlv.Destination{Name: dv.config.RouterName()},
+			AreaName:     &tlv.Destination{Name: dv.config.AreaName()},
+			NRibEntries:  uint64(dv.rib.Size()),
+			NNeighbors:   uint64(dv.neighbors.Size()),
+			NFibEntries:  uint64(dv.fib.Size()),
+			CostInfinity: dv.config.CostInfinity,
+			Neighbors:    dv.neighborStatus(),
 		}
 	}()
 
@@ -64,6 +84,179 @@ func (dv *Router) mgmtOnStatus(args ndn.InterestHandlerArgs) {
 	args.Reply(data.Wire)
 }
 
+// Received dataset Interest. The dataset is produced as a segmented object
+// under a new version, and the first segment is sent in reply.
+func (dv *Router) mgmtOnDataset(args ndn.InterestHandlerArgs) {
+	name := args.Interest.Name()
+	module := name[len(name)-2].String()
+
+	dataset := func() enc.Wire {
+		dv.mutex.Lock()
+		defer dv.mutex.Unlock()
+
+		switch module {
+		case "rib":
+			return dv.ribStatus().Encode()
+		case "prefix":
+			return dv.prefixStatus().Encode()
+		case "neighbor":
+			status := tlv.NeighborsStatus{Neighbors: dv.neighborStatus()}
+			return status.Encode()
+		case "fib":
+			return dv.fibStatus().Encode()
+		default:
+			return nil
+		}
+	}()
+	if dataset == nil {
+		log.Warn(dv, "Unknown management dataset", "name", name)
+		return
+	}
+
+	objName, err := object.Produce(ndn.ProduceArgs{
+		Name:            name.WithVersion(enc.VersionUnixMicro),
+		Content:         dataset,
+		FreshnessPeriod: time.Millisecond,
+		NoMetadata:      true,
+	}, dv.client.Store(), sig.NewSha256Signer())
+	if err != nil {
+		log.Warn(dv, "Failed to produce management dataset", "err", err)
+		return
+	}
+	dv.mgmtDir.Push(objName)
+	dv.mgmtDir.Evict(dv.client)
+
+	segment, err := dv.client.Store().Get(objName.Append(enc.NewSegmentComponent(0)), false)
+	if err != nil || segment == nil {
+		log.Warn(dv, "Failed to get first segment of management dataset", "err", err)
+		return
+	}
+	args.Reply(enc.Wire{segment})
+}
+
+// Get the status of all neighbors, sorted by name.
+// The caller must hold the router mutex.
+func (dv *Router) neighborStatus() []*tlv.NeighborStatus {
+	neighbors := make([]*tlv.NeighborStatus, 0, dv.neighbors.Size())
+	for _, ns := range dv.neighbors.GetAll() {
+		var srtt optional.Optional[uint64]
+		if ns.Srtt() > 0 {
+			srtt = optional.Some(uint64(ns.Srtt().Microseconds()))
+		}
+		neighbors = append(neighbors, &tlv.NeighborStatus{
+			Name:        ns.Name,
+			FaceId:      ns.FaceId(),
+			Cost:        ns.Cost(),
+			Srtt_us:     srtt,
+			AdvertSeq:   ns.AdvertSeq,
+			LastSeen_ms: uint64(time.Since(ns.LastSeen()).Milliseconds()),
+			Alive:       !ns.IsDead(),
+		})
+	}
+	slices.SortFunc(neighbors, func(a, b *tlv.NeighborStatus) int {
+		return a.Name.Compare(b.Name)
+	})
+	return neighbors
+}
+
+// Get the reachable destinations in the RIB, with their next hops.
+// The caller must hold the router mutex.
+func (dv *Router) ribStatus() *tlv.RibStatus {
+	status := &tlv.RibStatus{}
+	for _, entry := range dv.rib.Entries() {
+		nextHops := make([]*tlv.RibNextHopStatus, 0, len(entry.NextHops()))
+		for _, hop := range entry.NextHops() {
+			var faceId uint64
+			if ns := dv.neighbors.GetH(hop.Neighbor); ns != nil {
+				faceId = ns.FaceId()
+			}
+			nextHops = append(nextHops, &tlv.RibNextHopStatus{
+				Neighbor: dv.rib.Neighbor(hop.Neighbor),
+				FaceId:   faceId,
+				Cost:     hop.Cost,
+			})
+		}
+		status.Entries = append(status.Entries, &tlv.RibEntryStatus{
+			Name:     entry.Name(),
+			Cost:     entry.Cost(),
+			NextHops: nextHops,
+		})
+	}
+	slices.SortFunc(status.Entries, func(a, b *tlv.RibEntryStatus) int {
+		return a.Name.Compare(b.Name)
+	})
+	return status
+}
+
+// Get the prefixes announced by each exit router in the area,
+// and the prefix summary of each other area.
+// The caller must hold the router mutex.
+func (dv *Router) prefixStatus() *tlv.PrefixStatus {
+	status := &tlv.PrefixStatus{}
+	for hash, entry := range dv.rib.Entries() {
+		if !dv.config.IsAreaName(entry.Name()) {
+			continue
+		}
+		prefixes := make([]*tlv.PrefixEntryStatus, 0, len(dv.summaries[hash]))
+		for _, prefix := range dv.summaries[hash] {
+			prefixes = append(prefixes, &tlv.PrefixEntryStatus{Name: prefix})
+		}
+		status.Routers = append(status.Routers, &tlv.PrefixRouterStatus{
+			ExitRouter: entry.Name(),
+			Prefixes:   prefixes,
+		})
+	}
+	for router := range dv.pfx.Routers() {
+		if len(router.Prefixes) == 0 {
+			continue
+		}
+		prefixes := make([]*tlv.PrefixEntryStatus, 0, len(router.Prefixes))
+		for _, entry := range router.Prefixes {
+			prefixes = append(prefixes, &tlv.PrefixEntryStatus{
+				Name: entry.Name,
+				Cost: entry.Cost,
+			})
+		}
+		slices.SortFunc(prefixes, func(a, b *tlv.PrefixEntryStatus) int {
+			return a.Name.Compare(b.Name)
+		})
+		status.Routers = append(status.Routers, &tlv.PrefixRouterStatus{
+			ExitRouter: router.Name,
+			Prefixes:   prefixes,
+		})
+	}
+	slices.SortFunc(status.Routers, func(a, b *tlv.PrefixRouterStatus) int {
+		return a.ExitRouter.Compare(b.ExitRouter)
+	})
+	return status
+}
+
+// Get the next hops installed in the forwarder for each prefix.
+// The caller must hold the router mutex.
+func (dv *Router) fibStatus() *tlv.FibStatus {
+	status := &tlv.FibStatus{}
+	for name, entries := range dv.fib.Entries() {
+		nextHops := make([]*tlv.FibNextHopStatus, 0, len(entries))
+		for _, entry := range entries {
+			nextHops = append(nextHops, &tlv.FibNextHopStatus{
+				FaceId: entry.FaceId,
+				Cost:   entry.Cost,
+			})
+		}
+		slices.SortFunc(nextHops, func(a, b *tlv.FibNextHopStatus) int {
+			return cmp.Or(cmp.Compare(a.Cost, b.Cost), cmp.Compare(a.FaceId, b.FaceId))
+		})
+		status.Entries = append(status.Entries, &tlv.FibEntryStatus{
+			Name:     name,
+			NextHops: nextHops,
+		})
+	}
+	slices.SortFunc(status.Entries, func(a, b *tlv.FibEntryStatus) int {
+		return a.Name.Compare(b.Name)
+	})
+	return status
+}
+
 // Received advertisement Interest
 func (dv *Router) mgmtOnRib(args ndn.InterestHandlerArgs) {
 	res := &mgmt.ControlResponse{
@@ -129,6 +322,11 @@ func (dv *Router) mgmtOnRib(args ndn.InterestHandlerArgs) {
 		return
 	}
 
+	// Border routers export the prefixes of the area
+	if dv.updateSummaries() {
+		go dv.advert.generate()
+	}
+
 	res.Val.StatusCode = 200
 	res.Val.StatusText = "Readvertise command successful"
 	res.Val.Params = &mgmt.ControlArgs{
@@ -46,6 +46,8 @@ type Router struct {
 
 	// advertisement module
 	advert advertModule
+	// management datasets
+	mgmtDir *storage.MemoryFifoDir
 
 	// prefix table
 	pfx *table.PrefixTable
@@ -60,6 +62,8 @@ type Router struct {
 	rib *table.Rib
 	// forwarding table
 	fib *table.Fib
+	// area hash -> prefix summary
+	summaries map[uint64][]enc.Name
 }
 
 // Create a new DV router.
@@ -104,6 +108,8 @@ func NewRouter(config *config.Config, engine ndn.Engine) (*Router, error) {
 		client: object.NewClient(engine, store, trust),
 		nfdc:   nfdc.NewNfdMgmtThread(engine),
 		mutex:  sync.Mutex{},
+
+		mgmtDir: storage.NewMemoryFifoDir(16), // keep last few datasets
 	}
 
 	// Initialize advertisement module
@@ -156,6 +162,12 @@ func (dv *Router) Start() (err error) {
 	go dv.nfdc.Start()
 	defer dv.nfdc.Stop()
 
+	// Subscribe to face events of the forwarder
+	faceEvents := mgmt.SubscribeFaceEvents(dv.engine, func(event *mgmt.FaceEventNotificationValue) {
+		go dv.onFaceEvent(event)
+	})
+	defer faceEvents.Stop()
+
 	// Configure face
 	if err = dv.configureFace(); err != nil {
 		return err
@@ -171,7 +183,7 @@ func (dv *Router) Start() (err error) {
 	defer dv.pfxSvs.Stop()
 
 	// Add self to the RIB and make initial advertisement
-	dv.rib.Set(dv.config.RouterName(), dv.config.RouterName(), 0)
+	dv.rib.Set(dv.config.RouterName(), dv.config.RouterName(), 0, 0)
 	dv.advert.generate()
 
 	// Initialize prefix table
@@ -181,6 +193,9 @@ func (dv *Router) Start() (err error) {
 		select {
 		case <-dv.heartbeat.C:
 			dv.advert.sendSyncInterest()
+			if dv.config.RttCostUnit() > 0 {
+				dv.probeNeighbors()
+			}
 		case <-dv.deadcheck.C:
 			dv.checkDeadNeighbors()
 		case <-dv.stop:
@@ -230,6 +245,15 @@ func (dv *Router) register() (err error) {
 		return err
 	}
 
+	// Link probes from neighbors
+	err = dv.engine.AttachHandler(dv.config.LinkProbePrefix(),
+		func(args ndn.InterestHandlerArgs) {
+			go dv.onLinkProbe(args)
+		})
+	if err != nil {
+		return err
+	}
+
 	// Router management
 	err = dv.engine.AttachHandler(dv.config.MgmtPrefix(),
 		func(args ndn.InterestHandlerArgs) {
@@ -243,6 +267,7 @@ func (dv *Router) register() (err error) {
 	pfxs := []enc.Name{
 		dv.config.AdvertisementSyncPrefix(),
 		dv.config.AdvertisementDataPrefix(),
+		dv.config.LinkProbePrefix(),
 		dv.pfxSvs.SyncPrefix(),
 		dv.pfxSvs.DataPrefix(),
 		dv.config.MgmtPrefix(),
@@ -342,6 +367,30 @@ func (dv *Router) destroyFaces() {
 	}
 }
 
+// onFaceEvent removes neighbors as soon as their face goes down or is destroyed.
+func (dv *Router) onFaceEvent(event *mgmt.FaceEventNotificationValue) {
+	if event.FaceEventKind != mgmt.FaceEventDown && event.FaceEventKind != mgmt.FaceEventDestroyed {
+		return
+	}
+
+	dv.mutex.Lock()
+	if event.FaceEventKind == mgmt.FaceEventDestroyed {
+		// The face no longer exists, so there is nothing to clean up at exit
+		for i := range dv.config.Neighbors {
+			if dv.config.Neighbors[i].FaceId == event.FaceId {
+				dv.config.Neighbors[i].FaceId = 0
+				dv.config.Neighbors[i].Created = false
+			}
+		}
+	}
+	down := dv.neighbors.FaceDown(event.FaceId)
+	dv.mutex.Unlock()
+
+	if down {
+		dv.checkDeadNeighbors()
+	}
+}
+
 // (AI GENERATED DESCRIPTION): Initializes the router’s prefix table by creating a subscription map, setting up an SVS synchronization agent (with snapshot support) for publishing updates, and constructing a local prefix table that forwards changes to the SVS.
 func (dv *Router) createPrefixTable() {
 	// Subscription list
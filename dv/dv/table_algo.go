@@ -1,7 +1,6 @@
 package dv
 
 import (
-	"github.com/named-data/ndnd/dv/config"
 	"github.com/named-data/ndnd/dv/table"
 	enc "github.com/named-data/ndnd/std/encoding"
 	"github.com/named-data/ndnd/std/log"
@@ -26,40 +25,58 @@ func (dv *Router) updateRib(ns *table.NeighborState) {
 		return
 	}
 
-	// TODO: use cost to neighbor
-	localCost := uint64(1)
+	// Cost of the link to the neighbor
+	localCost := ns.Cost()
 
 	// Trigger our own advertisement if needed
 	var dirty bool = false
 
+	// Prefix sync is limited to the area, and routers in other
+	// areas are only reachable through the summary of their area
+	sameArea := dv.sameArea(ns.Advert)
+	ns.SetPrefixSync(sameArea)
+
 	// Reset destinations for this neighbor
 	dv.rib.DirtyResetNextHop(ns.Name)
 
 	for _, entry := range ns.Advert.Entries {
+		// Skip our own area and routers in other areas
+		if dv.config.IsAreaName(entry.Destination.Name) {
+			if entry.Destination.Name.Equal(dv.config.AreaName()) {
+				continue
+			}
+		} else if !sameArea {
+			continue
+		}
+
 		// Use the advertised cost by default
 		cost := entry.Cost + localCost
 
 		// Poison reverse - try other cost if next hop is us
 		if entry.NextHop.Name.Equal(dv.config.RouterName()) {
-			if entry.OtherCost < config.CostInfinity {
+			if entry.OtherCost < dv.config.CostInfinity {
 				cost = entry.OtherCost + localCost
 			} else {
-				cost = config.CostInfinity
+				cost = dv.config.CostInfinity
 			}
 		}
 
 		// Skip unreachable destinations
-		if cost >= config.CostInfinity {
+		if cost >= dv.config.CostInfinity {
 			continue
 		}
 
-		// Check advertisement changes
-		dirty = dv.rib.Set(entry.Destination.Name, ns.Name, cost) || dirty
+		// Check advertisement changes. The cost advertised by the neighbor
+		// is needed to check if the neighbor is a loop-free next hop.
+		dirty = dv.rib.Set(entry.Destination.Name, ns.Name, cost, entry.Cost) || dirty
 	}
 
 	// Drop dead entries
 	dirty = dv.rib.Prune() || dirty
 
+	// Summaries may change with the RIB or the advertisement
+	dirty = dv.updateSummaries() || dirty
+
 	// If advert changed, increment sequence number
 	if dirty {
 		go dv.postUpdateRib()
@@ -85,6 +102,7 @@ func (dv *Router) checkDeadNeighbors() {
 			dirty = dv.rib.Prune() || dirty
 		}
 	}
+	dirty = dv.updateSummaries() || dirty
 
 	if dirty {
 		go dv.postUpdateRib()
@@ -124,6 +142,14 @@ func (dv *Router) updateFib() {
 		// Get FIB entry to reach this router
 		fes := dv.rib.GetFibEntries(dv.neighbors, hash)
 
+		// Add entries to all prefixes in the summary of an area
+		if dv.config.IsAreaName(router.Name()) {
+			for _, prefix := range dv.summaries[hash] {
+				register(prefix, fes, 0)
+			}
+			continue
+		}
+
 		// Add entry for the router's prefix sync group prefix
 		proute := dv.config.PrefixTableGroupPrefix().
 			Append(router.Name()...)
@@ -154,7 +180,7 @@ func (dv *Router) updatePrefixSubs() {
 
 	// Get all prefixes from the RIB
 	for hash, router := range dv.rib.Entries() {
-		if router.Name().Equal(dv.config.RouterName()) {
+		if router.Name().Equal(dv.config.RouterName()) || dv.config.IsAreaName(router.Name()) {
 			continue
 		}
 
@@ -170,6 +196,11 @@ func (dv *Router) updatePrefixSubs() {
 				if dirty := dv.pfx.Apply(sp.Content); dirty {
 					// Update the local fib if prefix table changed
 					go dv.updateFib() // expensive
+
+					// Border routers export the prefixes of the area
+					if dv.updateSummaries() {
+						go dv.advert.generate()
+					}
 				}
 			})
 		}
@@ -1,6 +1,8 @@
 package table
 
 import (
+	"iter"
+
 	"github.com/named-data/ndnd/dv/config"
 	"github.com/named-data/ndnd/dv/nfdc"
 	enc "github.com/named-data/ndnd/std/encoding"
@@ -21,19 +23,17 @@ type FibEntry struct {
 // router should be hash of the router name.
 func (rib *Rib) GetFibEntries(nt *NeighborTable, router uint64) (entries []FibEntry) {
 	ribEntry := rib.entries[router]
-	entries = make([]FibEntry, 0, 2)
-
-	if ns := nt.GetH(ribEntry.nextHop1); ns != nil && ribEntry.lowest1 < config.CostInfinity {
-		entries = append(entries, FibEntry{
-			FaceId: ns.faceId,
-			Cost:   ribEntry.lowest1,
-		})
-	}
-	if ns := nt.GetH(ribEntry.nextHop2); ns != nil && ribEntry.lowest2 < config.CostInfinity {
-		entries = append(entries, FibEntry{
-			FaceId: ns.faceId,
-			Cost:   ribEntry.lowest2,
-		})
+	entries = make([]FibEntry, 0, len(ribEntry.nextHops))
+
+	// All loop-free next hops are installed, the forwarding strategy
+	// chooses between them based on the cost.
+	for _, hop := range ribEntry.nextHops {
+		if ns := nt.GetH(hop.Neighbor); ns != nil {
+			entries = append(entries, FibEntry{
+				FaceId: ns.faceId,
+				Cost:   hop.Cost,
+			})
+		}
 	}
 
 	return entries
@@ -63,6 +63,17 @@ func (fib *Fib) Size() int {
 	return len(fib.prefixes)
 }
 
+// Entries returns the next hops of all prefixes in the FIB.
+func (fib *Fib) Entries() iter.Seq2[enc.Name, []FibEntry] {
+	return func(yield func(enc.Name, []FibEntry) bool) {
+		for hash, entries := range fib.prefixes {
+			if !yield(fib.names[hash], entries) {
+				return
+			}
+		}
+	}
+}
+
 // (AI GENERATED DESCRIPTION): Updates the FIB entry for the specified name with the provided entries, returning true if the update succeeded.
 func (fib *Fib) Update(name enc.Name, newEntries []FibEntry) bool {
 	return fib.UpdateH(name.Hash(), name, newEntries)
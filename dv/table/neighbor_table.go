@@ -40,6 +40,10 @@ type NeighborState struct {
 	faceId uint64
 	// the received advertisement is active face
 	isFaceActive bool
+	// smoothed RTT of link probes, zero if unknown
+	srtt time.Duration
+	// prefix sync Interests are sent to the neighbor
+	pfxSync bool
 }
 
 // (AI GENERATED DESCRIPTION): Creates a new NeighborTable instance with the supplied configuration and NFD client, initializing an empty map to store neighbor states.
@@ -110,6 +114,70 @@ func (ns *NeighborState) IsDead() bool {
 	return time.Since(ns.lastSeen) > ns.nt.config.RouterDeadInterval()
 }
 
+// FaceId returns the latest known face ID of the neighbor, or zero if unknown.
+func (ns *NeighborState) FaceId() uint64 {
+	return ns.faceId
+}
+
+// LastSeen returns the time of the last sync Interest from the neighbor.
+func (ns *NeighborState) LastSeen() time.Time {
+	return ns.lastSeen
+}
+
+// Srtt returns the smoothed RTT to the neighbor, or zero if unknown.
+func (ns *NeighborState) Srtt() time.Duration {
+	return ns.srtt
+}
+
+// Cost returns the cost of the link to the neighbor. A static cost configured
+// for the face of the neighbor takes precedence over the cost derived from the RTT.
+func (ns *NeighborState) Cost() uint64 {
+	if ns.faceId != 0 {
+		for _, neighbor := range ns.nt.config.Neighbors {
+			if neighbor.FaceId == ns.faceId && neighbor.Cost > 0 {
+				return neighbor.Cost
+			}
+		}
+	}
+
+	if unit := ns.nt.config.RttCostUnit(); unit > 0 && ns.srtt > 0 {
+		// Round up so that a link is never cheaper than its RTT, and
+		// stay below infinity so that slow links remain usable.
+		cost := uint64((ns.srtt + unit - 1) / unit)
+		return min(max(cost, config.DefaultLinkCost), ns.nt.config.CostInfinity-1)
+	}
+
+	return config.DefaultLinkCost
+}
+
+// RecvRtt updates the smoothed RTT with a link probe measurement.
+// Return => true if the cost of the link has changed
+func (ns *NeighborState) RecvRtt(rtt time.Duration) bool {
+	prev := ns.Cost()
+	if ns.srtt == 0 {
+		ns.srtt = rtt
+	} else {
+		// Same gain as the TCP and NFD RTT estimators
+		ns.srtt += (rtt - ns.srtt) / 8
+	}
+	return ns.Cost() != prev
+}
+
+// FaceDown marks the neighbors reached through the face as dead, so they are
+// removed at the next dead check instead of after the dead interval.
+// Return => true if any neighbor was reached through the face
+func (nt *NeighborTable) FaceDown(faceId uint64) bool {
+	found := false
+	for _, ns := range nt.neighbors {
+		if ns.faceId == faceId {
+			log.Info(nt, "Neighbor face down", "neighbor", ns.Name, "faceid", faceId)
+			ns.lastSeen = time.Time{}
+			found = true
+		}
+	}
+	return found
+}
+
 // Call this when a ping is received from a face.
 // This will automatically register the face route with the neighbor
 // and update the last seen time for the neighbor.
@@ -128,6 +196,7 @@ func (ns *NeighborState) RecvPing(faceId uint64, active bool) (error, bool) {
 	// If face ID has changed, re-register face.
 	if ns.faceId != faceId {
 		ns.isFaceActive = active
+		ns.srtt = 0 // new link
 		log.Info(ns.nt, "Neighbor face change", "neighbor", ns.Name, "faceid", faceId, "old", ns.faceId)
 		ns.routeUnregister()
 		ns.routeRegister(faceId)
@@ -143,6 +212,33 @@ func (ns *NeighborState) delete() {
 	ns.Advert = nil
 	ns.faceId = 0
 	ns.isFaceActive = false
+	ns.srtt = 0
+	ns.pfxSync = false
+}
+
+// SetPrefixSync sets whether prefix sync Interests are sent to the neighbor.
+// Prefix sync is limited to neighbors in the same area.
+func (ns *NeighborState) SetPrefixSync(enabled bool) {
+	if ns.pfxSync == enabled {
+		return
+	}
+	ns.pfxSync = enabled
+
+	if ns.faceId == 0 {
+		return // registered with the face
+	}
+	if enabled {
+		ns.routeRegisterPfxSync(ns.faceId)
+		return
+	}
+
+	// Other neighbors in the area may be on the same face
+	for _, ons := range ns.nt.neighbors {
+		if ons != ns && ons.faceId == ns.faceId && ons.pfxSync {
+			return
+		}
+	}
+	ns.routeUnregisterPfxSync()
 }
 
 // (AI GENERATED DESCRIPTION): Creates the local route name for a neighbor by prefixing the neighbor’s name with the `LOCALHOP` namespace and appending the “DV” keyword component.
@@ -175,8 +271,38 @@ func (ns *NeighborState) routeRegister(faceId uint64) {
 	// Passive advertisement sync to neighbor
 	register(ns.nt.config.AdvertisementSyncPassivePrefix())
 	// For prefix table sync group
-	register(ns.nt.config.PrefixTableGroupPrefix().
-		Append(enc.NewKeywordComponent("svs")))
+	if ns.pfxSync {
+		ns.routeRegisterPfxSync(faceId)
+	}
+}
+
+// Register route for the prefix table sync group to this neighbor
+func (ns *NeighborState) routeRegisterPfxSync(faceId uint64) {
+	ns.nt.nfdc.Exec(nfdc.NfdMgmtCmd{
+		Module: "rib",
+		Cmd:    "register",
+		Args: &mgmt.ControlArgs{
+			Name:   ns.nt.config.PrefixTableGroupPrefix().Append(enc.NewKeywordComponent("svs")),
+			FaceId: optional.Some(faceId),
+			Origin: optional.Some(config.NlsrOrigin),
+			Cost:   optional.Some(uint64(0)),
+		},
+		Retries: 3,
+	})
+}
+
+// Single attempt to unregister the route for the prefix table sync group
+func (ns *NeighborState) routeUnregisterPfxSync() {
+	ns.nt.nfdc.Exec(nfdc.NfdMgmtCmd{
+		Module: "rib",
+		Cmd:    "unregister",
+		Args: &mgmt.ControlArgs{
+			Name:   ns.nt.config.PrefixTableGroupPrefix().Append(enc.NewKeywordComponent("svs")),
+			FaceId: optional.Some(ns.faceId),
+			Origin: optional.Some(config.NlsrOrigin),
+		},
+		Retries: 1,
+	})
 }
 
 // Single attempt to unregister the route
@@ -210,6 +336,7 @@ func (ns *NeighborState) routeUnregister() {
 	}
 
 	unregister(ns.nt.config.AdvertisementSyncPassivePrefix())
-	unregister(ns.nt.config.PrefixTableGroupPrefix().
-		Append(enc.NewKeywordComponent("svs")))
+	if ns.pfxSync {
+		ns.routeUnregisterPfxSync()
+	}
 }
@@ -1,6 +1,8 @@
 package table
 
 import (
+	"iter"
+	"maps"
 	"slices"
 
 	"github.com/named-data/ndnd/dv/config"
@@ -17,6 +19,7 @@ type PrefixTable struct {
 }
 
 type PrefixTableRouter struct {
+	Name     enc.Name
 	Prefixes map[string]*PrefixEntry
 }
 
@@ -56,6 +59,7 @@ func (pt *PrefixTable) GetRouter(name enc.Name) *PrefixTableRouter {
 	router := pt.routers[hash]
 	if router == nil {
 		router = &PrefixTableRouter{
+			Name:     name.Clone(),
 			Prefixes: make(map[string]*PrefixEntry),
 		}
 		pt.routers[hash] = router
@@ -63,6 +67,11 @@ func (pt *PrefixTable) GetRouter(name enc.Name) *PrefixTableRouter {
 	return router
 }
 
+// Routers returns all exit routers known to the prefix table.
+func (pt *PrefixTable) Routers() iter.Seq[*PrefixTableRouter] {
+	return maps.Values(pt.routers)
+}
+
 // (AI GENERATED DESCRIPTION): Resets the PrefixTable by clearing all stored prefixes and broadcasting a reset operation to the network.
 func (pt *PrefixTable) Reset() {
 	log.Info(pt, "Reset table")
@@ -1,8 +1,10 @@
 package table
 
 import (
+	"cmp"
 	"fmt"
 	"iter"
+	"slices"
 
 	"github.com/named-data/ndnd/dv/config"
 	"github.com/named-data/ndnd/dv/tlv"
@@ -27,6 +29,8 @@ type RibEntry struct {
 	name enc.Name
 	// neighbor hash -> cost
 	costs map[uint64]uint64
+	// neighbor hash -> cost advertised by the neighbor
+	dists map[uint64]uint64
 	// next hop for lowest cost (name hash)
 	nextHop1 uint64
 	// second next hop for lowest cost (name hash)
@@ -35,10 +39,20 @@ type RibEntry struct {
 	lowest1 uint64
 	// second lowest cost in this entry
 	lowest2 uint64
+	// loop-free next hops by increasing cost
+	nextHops []RibNextHop
 	// needs refresh
 	dirty bool
 }
 
+// RibNextHop is a loop-free next hop of a RIB entry.
+type RibNextHop struct {
+	// neighbor name hash
+	Neighbor uint64
+	// cost through the neighbor
+	Cost uint64
+}
+
 // (AI GENERATED DESCRIPTION): Creates a new Rib instance initialized with the supplied configuration and empty entry and neighbor maps.
 func NewRib(config *config.Config) *Rib {
 	return &Rib{
@@ -58,7 +72,7 @@ func (r *Rib) Print() {
 	for _, entry := range r.entries {
 		fmt.Printf("=> Destination: %s\n", entry.name.String())
 		for hop, cost := range entry.costs {
-			if cost < config.CostInfinity {
+			if cost < r.config.CostInfinity {
 				fmt.Printf("===> NextHop: %s, Cost: %d\n", r.neighbors[hop].String(), cost)
 			}
 		}
@@ -70,8 +84,10 @@ func (r *Rib) Size() int {
 	return len(r.entries)
 }
 
-// Set a destination in the RIB. Returns true if the Advertisement might change.
-func (r *Rib) Set(destName enc.Name, nextHop enc.Name, cost uint64) bool {
+// Set a destination in the RIB, with the cost through the next hop and the cost
+// advertised by the next hop itself. Returns true if the Advertisement or the
+// next hops might change.
+func (r *Rib) Set(destName enc.Name, nextHop enc.Name, cost uint64, dist uint64) bool {
 	destHash := destName.Hash()
 	nextHopHash := nextHop.Hash()
 
@@ -82,6 +98,7 @@ func (r *Rib) Set(destName enc.Name, nextHop enc.Name, cost uint64) bool {
 			rib:   r,
 			name:  destName.Clone(),
 			costs: make(map[uint64]uint64),
+			dists: make(map[uint64]uint64),
 		}
 		r.entries[destHash] = entry
 	}
@@ -91,7 +108,7 @@ func (r *Rib) Set(destName enc.Name, nextHop enc.Name, cost uint64) bool {
 		r.neighbors[nextHopHash] = nextHop.Clone()
 	}
 
-	return entry.Set(nextHopHash, cost)
+	return entry.Set(nextHopHash, cost, dist)
 }
 
 // Check if a destination is reachable in the RIB.
@@ -100,14 +117,14 @@ func (r *Rib) Has(destName enc.Name) bool {
 	if entry == nil {
 		return false
 	}
-	return entry.lowest1 < config.CostInfinity
+	return entry.lowest1 < r.config.CostInfinity
 }
 
 // Get all destinations reachable in the RIB.
 func (r *Rib) Entries() iter.Seq2[uint64, *RibEntry] {
 	return func(yield func(uint64, *RibEntry) bool) {
 		for hash, entry := range r.entries {
-			if entry.lowest1 < config.CostInfinity {
+			if entry.lowest1 < r.config.CostInfinity {
 				if !yield(hash, entry) {
 					return
 				}
@@ -116,6 +133,11 @@ func (r *Rib) Entries() iter.Seq2[uint64, *RibEntry] {
 	}
 }
 
+// Neighbor returns the name of a neighbor by its name hash.
+func (r *Rib) Neighbor(hash uint64) enc.Name {
+	return r.neighbors[hash]
+}
+
 // Remove all entries with a given next hop.
 // Returns true if the Advertisement might change.
 func (r *Rib) RemoveNextHop(nextHop enc.Name) bool {
@@ -125,6 +147,7 @@ func (r *Rib) RemoveNextHop(nextHop enc.Name) bool {
 	for _, entry := range r.entries {
 		if _, ok := entry.costs[nextHopHash]; ok {
 			delete(entry.costs, nextHopHash)
+			delete(entry.dists, nextHopHash)
 			dirty = entry.refresh() || dirty
 		}
 	}
@@ -138,7 +161,8 @@ func (r *Rib) RemoveNextHop(nextHop enc.Name) bool {
 func (r *Rib) DirtyResetNextHop(nextHop enc.Name) {
 	nextHopHash := nextHop.Hash()
 	for _, entry := range r.entries {
-		entry.costs[nextHopHash] = config.CostInfinity
+		entry.costs[nextHopHash] = r.config.CostInfinity
+		entry.dists[nextHopHash] = r.config.CostInfinity
 		entry.dirty = true
 	}
 }
@@ -155,7 +179,7 @@ func (r *Rib) Prune() bool {
 		}
 
 		// Remove if no valid next hops
-		if entry.lowest1 == config.CostInfinity {
+		if entry.lowest1 == r.config.CostInfinity {
 			delete(r.entries, entry.name.Hash())
 			dirty = true
 		}
@@ -188,10 +212,19 @@ func (e *RibEntry) Name() enc.Name {
 	return e.name
 }
 
-// (AI GENERATED DESCRIPTION): Updates a RIB entry’s cost for a given next hop, refreshing the entry only if the cost changes, and returns whether a refresh was performed.
-func (e *RibEntry) Set(nextHop uint64, cost uint64) bool {
-	if known, ok := e.costs[nextHop]; !ok || known != cost {
+// Cost returns the lowest cost to the destination.
+func (e *RibEntry) Cost() uint64 {
+	return e.lowest1
+}
+
+// Set the cost through a next hop and the cost advertised by the next hop.
+// Returns true if the Advertisement or the next hops might change.
+func (e *RibEntry) Set(nextHop uint64, cost uint64, dist uint64) bool {
+	knownCost, ok1 := e.costs[nextHop]
+	knownDist, ok2 := e.dists[nextHop]
+	if !ok1 || !ok2 || knownCost != cost || knownDist != dist {
 		e.costs[nextHop] = cost
+		e.dists[nextHop] = dist
 		return e.refresh()
 	}
 
@@ -202,8 +235,8 @@ func (e *RibEntry) Set(nextHop uint64, cost uint64) bool {
 // Update lowest and second lowest costs for the entry.
 func (e *RibEntry) refresh() bool {
 	e.dirty = false
-	lowest1 := config.CostInfinity
-	lowest2 := config.CostInfinity
+	lowest1 := e.rib.config.CostInfinity
+	lowest2 := e.rib.config.CostInfinity
 	nextHop1 := uint64(0)
 	nextHop2 := uint64(0)
 
@@ -219,6 +252,24 @@ func (e *RibEntry) refresh() bool {
 		}
 	}
 
+	// The best next hop is always used. Other next hops are used if they satisfy
+	// the downstream path criterion, i.e. their own cost to the destination is
+	// lower than ours, so that they never forward back to us. This includes all
+	// next hops with a cost equal to the lowest cost (ECMP), and alternates.
+	nextHops := make([]RibNextHop, 0, len(e.costs))
+	for hop, cost := range e.costs {
+		if cost >= e.rib.config.CostInfinity {
+			continue
+		}
+		if dist, ok := e.dists[hop]; hop == nextHop1 || (ok && dist < lowest1) {
+			nextHops = append(nextHops, RibNextHop{Neighbor: hop, Cost: cost})
+		}
+	}
+	slices.SortFunc(nextHops, func(a, b RibNextHop) int {
+		return cmp.Or(cmp.Compare(a.Cost, b.Cost), cmp.Compare(a.Neighbor, b.Neighbor))
+	})
+
+	changed := false
 	if e.lowest1 != lowest1 || e.lowest2 != lowest2 || e.nextHop1 != nextHop1 || e.nextHop2 != nextHop2 {
 		e.lowest1 = lowest1
 		e.lowest2 = lowest2
@@ -227,8 +278,19 @@ func (e *RibEntry) refresh() bool {
 		log.Info(e.rib, "Update next hop", "name", e.name,
 			"hop1", e.rib.neighbors[nextHop1], "cost1", lowest1,
 			"hop2", e.rib.neighbors[nextHop2], "cost2", lowest2)
-		return true
+		changed = true
 	}
 
-	return false
+	if !slices.Equal(e.nextHops, nextHops) {
+		e.nextHops = nextHops
+		log.Debug(e.rib, "Update loop-free next hops", "name", e.name, "count", len(nextHops))
+		changed = true
+	}
+
+	return changed
+}
+
+// NextHops returns the loop-free next hops of the entry by increasing cost.
+func (e *RibEntry) NextHops() []RibNextHop {
+	return e.nextHops
 }
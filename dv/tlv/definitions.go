@@ -1,7 +1,10 @@
 //go:generate gondn_tlv_gen
 package tlv
 
-import enc "github.com/named-data/ndnd/std/encoding"
+import (
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/types/optional"
+)
 
 type Packet struct {
 	//+field:struct:Advertisement
@@ -13,6 +16,8 @@ type Packet struct {
 type Advertisement struct {
 	//+field:sequence:*AdvEntry:struct:AdvEntry
 	Entries []*AdvEntry `tlv:"0xCA"`
+	//+field:struct:Destination
+	Area *Destination `tlv:"0xD4"`
 }
 
 type AdvEntry struct {
@@ -24,6 +29,8 @@ type AdvEntry struct {
 	Cost uint64 `tlv:"0xD0"`
 	//+field:natural
 	OtherCost uint64 `tlv:"0xD2"`
+	//+field:sequence:*Destination:struct:Destination
+	Prefixes []*Destination `tlv:"0xD6"`
 }
 
 type Destination struct {
@@ -67,4 +74,93 @@ type Status struct {
 	NNeighbors uint64 `tlv:"0x199"`
 	//+field:natural
 	NFibEntries uint64 `tlv:"0x19B"`
+	//+field:natural
+	CostInfinity uint64 `tlv:"0x19D"`
+	//+field:sequence:*NeighborStatus:struct:NeighborStatus
+	Neighbors []*NeighborStatus `tlv:"0x19F"`
+	//+field:struct:Destination
+	AreaName *Destination `tlv:"0x1AB"`
+}
+
+type NeighborStatus struct {
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+	//+field:natural
+	FaceId uint64 `tlv:"0x1A1"`
+	//+field:natural
+	Cost uint64 `tlv:"0xD0"`
+	//+field:natural:optional
+	Srtt_us optional.Optional[uint64] `tlv:"0x1A3"`
+	//+field:natural
+	AdvertSeq uint64 `tlv:"0x1A5"`
+	//+field:natural
+	LastSeen_ms uint64 `tlv:"0x1A7"`
+	//+field:bool
+	Alive bool `tlv:"0x1A9"`
+}
+
+type NeighborsStatus struct {
+	//+field:sequence:*NeighborStatus:struct:NeighborStatus
+	Neighbors []*NeighborStatus `tlv:"0x19F"`
+}
+
+type RibStatus struct {
+	//+field:sequence:*RibEntryStatus:struct:RibEntryStatus
+	Entries []*RibEntryStatus `tlv:"0x1B1"`
+}
+
+type RibEntryStatus struct {
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+	//+field:natural
+	Cost uint64 `tlv:"0xD0"`
+	//+field:sequence:*RibNextHopStatus:struct:RibNextHopStatus
+	NextHops []*RibNextHopStatus `tlv:"0x1B3"`
+}
+
+type RibNextHopStatus struct {
+	//+field:name
+	Neighbor enc.Name `tlv:"0x07"`
+	//+field:natural
+	FaceId uint64 `tlv:"0x1A1"`
+	//+field:natural
+	Cost uint64 `tlv:"0xD0"`
+}
+
+type PrefixStatus struct {
+	//+field:sequence:*PrefixRouterStatus:struct:PrefixRouterStatus
+	Routers []*PrefixRouterStatus `tlv:"0x1B5"`
+}
+
+type PrefixRouterStatus struct {
+	//+field:name
+	ExitRouter enc.Name `tlv:"0x07"`
+	//+field:sequence:*PrefixEntryStatus:struct:PrefixEntryStatus
+	Prefixes []*PrefixEntryStatus `tlv:"0x1B7"`
+}
+
+type PrefixEntryStatus struct {
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+	//+field:natural
+	Cost uint64 `tlv:"0xD0"`
+}
+
+type FibStatus struct {
+	//+field:sequence:*FibEntryStatus:struct:FibEntryStatus
+	Entries []*FibEntryStatus `tlv:"0x1B9"`
+}
+
+type FibEntryStatus struct {
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+	//+field:sequence:*FibNextHopStatus:struct:FibNextHopStatus
+	NextHops []*FibNextHopStatus `tlv:"0x1BB"`
+}
+
+type FibNextHopStatus struct {
+	//+field:natural
+	FaceId uint64 `tlv:"0x1A1"`
+	//+field:natural
+	Cost uint64 `tlv:"0xD0"`
 }
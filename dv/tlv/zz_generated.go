@@ -180,10 +180,12 @@ type AdvertisementEncoder struct {
 	Entries_subencoder []struct {
 		Entries_encoder AdvEntryEncoder
 	}
+	Area_encoder DestinationEncoder
 }
 
 type AdvertisementParsingContext struct {
 	Entries_context AdvEntryParsingContext
+	Area_context    DestinationParsingContext
 }
 
 func (encoder *AdvertisementEncoder) Init(value *Advertisement) {
@@ -210,6 +212,9 @@ func (encoder *AdvertisementEncoder) Init(value *Advertisement) {
 			}
 		}
 	}
+	if value.Area != nil {
+		encoder.Area_encoder.Init(value.Area)
+	}
 
 	l := uint(0)
 	if value.Entries != nil {
@@ -233,12 +238,18 @@ func (encoder *AdvertisementEncoder) Init(value *Advertisement) {
 			}
 		}
 	}
+	if value.Area != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Area_encoder.Length).EncodingLength())
+		l += encoder.Area_encoder.Length
+	}
 	encoder.Length = l
 
 }
 
 func (context *AdvertisementParsingContext) Init() {
 	context.Entries_context.Init()
+	context.Area_context.Init()
 }
 
 func (encoder *AdvertisementEncoder) EncodeInto(value *Advertisement, buf []byte) {
@@ -270,6 +281,15 @@ func (encoder *AdvertisementEncoder) EncodeInto(value *Advertisement, buf []byte
 			}
 		}
 	}
+	if value.Area != nil {
+		buf[pos] = byte(212)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Area_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Area_encoder.Length > 0 {
+			encoder.Area_encoder.EncodeInto(value.Area, buf[pos:])
+			pos += encoder.Area_encoder.Length
+		}
+	}
 }
 
 func (encoder *AdvertisementEncoder) Encode(value *Advertisement) enc.Wire {
@@ -285,6 +305,7 @@ func (encoder *AdvertisementEncoder) Encode(value *Advertisement) enc.Wire {
 func (context *AdvertisementParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*Advertisement, error) {
 
 	var handled_Entries bool = false
+	var handled_Area bool = false
 
 	progress := -1
 	_ = progress
@@ -331,6 +352,12 @@ func (context *AdvertisementParsingContext) Parse(reader enc.WireView, ignoreCri
 					}
 					progress--
 				}
+			case 212:
+				if true {
+					handled = true
+					handled_Area = true
+					value.Area, err = context.Area_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
 			default:
 				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
 					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
@@ -352,6 +379,9 @@ func (context *AdvertisementParsingContext) Parse(reader enc.WireView, ignoreCri
 	if !handled_Entries && err == nil {
 		// sequence - skip
 	}
+	if !handled_Area && err == nil {
+		value.Area = nil
+	}
 
 	if err != nil {
 		return nil, err
@@ -381,11 +411,17 @@ type AdvEntryEncoder struct {
 
 	Destination_encoder DestinationEncoder
 	NextHop_encoder     DestinationEncoder
+
+	Prefixes_subencoder []struct {
+		Prefixes_encoder DestinationEncoder
+	}
 }
 
 type AdvEntryParsingContext struct {
 	Destination_context DestinationParsingContext
 	NextHop_context     DestinationParsingContext
+
+	Prefixes_context DestinationParsingContext
 }
 
 func (encoder *AdvEntryEncoder) Init(value *AdvEntry) {
@@ -396,6 +432,30 @@ func (encoder *AdvEntryEncoder) Init(value *AdvEntry) {
 		encoder.NextHop_encoder.Init(value.NextHop)
 	}
 
+	{
+		Prefixes_l := len(value.Prefixes)
+		encoder.Prefixes_subencoder = make([]struct {
+			Prefixes_encoder DestinationEncoder
+		}, Prefixes_l)
+		for i := 0; i < Prefixes_l; i++ {
+			pseudoEncoder := &encoder.Prefixes_subencoder[i]
+			pseudoValue := struct {
+				Prefixes *Destination
+			}{
+				Prefixes: value.Prefixes[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					encoder.Prefixes_encoder.Init(value.Prefixes)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
 	l := uint(0)
 	if value.Destination != nil {
 		l += 1
@@ -411,6 +471,27 @@ func (encoder *AdvEntryEncoder) Init(value *AdvEntry) {
 	l += uint(1 + enc.Nat(value.Cost).EncodingLength())
 	l += 1
 	l += uint(1 + enc.Nat(value.OtherCost).EncodingLength())
+	if value.Prefixes != nil {
+		for seq_i, seq_v := range value.Prefixes {
+			pseudoEncoder := &encoder.Prefixes_subencoder[seq_i]
+			pseudoValue := struct {
+				Prefixes *Destination
+			}{
+				Prefixes: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					l += 1
+					l += uint(enc.TLNum(encoder.Prefixes_encoder.Length).EncodingLength())
+					l += encoder.Prefixes_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
 	encoder.Length = l
 
 }
@@ -419,6 +500,7 @@ func (context *AdvEntryParsingContext) Init() {
 	context.Destination_context.Init()
 	context.NextHop_context.Init()
 
+	context.Prefixes_context.Init()
 }
 
 func (encoder *AdvEntryEncoder) EncodeInto(value *AdvEntry, buf []byte) {
@@ -453,6 +535,31 @@ func (encoder *AdvEntryEncoder) EncodeInto(value *AdvEntry, buf []byte) {
 
 	buf[pos] = byte(enc.Nat(value.OtherCost).EncodeInto(buf[pos+1:]))
 	pos += uint(1 + buf[pos])
+	if value.Prefixes != nil {
+		for seq_i, seq_v := range value.Prefixes {
+			pseudoEncoder := &encoder.Prefixes_subencoder[seq_i]
+			pseudoValue := struct {
+				Prefixes *Destination
+			}{
+				Prefixes: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					buf[pos] = byte(214)
+					pos += 1
+					pos += uint(enc.TLNum(encoder.Prefixes_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Prefixes_encoder.Length > 0 {
+						encoder.Prefixes_encoder.EncodeInto(value.Prefixes, buf[pos:])
+						pos += encoder.Prefixes_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
 }
 
 func (encoder *AdvEntryEncoder) Encode(value *AdvEntry) enc.Wire {
@@ -471,6 +578,7 @@ func (context *AdvEntryParsingContext) Parse(reader enc.WireView, ignoreCritical
 	var handled_NextHop bool = false
 	var handled_Cost bool = false
 	var handled_OtherCost bool = false
+	var handled_Prefixes bool = false
 
 	progress := -1
 	_ = progress
@@ -547,6 +655,26 @@ func (context *AdvEntryParsingContext) Parse(reader enc.WireView, ignoreCritical
 						}
 					}
 				}
+			case 214:
+				if true {
+					handled = true
+					handled_Prefixes = true
+					if value.Prefixes == nil {
+						value.Prefixes = make([]*Destination, 0)
+					}
+					{
+						pseudoValue := struct {
+							Prefixes *Destination
+						}{}
+						{
+							value := &pseudoValue
+							value.Prefixes, err = context.Prefixes_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Prefixes = append(value.Prefixes, pseudoValue.Prefixes)
+					}
+					progress--
+				}
 			default:
 				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
 					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
@@ -577,6 +705,9 @@ func (context *AdvEntryParsingContext) Parse(reader enc.WireView, ignoreCritical
 	if !handled_OtherCost && err == nil {
 		err = enc.ErrSkipRequired{Name: "OtherCost", TypeNum: 210}
 	}
+	if !handled_Prefixes && err == nil {
+		// sequence - skip
+	}
 
 	if err != nil {
 		return nil, err
@@ -1402,11 +1533,19 @@ type StatusEncoder struct {
 
 	NetworkName_encoder DestinationEncoder
 	RouterName_encoder  DestinationEncoder
+
+	Neighbors_subencoder []struct {
+		Neighbors_encoder NeighborStatusEncoder
+	}
+	AreaName_encoder DestinationEncoder
 }
 
 type StatusParsingContext struct {
 	NetworkName_context DestinationParsingContext
 	RouterName_context  DestinationParsingContext
+
+	Neighbors_context NeighborStatusParsingContext
+	AreaName_context  DestinationParsingContext
 }
 
 func (encoder *StatusEncoder) Init(value *Status) {
@@ -1418,6 +1557,33 @@ func (encoder *StatusEncoder) Init(value *Status) {
 		encoder.RouterName_encoder.Init(value.RouterName)
 	}
 
+	{
+		Neighbors_l := len(value.Neighbors)
+		encoder.Neighbors_subencoder = make([]struct {
+			Neighbors_encoder NeighborStatusEncoder
+		}, Neighbors_l)
+		for i := 0; i < Neighbors_l; i++ {
+			pseudoEncoder := &encoder.Neighbors_subencoder[i]
+			pseudoValue := struct {
+				Neighbors *NeighborStatus
+			}{
+				Neighbors: value.Neighbors[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Neighbors != nil {
+					encoder.Neighbors_encoder.Init(value.Neighbors)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.AreaName != nil {
+		encoder.AreaName_encoder.Init(value.AreaName)
+	}
+
 	l := uint(0)
 	l += 3
 	l += uint(enc.TLNum(len(value.Version)).EncodingLength())
@@ -1438,6 +1604,34 @@ func (encoder *StatusEncoder) Init(value *Status) {
 	l += uint(1 + enc.Nat(value.NNeighbors).EncodingLength())
 	l += 3
 	l += uint(1 + enc.Nat(value.NFibEntries).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.CostInfinity).EncodingLength())
+	if value.Neighbors != nil {
+		for seq_i, seq_v := range value.Neighbors {
+			pseudoEncoder := &encoder.Neighbors_subencoder[seq_i]
+			pseudoValue := struct {
+				Neighbors *NeighborStatus
+			}{
+				Neighbors: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Neighbors != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Neighbors_encoder.Length).EncodingLength())
+					l += encoder.Neighbors_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.AreaName != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.AreaName_encoder.Length).EncodingLength())
+		l += encoder.AreaName_encoder.Length
+	}
 	encoder.Length = l
 
 }
@@ -1447,6 +1641,8 @@ func (context *StatusParsingContext) Init() {
 	context.NetworkName_context.Init()
 	context.RouterName_context.Init()
 
+	context.Neighbors_context.Init()
+	context.AreaName_context.Init()
 }
 
 func (encoder *StatusEncoder) EncodeInto(value *Status, buf []byte) {
@@ -1497,6 +1693,48 @@ func (encoder *StatusEncoder) EncodeInto(value *Status, buf []byte) {
 
 	buf[pos] = byte(enc.Nat(value.NFibEntries).EncodeInto(buf[pos+1:]))
 	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(413))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.CostInfinity).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if value.Neighbors != nil {
+		for seq_i, seq_v := range value.Neighbors {
+			pseudoEncoder := &encoder.Neighbors_subencoder[seq_i]
+			pseudoValue := struct {
+				Neighbors *NeighborStatus
+			}{
+				Neighbors: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Neighbors != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(415))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Neighbors_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Neighbors_encoder.Length > 0 {
+						encoder.Neighbors_encoder.EncodeInto(value.Neighbors, buf[pos:])
+						pos += encoder.Neighbors_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.AreaName != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(427))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.AreaName_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.AreaName_encoder.Length > 0 {
+			encoder.AreaName_encoder.EncodeInto(value.AreaName, buf[pos:])
+			pos += encoder.AreaName_encoder.Length
+		}
+	}
 }
 
 func (encoder *StatusEncoder) Encode(value *Status) enc.Wire {
@@ -1517,6 +1755,9 @@ func (context *StatusParsingContext) Parse(reader enc.WireView, ignoreCritical b
 	var handled_NRibEntries bool = false
 	var handled_NNeighbors bool = false
 	var handled_NFibEntries bool = false
+	var handled_CostInfinity bool = false
+	var handled_Neighbors bool = false
+	var handled_AreaName bool = false
 
 	progress := -1
 	_ = progress
@@ -1624,6 +1865,51 @@ func (context *StatusParsingContext) Parse(reader enc.WireView, ignoreCritical b
 						}
 					}
 				}
+			case 413:
+				if true {
+					handled = true
+					handled_CostInfinity = true
+					value.CostInfinity = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.CostInfinity = uint64(value.CostInfinity<<8) | uint64(x)
+						}
+					}
+				}
+			case 415:
+				if true {
+					handled = true
+					handled_Neighbors = true
+					if value.Neighbors == nil {
+						value.Neighbors = make([]*NeighborStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							Neighbors *NeighborStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.Neighbors, err = context.Neighbors_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Neighbors = append(value.Neighbors, pseudoValue.Neighbors)
+					}
+					progress--
+				}
+			case 427:
+				if true {
+					handled = true
+					handled_AreaName = true
+					value.AreaName, err = context.AreaName_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
 			default:
 				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
 					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
@@ -1660,6 +1946,15 @@ func (context *StatusParsingContext) Parse(reader enc.WireView, ignoreCritical b
 	if !handled_NFibEntries && err == nil {
 		err = enc.ErrSkipRequired{Name: "NFibEntries", TypeNum: 411}
 	}
+	if !handled_CostInfinity && err == nil {
+		err = enc.ErrSkipRequired{Name: "CostInfinity", TypeNum: 413}
+	}
+	if !handled_Neighbors && err == nil {
+		// sequence - skip
+	}
+	if !handled_AreaName && err == nil {
+		value.AreaName = nil
+	}
 
 	if err != nil {
 		return nil, err
@@ -1683,3 +1978,2401 @@ func ParseStatus(reader enc.WireView, ignoreCritical bool) (*Status, error) {
 	context.Init()
 	return context.Parse(reader, ignoreCritical)
 }
+
+type NeighborStatusEncoder struct {
+	Length uint
+
+	Name_length uint
+}
+
+type NeighborStatusParsingContext struct {
+}
+
+func (encoder *NeighborStatusEncoder) Init(value *NeighborStatus) {
+	if value.Name != nil {
+		encoder.Name_length = 0
+		for _, c := range value.Name {
+			encoder.Name_length += uint(c.EncodingLength())
+		}
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Name_length).EncodingLength())
+		l += encoder.Name_length
+	}
+	l += 3
+	l += uint(1 + enc.Nat(value.FaceId).EncodingLength())
+	l += 1
+	l += uint(1 + enc.Nat(value.Cost).EncodingLength())
+	if optval, ok := value.Srtt_us.Get(); ok {
+		l += 3
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
+	l += 3
+	l += uint(1 + enc.Nat(value.AdvertSeq).EncodingLength())
+	l += 3
+	l += uint(1 + enc.Nat(value.LastSeen_ms).EncodingLength())
+	if value.Alive {
+		l += 3
+		l += 1
+	}
+	encoder.Length = l
+
+}
+
+func (context *NeighborStatusParsingContext) Init() {
+
+}
+
+func (encoder *NeighborStatusEncoder) EncodeInto(value *NeighborStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = byte(7)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Name_length).EncodeInto(buf[pos:]))
+		for _, c := range value.Name {
+			pos += uint(c.EncodeInto(buf[pos:]))
+		}
+	}
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(417))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.FaceId).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = byte(208)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.Cost).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if optval, ok := value.Srtt_us.Get(); ok {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(419))
+		pos += 3
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(421))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.AdvertSeq).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(423))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.LastSeen_ms).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if value.Alive {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(425))
+		pos += 3
+		buf[pos] = byte(0)
+		pos += 1
+	}
+}
+
+func (encoder *NeighborStatusEncoder) Encode(value *NeighborStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *NeighborStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*NeighborStatus, error) {
+
+	var handled_Name bool = false
+	var handled_FaceId bool = false
+	var handled_Cost bool = false
+	var handled_Srtt_us bool = false
+	var handled_AdvertSeq bool = false
+	var handled_LastSeen_ms bool = false
+	var handled_Alive bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &NeighborStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 7:
+				if true {
+					handled = true
+					handled_Name = true
+					delegate := reader.Delegate(int(l))
+					value.Name, err = delegate.ReadName()
+				}
+			case 417:
+				if true {
+					handled = true
+					handled_FaceId = true
+					value.FaceId = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.FaceId = uint64(value.FaceId<<8) | uint64(x)
+						}
+					}
+				}
+			case 208:
+				if true {
+					handled = true
+					handled_Cost = true
+					value.Cost = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Cost = uint64(value.Cost<<8) | uint64(x)
+						}
+					}
+				}
+			case 419:
+				if true {
+					handled = true
+					handled_Srtt_us = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.Srtt_us.Set(optval)
+					}
+				}
+			case 421:
+				if true {
+					handled = true
+					handled_AdvertSeq = true
+					value.AdvertSeq = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.AdvertSeq = uint64(value.AdvertSeq<<8) | uint64(x)
+						}
+					}
+				}
+			case 423:
+				if true {
+					handled = true
+					handled_LastSeen_ms = true
+					value.LastSeen_ms = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.LastSeen_ms = uint64(value.LastSeen_ms<<8) | uint64(x)
+						}
+					}
+				}
+			case 425:
+				if true {
+					handled = true
+					handled_Alive = true
+					value.Alive = true
+					err = reader.Skip(int(l))
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_FaceId && err == nil {
+		err = enc.ErrSkipRequired{Name: "FaceId", TypeNum: 417}
+	}
+	if !handled_Cost && err == nil {
+		err = enc.ErrSkipRequired{Name: "Cost", TypeNum: 208}
+	}
+	if !handled_Srtt_us && err == nil {
+		value.Srtt_us.Unset()
+	}
+	if !handled_AdvertSeq && err == nil {
+		err = enc.ErrSkipRequired{Name: "AdvertSeq", TypeNum: 421}
+	}
+	if !handled_LastSeen_ms && err == nil {
+		err = enc.ErrSkipRequired{Name: "LastSeen_ms", TypeNum: 423}
+	}
+	if !handled_Alive && err == nil {
+		value.Alive = false
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *NeighborStatus) Encode() enc.Wire {
+	encoder := NeighborStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *NeighborStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseNeighborStatus(reader enc.WireView, ignoreCritical bool) (*NeighborStatus, error) {
+	context := NeighborStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type NeighborsStatusEncoder struct {
+	Length uint
+
+	Neighbors_subencoder []struct {
+		Neighbors_encoder NeighborStatusEncoder
+	}
+}
+
+type NeighborsStatusParsingContext struct {
+	Neighbors_context NeighborStatusParsingContext
+}
+
+func (encoder *NeighborsStatusEncoder) Init(value *NeighborsStatus) {
+	{
+		Neighbors_l := len(value.Neighbors)
+		encoder.Neighbors_subencoder = make([]struct {
+			Neighbors_encoder NeighborStatusEncoder
+		}, Neighbors_l)
+		for i := 0; i < Neighbors_l; i++ {
+			pseudoEncoder := &encoder.Neighbors_subencoder[i]
+			pseudoValue := struct {
+				Neighbors *NeighborStatus
+			}{
+				Neighbors: value.Neighbors[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Neighbors != nil {
+					encoder.Neighbors_encoder.Init(value.Neighbors)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Neighbors != nil {
+		for seq_i, seq_v := range value.Neighbors {
+			pseudoEncoder := &encoder.Neighbors_subencoder[seq_i]
+			pseudoValue := struct {
+				Neighbors *NeighborStatus
+			}{
+				Neighbors: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Neighbors != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Neighbors_encoder.Length).EncodingLength())
+					l += encoder.Neighbors_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *NeighborsStatusParsingContext) Init() {
+	context.Neighbors_context.Init()
+}
+
+func (encoder *NeighborsStatusEncoder) EncodeInto(value *NeighborsStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Neighbors != nil {
+		for seq_i, seq_v := range value.Neighbors {
+			pseudoEncoder := &encoder.Neighbors_subencoder[seq_i]
+			pseudoValue := struct {
+				Neighbors *NeighborStatus
+			}{
+				Neighbors: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Neighbors != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(415))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Neighbors_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Neighbors_encoder.Length > 0 {
+						encoder.Neighbors_encoder.EncodeInto(value.Neighbors, buf[pos:])
+						pos += encoder.Neighbors_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *NeighborsStatusEncoder) Encode(value *NeighborsStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *NeighborsStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*NeighborsStatus, error) {
+
+	var handled_Neighbors bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &NeighborsStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 415:
+				if true {
+					handled = true
+					handled_Neighbors = true
+					if value.Neighbors == nil {
+						value.Neighbors = make([]*NeighborStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							Neighbors *NeighborStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.Neighbors, err = context.Neighbors_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Neighbors = append(value.Neighbors, pseudoValue.Neighbors)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Neighbors && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *NeighborsStatus) Encode() enc.Wire {
+	encoder := NeighborsStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *NeighborsStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseNeighborsStatus(reader enc.WireView, ignoreCritical bool) (*NeighborsStatus, error) {
+	context := NeighborsStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type RibStatusEncoder struct {
+	Length uint
+
+	Entries_subencoder []struct {
+		Entries_encoder RibEntryStatusEncoder
+	}
+}
+
+type RibStatusParsingContext struct {
+	Entries_context RibEntryStatusParsingContext
+}
+
+func (encoder *RibStatusEncoder) Init(value *RibStatus) {
+	{
+		Entries_l := len(value.Entries)
+		encoder.Entries_subencoder = make([]struct {
+			Entries_encoder RibEntryStatusEncoder
+		}, Entries_l)
+		for i := 0; i < Entries_l; i++ {
+			pseudoEncoder := &encoder.Entries_subencoder[i]
+			pseudoValue := struct {
+				Entries *RibEntryStatus
+			}{
+				Entries: value.Entries[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					encoder.Entries_encoder.Init(value.Entries)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Entries != nil {
+		for seq_i, seq_v := range value.Entries {
+			pseudoEncoder := &encoder.Entries_subencoder[seq_i]
+			pseudoValue := struct {
+				Entries *RibEntryStatus
+			}{
+				Entries: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Entries_encoder.Length).EncodingLength())
+					l += encoder.Entries_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *RibStatusParsingContext) Init() {
+	context.Entries_context.Init()
+}
+
+func (encoder *RibStatusEncoder) EncodeInto(value *RibStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Entries != nil {
+		for seq_i, seq_v := range value.Entries {
+			pseudoEncoder := &encoder.Entries_subencoder[seq_i]
+			pseudoValue := struct {
+				Entries *RibEntryStatus
+			}{
+				Entries: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(433))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Entries_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Entries_encoder.Length > 0 {
+						encoder.Entries_encoder.EncodeInto(value.Entries, buf[pos:])
+						pos += encoder.Entries_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *RibStatusEncoder) Encode(value *RibStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *RibStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*RibStatus, error) {
+
+	var handled_Entries bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &RibStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 433:
+				if true {
+					handled = true
+					handled_Entries = true
+					if value.Entries == nil {
+						value.Entries = make([]*RibEntryStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							Entries *RibEntryStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.Entries, err = context.Entries_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Entries = append(value.Entries, pseudoValue.Entries)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Entries && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *RibStatus) Encode() enc.Wire {
+	encoder := RibStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *RibStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseRibStatus(reader enc.WireView, ignoreCritical bool) (*RibStatus, error) {
+	context := RibStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type RibEntryStatusEncoder struct {
+	Length uint
+
+	Name_length uint
+
+	NextHops_subencoder []struct {
+		NextHops_encoder RibNextHopStatusEncoder
+	}
+}
+
+type RibEntryStatusParsingContext struct {
+	NextHops_context RibNextHopStatusParsingContext
+}
+
+func (encoder *RibEntryStatusEncoder) Init(value *RibEntryStatus) {
+	if value.Name != nil {
+		encoder.Name_length = 0
+		for _, c := range value.Name {
+			encoder.Name_length += uint(c.EncodingLength())
+		}
+	}
+
+	{
+		NextHops_l := len(value.NextHops)
+		encoder.NextHops_subencoder = make([]struct {
+			NextHops_encoder RibNextHopStatusEncoder
+		}, NextHops_l)
+		for i := 0; i < NextHops_l; i++ {
+			pseudoEncoder := &encoder.NextHops_subencoder[i]
+			pseudoValue := struct {
+				NextHops *RibNextHopStatus
+			}{
+				NextHops: value.NextHops[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.NextHops != nil {
+					encoder.NextHops_encoder.Init(value.NextHops)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Name_length).EncodingLength())
+		l += encoder.Name_length
+	}
+	l += 1
+	l += uint(1 + enc.Nat(value.Cost).EncodingLength())
+	if value.NextHops != nil {
+		for seq_i, seq_v := range value.NextHops {
+			pseudoEncoder := &encoder.NextHops_subencoder[seq_i]
+			pseudoValue := struct {
+				NextHops *RibNextHopStatus
+			}{
+				NextHops: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.NextHops != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.NextHops_encoder.Length).EncodingLength())
+					l += encoder.NextHops_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *RibEntryStatusParsingContext) Init() {
+
+	context.NextHops_context.Init()
+}
+
+func (encoder *RibEntryStatusEncoder) EncodeInto(value *RibEntryStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = byte(7)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Name_length).EncodeInto(buf[pos:]))
+		for _, c := range value.Name {
+			pos += uint(c.EncodeInto(buf[pos:]))
+		}
+	}
+	buf[pos] = byte(208)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.Cost).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if value.NextHops != nil {
+		for seq_i, seq_v := range value.NextHops {
+			pseudoEncoder := &encoder.NextHops_subencoder[seq_i]
+			pseudoValue := struct {
+				NextHops *RibNextHopStatus
+			}{
+				NextHops: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.NextHops != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(435))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.NextHops_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.NextHops_encoder.Length > 0 {
+						encoder.NextHops_encoder.EncodeInto(value.NextHops, buf[pos:])
+						pos += encoder.NextHops_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *RibEntryStatusEncoder) Encode(value *RibEntryStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *RibEntryStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*RibEntryStatus, error) {
+
+	var handled_Name bool = false
+	var handled_Cost bool = false
+	var handled_NextHops bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &RibEntryStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 7:
+				if true {
+					handled = true
+					handled_Name = true
+					delegate := reader.Delegate(int(l))
+					value.Name, err = delegate.ReadName()
+				}
+			case 208:
+				if true {
+					handled = true
+					handled_Cost = true
+					value.Cost = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Cost = uint64(value.Cost<<8) | uint64(x)
+						}
+					}
+				}
+			case 435:
+				if true {
+					handled = true
+					handled_NextHops = true
+					if value.NextHops == nil {
+						value.NextHops = make([]*RibNextHopStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							NextHops *RibNextHopStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.NextHops, err = context.NextHops_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.NextHops = append(value.NextHops, pseudoValue.NextHops)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_Cost && err == nil {
+		err = enc.ErrSkipRequired{Name: "Cost", TypeNum: 208}
+	}
+	if !handled_NextHops && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *RibEntryStatus) Encode() enc.Wire {
+	encoder := RibEntryStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *RibEntryStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseRibEntryStatus(reader enc.WireView, ignoreCritical bool) (*RibEntryStatus, error) {
+	context := RibEntryStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type RibNextHopStatusEncoder struct {
+	Length uint
+
+	Neighbor_length uint
+}
+
+type RibNextHopStatusParsingContext struct {
+}
+
+func (encoder *RibNextHopStatusEncoder) Init(value *RibNextHopStatus) {
+	if value.Neighbor != nil {
+		encoder.Neighbor_length = 0
+		for _, c := range value.Neighbor {
+			encoder.Neighbor_length += uint(c.EncodingLength())
+		}
+	}
+
+	l := uint(0)
+	if value.Neighbor != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Neighbor_length).EncodingLength())
+		l += encoder.Neighbor_length
+	}
+	l += 3
+	l += uint(1 + enc.Nat(value.FaceId).EncodingLength())
+	l += 1
+	l += uint(1 + enc.Nat(value.Cost).EncodingLength())
+	encoder.Length = l
+
+}
+
+func (context *RibNextHopStatusParsingContext) Init() {
+
+}
+
+func (encoder *RibNextHopStatusEncoder) EncodeInto(value *RibNextHopStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Neighbor != nil {
+		buf[pos] = byte(7)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Neighbor_length).EncodeInto(buf[pos:]))
+		for _, c := range value.Neighbor {
+			pos += uint(c.EncodeInto(buf[pos:]))
+		}
+	}
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(417))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.FaceId).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = byte(208)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.Cost).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+}
+
+func (encoder *RibNextHopStatusEncoder) Encode(value *RibNextHopStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *RibNextHopStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*RibNextHopStatus, error) {
+
+	var handled_Neighbor bool = false
+	var handled_FaceId bool = false
+	var handled_Cost bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &RibNextHopStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 7:
+				if true {
+					handled = true
+					handled_Neighbor = true
+					delegate := reader.Delegate(int(l))
+					value.Neighbor, err = delegate.ReadName()
+				}
+			case 417:
+				if true {
+					handled = true
+					handled_FaceId = true
+					value.FaceId = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.FaceId = uint64(value.FaceId<<8) | uint64(x)
+						}
+					}
+				}
+			case 208:
+				if true {
+					handled = true
+					handled_Cost = true
+					value.Cost = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Cost = uint64(value.Cost<<8) | uint64(x)
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Neighbor && err == nil {
+		value.Neighbor = nil
+	}
+	if !handled_FaceId && err == nil {
+		err = enc.ErrSkipRequired{Name: "FaceId", TypeNum: 417}
+	}
+	if !handled_Cost && err == nil {
+		err = enc.ErrSkipRequired{Name: "Cost", TypeNum: 208}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *RibNextHopStatus) Encode() enc.Wire {
+	encoder := RibNextHopStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *RibNextHopStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseRibNextHopStatus(reader enc.WireView, ignoreCritical bool) (*RibNextHopStatus, error) {
+	context := RibNextHopStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type PrefixStatusEncoder struct {
+	Length uint
+
+	Routers_subencoder []struct {
+		Routers_encoder PrefixRouterStatusEncoder
+	}
+}
+
+type PrefixStatusParsingContext struct {
+	Routers_context PrefixRouterStatusParsingContext
+}
+
+func (encoder *PrefixStatusEncoder) Init(value *PrefixStatus) {
+	{
+		Routers_l := len(value.Routers)
+		encoder.Routers_subencoder = make([]struct {
+			Routers_encoder PrefixRouterStatusEncoder
+		}, Routers_l)
+		for i := 0; i < Routers_l; i++ {
+			pseudoEncoder := &encoder.Routers_subencoder[i]
+			pseudoValue := struct {
+				Routers *PrefixRouterStatus
+			}{
+				Routers: value.Routers[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Routers != nil {
+					encoder.Routers_encoder.Init(value.Routers)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Routers != nil {
+		for seq_i, seq_v := range value.Routers {
+			pseudoEncoder := &encoder.Routers_subencoder[seq_i]
+			pseudoValue := struct {
+				Routers *PrefixRouterStatus
+			}{
+				Routers: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Routers != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Routers_encoder.Length).EncodingLength())
+					l += encoder.Routers_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *PrefixStatusParsingContext) Init() {
+	context.Routers_context.Init()
+}
+
+func (encoder *PrefixStatusEncoder) EncodeInto(value *PrefixStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Routers != nil {
+		for seq_i, seq_v := range value.Routers {
+			pseudoEncoder := &encoder.Routers_subencoder[seq_i]
+			pseudoValue := struct {
+				Routers *PrefixRouterStatus
+			}{
+				Routers: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Routers != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(437))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Routers_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Routers_encoder.Length > 0 {
+						encoder.Routers_encoder.EncodeInto(value.Routers, buf[pos:])
+						pos += encoder.Routers_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *PrefixStatusEncoder) Encode(value *PrefixStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *PrefixStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*PrefixStatus, error) {
+
+	var handled_Routers bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &PrefixStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 437:
+				if true {
+					handled = true
+					handled_Routers = true
+					if value.Routers == nil {
+						value.Routers = make([]*PrefixRouterStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							Routers *PrefixRouterStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.Routers, err = context.Routers_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Routers = append(value.Routers, pseudoValue.Routers)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Routers && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *PrefixStatus) Encode() enc.Wire {
+	encoder := PrefixStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *PrefixStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParsePrefixStatus(reader enc.WireView, ignoreCritical bool) (*PrefixStatus, error) {
+	context := PrefixStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type PrefixRouterStatusEncoder struct {
+	Length uint
+
+	ExitRouter_length   uint
+	Prefixes_subencoder []struct {
+		Prefixes_encoder PrefixEntryStatusEncoder
+	}
+}
+
+type PrefixRouterStatusParsingContext struct {
+	Prefixes_context PrefixEntryStatusParsingContext
+}
+
+func (encoder *PrefixRouterStatusEncoder) Init(value *PrefixRouterStatus) {
+	if value.ExitRouter != nil {
+		encoder.ExitRouter_length = 0
+		for _, c := range value.ExitRouter {
+			encoder.ExitRouter_length += uint(c.EncodingLength())
+		}
+	}
+	{
+		Prefixes_l := len(value.Prefixes)
+		encoder.Prefixes_subencoder = make([]struct {
+			Prefixes_encoder PrefixEntryStatusEncoder
+		}, Prefixes_l)
+		for i := 0; i < Prefixes_l; i++ {
+			pseudoEncoder := &encoder.Prefixes_subencoder[i]
+			pseudoValue := struct {
+				Prefixes *PrefixEntryStatus
+			}{
+				Prefixes: value.Prefixes[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					encoder.Prefixes_encoder.Init(value.Prefixes)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.ExitRouter != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.ExitRouter_length).EncodingLength())
+		l += encoder.ExitRouter_length
+	}
+	if value.Prefixes != nil {
+		for seq_i, seq_v := range value.Prefixes {
+			pseudoEncoder := &encoder.Prefixes_subencoder[seq_i]
+			pseudoValue := struct {
+				Prefixes *PrefixEntryStatus
+			}{
+				Prefixes: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Prefixes_encoder.Length).EncodingLength())
+					l += encoder.Prefixes_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *PrefixRouterStatusParsingContext) Init() {
+
+	context.Prefixes_context.Init()
+}
+
+func (encoder *PrefixRouterStatusEncoder) EncodeInto(value *PrefixRouterStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.ExitRouter != nil {
+		buf[pos] = byte(7)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.ExitRouter_length).EncodeInto(buf[pos:]))
+		for _, c := range value.ExitRouter {
+			pos += uint(c.EncodeInto(buf[pos:]))
+		}
+	}
+	if value.Prefixes != nil {
+		for seq_i, seq_v := range value.Prefixes {
+			pseudoEncoder := &encoder.Prefixes_subencoder[seq_i]
+			pseudoValue := struct {
+				Prefixes *PrefixEntryStatus
+			}{
+				Prefixes: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Prefixes != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(439))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Prefixes_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Prefixes_encoder.Length > 0 {
+						encoder.Prefixes_encoder.EncodeInto(value.Prefixes, buf[pos:])
+						pos += encoder.Prefixes_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *PrefixRouterStatusEncoder) Encode(value *PrefixRouterStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *PrefixRouterStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*PrefixRouterStatus, error) {
+
+	var handled_ExitRouter bool = false
+	var handled_Prefixes bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &PrefixRouterStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 7:
+				if true {
+					handled = true
+					handled_ExitRouter = true
+					delegate := reader.Delegate(int(l))
+					value.ExitRouter, err = delegate.ReadName()
+				}
+			case 439:
+				if true {
+					handled = true
+					handled_Prefixes = true
+					if value.Prefixes == nil {
+						value.Prefixes = make([]*PrefixEntryStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							Prefixes *PrefixEntryStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.Prefixes, err = context.Prefixes_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Prefixes = append(value.Prefixes, pseudoValue.Prefixes)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_ExitRouter && err == nil {
+		value.ExitRouter = nil
+	}
+	if !handled_Prefixes && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *PrefixRouterStatus) Encode() enc.Wire {
+	encoder := PrefixRouterStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *PrefixRouterStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParsePrefixRouterStatus(reader enc.WireView, ignoreCritical bool) (*PrefixRouterStatus, error) {
+	context := PrefixRouterStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type PrefixEntryStatusEncoder struct {
+	Length uint
+
+	Name_length uint
+}
+
+type PrefixEntryStatusParsingContext struct {
+}
+
+func (encoder *PrefixEntryStatusEncoder) Init(value *PrefixEntryStatus) {
+	if value.Name != nil {
+		encoder.Name_length = 0
+		for _, c := range value.Name {
+			encoder.Name_length += uint(c.EncodingLength())
+		}
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Name_length).EncodingLength())
+		l += encoder.Name_length
+	}
+	l += 1
+	l += uint(1 + enc.Nat(value.Cost).EncodingLength())
+	encoder.Length = l
+
+}
+
+func (context *PrefixEntryStatusParsingContext) Init() {
+
+}
+
+func (encoder *PrefixEntryStatusEncoder) EncodeInto(value *PrefixEntryStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = byte(7)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Name_length).EncodeInto(buf[pos:]))
+		for _, c := range value.Name {
+			pos += uint(c.EncodeInto(buf[pos:]))
+		}
+	}
+	buf[pos] = byte(208)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.Cost).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+}
+
+func (encoder *PrefixEntryStatusEncoder) Encode(value *PrefixEntryStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *PrefixEntryStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*PrefixEntryStatus, error) {
+
+	var handled_Name bool = false
+	var handled_Cost bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &PrefixEntryStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 7:
+				if true {
+					handled = true
+					handled_Name = true
+					delegate := reader.Delegate(int(l))
+					value.Name, err = delegate.ReadName()
+				}
+			case 208:
+				if true {
+					handled = true
+					handled_Cost = true
+					value.Cost = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Cost = uint64(value.Cost<<8) | uint64(x)
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_Cost && err == nil {
+		err = enc.ErrSkipRequired{Name: "Cost", TypeNum: 208}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *PrefixEntryStatus) Encode() enc.Wire {
+	encoder := PrefixEntryStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *PrefixEntryStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParsePrefixEntryStatus(reader enc.WireView, ignoreCritical bool) (*PrefixEntryStatus, error) {
+	context := PrefixEntryStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type FibStatusEncoder struct {
+	Length uint
+
+	Entries_subencoder []struct {
+		Entries_encoder FibEntryStatusEncoder
+	}
+}
+
+type FibStatusParsingContext struct {
+	Entries_context FibEntryStatusParsingContext
+}
+
+func (encoder *FibStatusEncoder) Init(value *FibStatus) {
+	{
+		Entries_l := len(value.Entries)
+		encoder.Entries_subencoder = make([]struct {
+			Entries_encoder FibEntryStatusEncoder
+		}, Entries_l)
+		for i := 0; i < Entries_l; i++ {
+			pseudoEncoder := &encoder.Entries_subencoder[i]
+			pseudoValue := struct {
+				Entries *FibEntryStatus
+			}{
+				Entries: value.Entries[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					encoder.Entries_encoder.Init(value.Entries)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Entries != nil {
+		for seq_i, seq_v := range value.Entries {
+			pseudoEncoder := &encoder.Entries_subencoder[seq_i]
+			pseudoValue := struct {
+				Entries *FibEntryStatus
+			}{
+				Entries: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.Entries_encoder.Length).EncodingLength())
+					l += encoder.Entries_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *FibStatusParsingContext) Init() {
+	context.Entries_context.Init()
+}
+
+func (encoder *FibStatusEncoder) EncodeInto(value *FibStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Entries != nil {
+		for seq_i, seq_v := range value.Entries {
+			pseudoEncoder := &encoder.Entries_subencoder[seq_i]
+			pseudoValue := struct {
+				Entries *FibEntryStatus
+			}{
+				Entries: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(441))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.Entries_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Entries_encoder.Length > 0 {
+						encoder.Entries_encoder.EncodeInto(value.Entries, buf[pos:])
+						pos += encoder.Entries_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *FibStatusEncoder) Encode(value *FibStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *FibStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*FibStatus, error) {
+
+	var handled_Entries bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &FibStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 441:
+				if true {
+					handled = true
+					handled_Entries = true
+					if value.Entries == nil {
+						value.Entries = make([]*FibEntryStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							Entries *FibEntryStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.Entries, err = context.Entries_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Entries = append(value.Entries, pseudoValue.Entries)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Entries && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *FibStatus) Encode() enc.Wire {
+	encoder := FibStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *FibStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseFibStatus(reader enc.WireView, ignoreCritical bool) (*FibStatus, error) {
+	context := FibStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type FibEntryStatusEncoder struct {
+	Length uint
+
+	Name_length         uint
+	NextHops_subencoder []struct {
+		NextHops_encoder FibNextHopStatusEncoder
+	}
+}
+
+type FibEntryStatusParsingContext struct {
+	NextHops_context FibNextHopStatusParsingContext
+}
+
+func (encoder *FibEntryStatusEncoder) Init(value *FibEntryStatus) {
+	if value.Name != nil {
+		encoder.Name_length = 0
+		for _, c := range value.Name {
+			encoder.Name_length += uint(c.EncodingLength())
+		}
+	}
+	{
+		NextHops_l := len(value.NextHops)
+		encoder.NextHops_subencoder = make([]struct {
+			NextHops_encoder FibNextHopStatusEncoder
+		}, NextHops_l)
+		for i := 0; i < NextHops_l; i++ {
+			pseudoEncoder := &encoder.NextHops_subencoder[i]
+			pseudoValue := struct {
+				NextHops *FibNextHopStatus
+			}{
+				NextHops: value.NextHops[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.NextHops != nil {
+					encoder.NextHops_encoder.Init(value.NextHops)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Name_length).EncodingLength())
+		l += encoder.Name_length
+	}
+	if value.NextHops != nil {
+		for seq_i, seq_v := range value.NextHops {
+			pseudoEncoder := &encoder.NextHops_subencoder[seq_i]
+			pseudoValue := struct {
+				NextHops *FibNextHopStatus
+			}{
+				NextHops: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.NextHops != nil {
+					l += 3
+					l += uint(enc.TLNum(encoder.NextHops_encoder.Length).EncodingLength())
+					l += encoder.NextHops_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *FibEntryStatusParsingContext) Init() {
+
+	context.NextHops_context.Init()
+}
+
+func (encoder *FibEntryStatusEncoder) EncodeInto(value *FibEntryStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = byte(7)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Name_length).EncodeInto(buf[pos:]))
+		for _, c := range value.Name {
+			pos += uint(c.EncodeInto(buf[pos:]))
+		}
+	}
+	if value.NextHops != nil {
+		for seq_i, seq_v := range value.NextHops {
+			pseudoEncoder := &encoder.NextHops_subencoder[seq_i]
+			pseudoValue := struct {
+				NextHops *FibNextHopStatus
+			}{
+				NextHops: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.NextHops != nil {
+					buf[pos] = 253
+					binary.BigEndian.PutUint16(buf[pos+1:], uint16(443))
+					pos += 3
+					pos += uint(enc.TLNum(encoder.NextHops_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.NextHops_encoder.Length > 0 {
+						encoder.NextHops_encoder.EncodeInto(value.NextHops, buf[pos:])
+						pos += encoder.NextHops_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *FibEntryStatusEncoder) Encode(value *FibEntryStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *FibEntryStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*FibEntryStatus, error) {
+
+	var handled_Name bool = false
+	var handled_NextHops bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &FibEntryStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 7:
+				if true {
+					handled = true
+					handled_Name = true
+					delegate := reader.Delegate(int(l))
+					value.Name, err = delegate.ReadName()
+				}
+			case 443:
+				if true {
+					handled = true
+					handled_NextHops = true
+					if value.NextHops == nil {
+						value.NextHops = make([]*FibNextHopStatus, 0)
+					}
+					{
+						pseudoValue := struct {
+							NextHops *FibNextHopStatus
+						}{}
+						{
+							value := &pseudoValue
+							value.NextHops, err = context.NextHops_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.NextHops = append(value.NextHops, pseudoValue.NextHops)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_NextHops && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *FibEntryStatus) Encode() enc.Wire {
+	encoder := FibEntryStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *FibEntryStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseFibEntryStatus(reader enc.WireView, ignoreCritical bool) (*FibEntryStatus, error) {
+	context := FibEntryStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type FibNextHopStatusEncoder struct {
+	Length uint
+}
+
+type FibNextHopStatusParsingContext struct {
+}
+
+func (encoder *FibNextHopStatusEncoder) Init(value *FibNextHopStatus) {
+
+	l := uint(0)
+	l += 3
+	l += uint(1 + enc.Nat(value.FaceId).EncodingLength())
+	l += 1
+	l += uint(1 + enc.Nat(value.Cost).EncodingLength())
+	encoder.Length = l
+
+}
+
+func (context *FibNextHopStatusParsingContext) Init() {
+
+}
+
+func (encoder *FibNextHopStatusEncoder) EncodeInto(value *FibNextHopStatus, buf []byte) {
+
+	pos := uint(0)
+
+	buf[pos] = 253
+	binary.BigEndian.PutUint16(buf[pos+1:], uint16(417))
+	pos += 3
+
+	buf[pos] = byte(enc.Nat(value.FaceId).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = byte(208)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.Cost).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+}
+
+func (encoder *FibNextHopStatusEncoder) Encode(value *FibNextHopStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *FibNextHopStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*FibNextHopStatus, error) {
+
+	var handled_FaceId bool = false
+	var handled_Cost bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &FibNextHopStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 417:
+				if true {
+					handled = true
+					handled_FaceId = true
+					value.FaceId = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.FaceId = uint64(value.FaceId<<8) | uint64(x)
+						}
+					}
+				}
+			case 208:
+				if true {
+					handled = true
+					handled_Cost = true
+					value.Cost = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Cost = uint64(value.Cost<<8) | uint64(x)
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_FaceId && err == nil {
+		err = enc.ErrSkipRequired{Name: "FaceId", TypeNum: 417}
+	}
+	if !handled_Cost && err == nil {
+		err = enc.ErrSkipRequired{Name: "Cost", TypeNum: 208}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *FibNextHopStatus) Encode() enc.Wire {
+	encoder := FibNextHopStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *FibNextHopStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseFibNextHopStatus(reader enc.WireView, ignoreCritical bool) (*FibNextHopStatus, error) {
+	context := FibNextHopStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
@@ -0,0 +1,422 @@
+package emu_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/dv/config"
+	"github.com/named-data/ndnd/dv/tlv"
+	"github.com/named-data/ndnd/emu"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	"github.com/named-data/ndnd/std/object"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/named-data/ndnd/std/utils"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMain(m *testing.M) {
+	log.Default().SetLevel(log.LevelError)
+	m.Run()
+}
+
+// produce serves Data with the name of each Interest under a prefix, and registers the prefix.
+func produce(t *testing.T, engine ndn.Engine, prefix enc.Name, origin mgmt.RouteOrigin) {
+	err := engine.AttachHandler(prefix, func(args ndn.InterestHandlerArgs) {
+		data, err := engine.Spec().MakeData(args.Interest.Name(), &ndn.DataConfig{},
+			enc.Wire{[]byte("hello")}, nil)
+		if err == nil {
+			args.Reply(data.Wire)
+		}
+	})
+	require.NoError(t, err)
+
+	_, err = engine.ExecMgmtCmd("rib", "register", &mgmt.ControlArgs{
+		Name:   prefix,
+		Origin: optional.Some(uint64(origin)),
+	})
+	require.NoError(t, err)
+}
+
+// fetch expresses an Interest, and returns the result once the network settles.
+func fetch(t *testing.T, net *emu.Network, engine ndn.Engine, name enc.Name) (ndn.ExpressCallbackArgs, time.Duration) {
+	interest, err := engine.Spec().MakeInterest(name, &ndn.InterestConfig{
+		Lifetime: optional.Some(time.Second),
+		Nonce:    utils.ConvertNonce(engine.Timer().Nonce()),
+	}, nil, nil)
+	require.NoError(t, err)
+
+	type result struct {
+		args ndn.ExpressCallbackArgs
+		rtt  time.Duration
+	}
+	start := time.Now()
+	ch := make(chan result, 1)
+	require.NoError(t, engine.Express(interest, func(args ndn.ExpressCallbackArgs) {
+		ch <- result{args, time.Since(start)}
+	}))
+	require.True(t, net.Until(2*time.Second, func() bool { return len(ch) > 0 }))
+	res := <-ch
+	return res.args, res.rtt
+}
+
+func TestInterestData(t *testing.T) {
+	emu.Test(t, func(t *testing.T, net *emu.Network) {
+		tu.SetT(t)
+		a, b := net.AddNode("a"), net.AddNode("b")
+		link := net.Connect(a, b, emu.LinkConfig{Delay: 10 * time.Millisecond})
+
+		prefix := tu.NoErr(enc.NameFromStr("/test/b"))
+		consumer, producer := a.NewEngine(), b.NewEngine()
+		produce(t, producer, prefix, mgmt.RouteOriginApp)
+
+		// No route on a
+		res, _ := fetch(t, net, consumer, prefix.Append(enc.NewGenericComponent("1")))
+		require.Equal(t, ndn.InterestResultNack, res.Result)
+
+		// Static route from a to b
+		_, err := consumer.ExecMgmtCmd("rib", "register", &mgmt.ControlArgs{
+			Name:   prefix,
+			FaceId: optional.Some(a.FaceTo(b)),
+			Origin: optional.Some(uint64(mgmt.RouteOriginStatic)),
+		})
+		require.NoError(t, err)
+		require.Equal(t, []emu.NextHop{{FaceID: a.FaceTo(b), Cost: 0}}, a.NextHops(prefix))
+
+		res, rtt := fetch(t, net, consumer, prefix.Append(enc.NewGenericComponent("2")))
+		require.Equal(t, ndn.InterestResultData, res.Result)
+		require.Equal(t, []byte("hello"), res.Data.Content().Join())
+		require.Equal(t, 20*time.Millisecond, rtt)
+
+		// Frames are not sent on the faces of a down link
+		link.SetUp(false)
+		res, _ = fetch(t, net, consumer, prefix.Append(enc.NewGenericComponent("3")))
+		require.Equal(t, ndn.InterestResultTimeout, res.Result)
+
+		link.SetUp(true)
+		res, _ = fetch(t, net, consumer, prefix.Append(enc.NewGenericComponent("4")))
+		require.Equal(t, ndn.InterestResultData, res.Result)
+
+		stats := link.Stats()
+		require.Equal(t, uint64(4), stats.Sent)
+		require.Equal(t, uint64(4), stats.Delivered)
+	})
+}
+
+func TestBandwidth(t *testing.T) {
+	emu.Test(t, func(t *testing.T, net *emu.Network) {
+		tu.SetT(t)
+		a, b := net.AddNode("a"), net.AddNode("b")
+		net.Connect(a, b, emu.LinkConfig{Delay: 10 * time.Millisecond, Bandwidth: 8000})
+
+		prefix := tu.NoErr(enc.NameFromStr("/test/b"))
+		consumer, producer := a.NewEngine(), b.NewEngine()
+		produce(t, producer, prefix, mgmt.RouteOriginApp)
+		_, err := consumer.ExecMgmtCmd("rib", "register", &mgmt.ControlArgs{
+			Name:   prefix,
+			FaceId: optional.Some(a.FaceTo(b)),
+		})
+		require.NoError(t, err)
+
+		// At 1000 bytes/s, transmission takes about 1ms per byte
+		res, rtt := fetch(t, net, consumer, prefix.Append(enc.NewGenericComponent("1")))
+		require.Equal(t, ndn.InterestResultData, res.Result)
+		require.Greater(t, rtt, 20*time.Millisecond+50*time.Millisecond)
+	})
+}
+
+func TestDvFailover(t *testing.T) {
+	emu.Test(t, func(t *testing.T, net *emu.Network) {
+		tu.SetT(t)
+		// a - b - c and a - d - e - c, so that a reaches c through b
+		a, b, c := net.AddNode("a"), net.AddNode("b"), net.AddNode("c")
+		d, e := net.AddNode("d"), net.AddNode("e")
+		config := emu.LinkConfig{Delay: 5 * time.Millisecond}
+		ab := net.Connect(a, b, config)
+		net.Connect(b, c, config)
+		net.Connect(a, d, config)
+		net.Connect(d, e, config)
+		net.Connect(e, c, config)
+		require.NoError(t, net.StartDV("/emu"))
+		net.Advance(time.Second)
+
+		// The prefix is readvertised to the router of c
+		prefix := tu.NoErr(enc.NameFromStr("/test/c"))
+		produce(t, c.NewEngine(), prefix, mgmt.RouteOriginClient)
+		consumer := a.NewEngine()
+
+		converged := func(via *emu.Node) func() bool {
+			return func() bool {
+				nexthops := a.NextHops(prefix)
+				return len(nexthops) > 0 && nexthops[0].FaceID == a.FaceTo(via)
+			}
+		}
+		require.True(t, net.Until(time.Minute, converged(b)))
+
+		res, _ := fetch(t, net, consumer, prefix.Append(enc.NewGenericComponent("1")))
+		require.Equal(t, ndn.InterestResultData, res.Result)
+
+		// Route around the failed link
+		ab.SetUp(false)
+		require.True(t, net.Until(time.Minute, converged(d)))
+
+		res, _ = fetch(t, net, consumer, prefix.Append(enc.NewGenericComponent("2")))
+		require.Equal(t, ndn.InterestResultData, res.Result)
+	})
+}
+
+// triangle creates nodes a, b and c, where the direct link from a to c is slow.
+func triangle(net *emu.Network) (a, b, c *emu.Node) {
+	a, b, c = net.AddNode("a"), net.AddNode("b"), net.AddNode("c")
+	net.Connect(a, b, emu.LinkConfig{Delay: 5 * time.Millisecond})
+	net.Connect(b, c, emu.LinkConfig{Delay: 5 * time.Millisecond})
+	net.Connect(a, c, emu.LinkConfig{Delay: 50 * time.Millisecond})
+	return a, b, c
+}
+
+// startDV starts ndn-dv on every node, after changing the configuration of each node.
+func startDV(t *testing.T, net *emu.Network, update func(n *emu.Node, cfg *config.Config)) {
+	for _, n := range net.Nodes() {
+		cfg := n.DVConfig("/emu")
+		update(n, cfg)
+		_, err := n.StartDV(cfg)
+		require.NoError(t, err)
+	}
+	net.Advance(time.Second)
+}
+
+func TestDvStaticCost(t *testing.T) {
+	emu.Test(t, func(t *testing.T, net *emu.Network) {
+		tu.SetT(t)
+		a, b, c := triangle(net)
+		startDV(t, net, func(n *emu.Node, cfg *config.Config) {
+			for i := range cfg.Neighbors {
+				if (n == a && cfg.Neighbors[i].Uri == c.URI()) || (n == c && cfg.Neighbors[i].Uri == a.URI()) {
+					cfg.Neighbors[i].Cost = 10
+				}
+			}
+		})
+
+		prefix := tu.NoErr(enc.NameFromStr("/test/c"))
+		produce(t, c.NewEngine(), prefix, mgmt.RouteOriginClient)
+
+		// The path through b has a cost of 2
+		require.True(t, net.Until(time.Minute, func() bool {
+			nexthops := a.NextHops(prefix)
+			return len(nexthops) > 0 && nexthops[0] == emu.NextHop{FaceID: a.FaceTo(b), Cost: 2}
+		}))
+	})
+}
+
+func TestDvRttCost(t *testing.T) {
+	emu.Test(t, func(t *testing.T, net *emu.Network) {
+		tu.SetT(t)
+		a, b, c := triangle(net)
+		startDV(t, net, func(n *emu.Node, cfg *config.Config) {
+			cfg.RttCostUnit_ms = 10
+		})
+
+		prefix := tu.NoErr(enc.NameFromStr("/test/c"))
+		produce(t, c.NewEngine(), prefix, mgmt.RouteOriginClient)
+
+		// The RTT of the direct link is 100ms, and 10ms for the links through b
+		require.True(t, net.Until(time.Minute, func() bool {
+			nexthops := a.NextHops(prefix)
+			return len(nexthops) > 0 && nexthops[0] == emu.NextHop{FaceID: a.FaceTo(b), Cost: 2}
+		}))
+	})
+}
+
+func TestDvMultipath(t *testing.T) {
+	emu.Test(t, func(t *testing.T, net *emu.Network) {
+		tu.SetT(t)
+
+		// a - b - d and a - c - d have the same cost
+		a, b, c, d := net.AddNode("a"), net.AddNode("b"), net.AddNode("c"), net.AddNode("d")
+		config := emu.LinkConfig{Delay: 5 * time.Millisecond}
+		net.Connect(a, b, config)
+		net.Connect(a, c, config)
+		net.Connect(b, d, config)
+		net.Connect(c, d, config)
+		require.NoError(t, net.StartDV("/emu"))
+		net.Advance(time.Second)
+
+		prefix := tu.NoErr(enc.NameFromStr("/test/d"))
+		produce(t, d.NewEngine(), prefix, mgmt.RouteOriginClient)
+
+		require.True(t, net.Until(time.Minute, func() bool {
+			return slices.Equal(a.NextHops(prefix), []emu.NextHop{
+				{FaceID: a.FaceTo(b), Cost: 2},
+				{FaceID: a.FaceTo(c), Cost: 2},
+			})
+		}))
+	})
+}
+
+func TestDvLoopFreeAlternate(t *testing.T) {
+	emu.Test(t, func(t *testing.T, net *emu.Network) {
+		tu.SetT(t)
+		a, b, c := triangle(net)
+		startDV(t, net, func(n *emu.Node, cfg *config.Config) {
+			for i := range cfg.Neighbors {
+				if (n == a && cfg.Neighbors[i].Uri == c.URI()) || (n == c && cfg.Neighbors[i].Uri == a.URI()) {
+					cfg.Neighbors[i].Cost = 3
+				}
+			}
+		})
+
+		prefix := tu.NoErr(enc.NameFromStr("/test/c"))
+		produce(t, c.NewEngine(), prefix, mgmt.RouteOriginClient)
+
+		// c is a loop-free alternate for a, since it is the destination.
+		// a is not a loop-free alternate for b, since it is farther from c.
+		require.True(t, net.Until(time.Minute, func() bool {
+			return slices.Equal(a.NextHops(prefix), []emu.NextHop{
+				{FaceID: a.FaceTo(b), Cost: 2},
+				{FaceID: a.FaceTo(c), Cost: 3},
+			}) && slices.Equal(b.NextHops(prefix), []emu.NextHop{
+				{FaceID: b.FaceTo(c), Cost: 1},
+			})
+		}))
+	})
+}
+
+// fetchObject fetches an object without metadata, and returns its content once the network settles.
+func fetchObject(t *testing.T, net *emu.Network, engine ndn.Engine, name enc.Name) enc.Wire {
+	client := object.NewClient(engine, nil, nil)
+	require.NoError(t, client.Start())
+	defer client.Stop()
+
+	ch := make(chan ndn.ConsumeState, 1)
+	client.ConsumeExt(ndn.ConsumeExtArgs{
+		Name:       name,
+		NoMetadata: true,
+		Callback:   func(state ndn.ConsumeState) { ch <- state },
+	})
+	require.True(t, net.Until(2*time.Second, func() bool { return len(ch) > 0 }))
+	state := <-ch
+	require.NoError(t, state.Error())
+	return state.Content()
+}
+
+func TestDvDatasets(t *testing.T) {
+	emu.Test(t, func(t *testing.T, net *emu.Network) {
+		tu.SetT(t)
+		a, b, c := net.AddNode("a"), net.AddNode("b"), net.AddNode("c")
+		config := emu.LinkConfig{Delay: 5 * time.Millisecond}
+		net.Connect(a, b, config)
+		net.Connect(b, c, config)
+		require.NoError(t, net.StartDV("/emu"))
+		net.Advance(time.Second)
+
+		prefix := tu.NoErr(enc.NameFromStr("/test/c"))
+		produce(t, c.NewEngine(), prefix, mgmt.RouteOriginClient)
+		require.True(t, net.Until(time.Minute, func() bool { return len(a.NextHops(prefix)) > 0 }))
+
+		engine := a.NewEngine()
+		dataset := func(module string) enc.Wire {
+			return fetchObject(t, net, engine, tu.NoErr(enc.NameFromStr("/localhost/nlsr/"+module+"/list")))
+		}
+		routerB := tu.NoErr(enc.NameFromStr("/emu/b"))
+		routerC := tu.NoErr(enc.NameFromStr("/emu/c"))
+
+		rib := tu.NoErr(tlv.ParseRibStatus(enc.NewWireView(dataset("rib")), true))
+		entries := make(map[string]*tlv.RibEntryStatus)
+		for _, entry := range rib.Entries {
+			entries[entry.Name.String()] = entry
+		}
+		require.Len(t, entries, 3)
+		require.Equal(t, uint64(2), entries["/emu/c"].Cost)
+		require.Len(t, entries["/emu/c"].NextHops, 1)
+		require.True(t, routerB.Equal(entries["/emu/c"].NextHops[0].Neighbor))
+		require.Equal(t, a.FaceTo(b), entries["/emu/c"].NextHops[0].FaceId)
+
+		pfx := tu.NoErr(tlv.ParsePrefixStatus(enc.NewWireView(dataset("prefix")), true))
+		found := false
+		for _, router := range pfx.Routers {
+			for _, entry := range router.Prefixes {
+				if entry.Name.Equal(prefix) {
+					require.True(t, routerC.Equal(router.ExitRouter))
+					found = true
+				}
+			}
+		}
+		require.True(t, found)
+
+		neighbors := tu.NoErr(tlv.ParseNeighborsStatus(enc.NewWireView(dataset("neighbor")), true))
+		require.Len(t, neighbors.Neighbors, 1)
+		require.True(t, routerB.Equal(neighbors.Neighbors[0].Name))
+		require.True(t, neighbors.Neighbors[0].Alive)
+		require.Equal(t, a.FaceTo(b), neighbors.Neighbors[0].FaceId)
+		require.Greater(t, neighbors.Neighbors[0].AdvertSeq, uint64(0))
+
+		fib := tu.NoErr(tlv.ParseFibStatus(enc.NewWireView(dataset("fib")), true))
+		found = false
+		for _, entry := range fib.Entries {
+			if entry.Name.Equal(prefix) {
+				require.Len(t, entry.NextHops, 1)
+				require.Equal(t, a.FaceTo(b), entry.NextHops[0].FaceId)
+				found = true
+			}
+		}
+		require.True(t, found)
+	})
+}
+
+func TestDvAreas(t *testing.T) {
+	emu.Test(t, func(t *testing.T, net *emu.Network) {
+		tu.SetT(t)
+
+		// a - b in area x, c - d in area y, and e in area z
+		a, b, c := net.AddNode("a"), net.AddNode("b"), net.AddNode("c")
+		d, e := net.AddNode("d"), net.AddNode("e")
+		link := emu.LinkConfig{Delay: 5 * time.Millisecond}
+		net.Connect(a, b, link)
+		net.Connect(b, c, link)
+		net.Connect(c, d, link)
+		net.Connect(d, e, link)
+		areas := map[*emu.Node]string{a: "x", b: "x", c: "y", d: "y", e: "z"}
+		startDV(t, net, func(n *emu.Node, cfg *config.Config) {
+			cfg.Area = areas[n]
+		})
+
+		// Prefixes of e are aggregated by the border router of z
+		prefix := tu.NoErr(enc.NameFromStr("/test/e"))
+		produce(t, e.NewEngine(), prefix, mgmt.RouteOriginClient)
+		produce(t, e.NewEngine(), prefix.Append(enc.NewGenericComponent("sub")), mgmt.RouteOriginClient)
+
+		require.True(t, net.Until(time.Minute, func() bool {
+			nexthops := a.NextHops(prefix)
+			return len(nexthops) > 0 && nexthops[0] == emu.NextHop{FaceID: a.FaceTo(b), Cost: 4}
+		}))
+
+		consumer := a.NewEngine()
+		res, _ := fetch(t, net, consumer, prefix.Append(enc.NewGenericComponent("1")))
+		require.Equal(t, ndn.InterestResultData, res.Result)
+
+		// Routers in other areas are not in the RIB, only their areas
+		dataset := func(module string) enc.Wire {
+			return fetchObject(t, net, consumer, tu.NoErr(enc.NameFromStr("/localhost/nlsr/"+module+"/list")))
+		}
+		rib := tu.NoErr(tlv.ParseRibStatus(enc.NewWireView(dataset("rib")), true))
+		names := make([]string, 0, len(rib.Entries))
+		for _, entry := range rib.Entries {
+			names = append(names, entry.Name.String())
+		}
+		require.ElementsMatch(t, []string{"/emu/a", "/emu/b", "/emu/32=AREA/y", "/emu/32=AREA/z"}, names)
+
+		// Only the summary of other areas is known
+		pfx := tu.NoErr(tlv.ParsePrefixStatus(enc.NewWireView(dataset("prefix")), true))
+		summaries := make(map[string][]string)
+		for _, router := range pfx.Routers {
+			for _, entry := range router.Prefixes {
+				summaries[router.ExitRouter.String()] = append(summaries[router.ExitRouter.String()], entry.Name.String())
+			}
+		}
+		require.Equal(t, map[string][]string{"/emu/32=AREA/z": {"/test/e"}}, summaries)
+	})
+}
@@ -0,0 +1,130 @@
+package emu
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/face"
+	enc "github.com/named-data/ndnd/std/encoding"
+)
+
+// appFace is the face of an engine to the forwarder of its node.
+// It implements ndn.Face, and has local scope like a Unix socket face.
+type appFace struct {
+	node *Node
+
+	mutex   sync.Mutex
+	running bool
+	onPkt   func(frame []byte)
+	onError func(err error)
+
+	// Transport of the face of the forwarder, while running
+	transport *face.MemoryTransport
+	// Frames from the forwarder to the engine
+	frames *queue[[]byte]
+}
+
+// newAppFace creates a face to the forwarder of the node.
+func newAppFace(node *Node) *appFace {
+	return &appFace{node: node}
+}
+
+// String returns the log identifier of the face.
+func (f *appFace) String() string {
+	return fmt.Sprintf("emu-app-face (%s)", f.node.name)
+}
+
+// IsRunning returns true if the face is running.
+func (f *appFace) IsRunning() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.running
+}
+
+// IsLocal returns true, since the face is always local.
+func (f *appFace) IsLocal() bool {
+	return true
+}
+
+// OnPacket sets the callback for receiving packets.
+func (f *appFace) OnPacket(onPkt func(frame []byte)) {
+	f.onPkt = onPkt
+}
+
+// OnError sets the callback for fatal errors, which never happen.
+func (f *appFace) OnError(onError func(err error)) {
+	f.onError = onError
+}
+
+// Open creates the face on the forwarder, like a Unix socket face
+// with a file descriptor URI.
+func (f *appFace) Open() error {
+	if f.onPkt == nil || f.onError == nil {
+		return fmt.Errorf("face callbacks are not set")
+	}
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.running {
+		return fmt.Errorf("face is already running")
+	}
+
+	n := f.node
+	n.mutex.Lock()
+	n.nAppFaces++
+	fd := n.nAppFaces
+	n.mutex.Unlock()
+
+	f.frames = newQueue(func(frame []byte) { f.onPkt(frame) })
+	f.transport = face.MakeMemoryTransport(
+		defn.MakeFDFaceURI(fd),
+		defn.MakeUnixFaceURI("/run/emu/"+n.name+".sock"),
+		defn.Local,
+		func(frame []byte) { f.frames.push(frame) })
+
+	options := face.MakeNDNLPLinkServiceOptions()
+	options.IsFragmentationEnabled = false
+	n.addFace(f.transport, options)
+
+	f.running = true
+	return nil
+}
+
+// Close destroys the face on the forwarder.
+func (f *appFace) Close() error {
+	f.mutex.Lock()
+	if !f.running {
+		f.mutex.Unlock()
+		return fmt.Errorf("face is not running")
+	}
+	f.running = false
+	f.mutex.Unlock()
+
+	f.transport.Close()
+	f.frames.close()
+	return nil
+}
+
+// Send passes a frame to the forwarder.
+func (f *appFace) Send(pkt enc.Wire) error {
+	f.mutex.Lock()
+	running, transport := f.running, f.transport
+	f.mutex.Unlock()
+
+	if !running {
+		return fmt.Errorf("face is not running")
+	}
+	transport.Receive(pkt.Join())
+	return nil
+}
+
+// OnUp sets the callback for the face going up. The face never goes down.
+func (f *appFace) OnUp(onUp func()) (cancel func()) {
+	return func() {}
+}
+
+// OnDown sets the callback for the face going down. The face never goes down.
+func (f *appFace) OnDown(onDown func()) (cancel func()) {
+	return func() {}
+}
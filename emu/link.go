@@ -0,0 +1,202 @@
+package emu
+
+import (
+	"hash/fnv"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/face"
+)
+
+// LinkConfig is the configuration of a link. It applies to both directions.
+type LinkConfig struct {
+	// Delay is the propagation delay of the link.
+	Delay time.Duration
+	// Loss is the probability that a frame is lost, between 0 and 1.
+	Loss float64
+	// Bandwidth is the capacity of the link in bits per second, or 0 if unlimited.
+	// Frames wait in an unbounded queue until the link is free.
+	Bandwidth uint64
+}
+
+// Link is a point-to-point link between the forwarders of two nodes.
+// Each node has a permanent face to the other node, with the URI of the other node.
+type Link struct {
+	mutex  sync.Mutex
+	config LinkConfig
+	up     bool
+	// epoch is incremented when the link goes down, to lose the frames in transit
+	epoch uint64
+
+	// Directions of the link, from A to B and from B to A
+	dirs [2]*linkDir
+}
+
+// LinkStats are the counters of a link, summed over both directions.
+type LinkStats struct {
+	// Sent is the number of frames sent on the link.
+	Sent uint64
+	// Lost is the number of frames lost on the link, or dropped because it was down.
+	Lost uint64
+	// Delivered is the number of frames received at the other end.
+	Delivered uint64
+}
+
+// linkDir is one direction of a link.
+type linkDir struct {
+	link *Link
+	// Transport of the face of the receiving forwarder
+	dst *face.MemoryTransport
+	// Random numbers for frame loss
+	rng *rand.Rand
+	// End of the transmission of the last frame
+	busy time.Time
+	// Frames in transit
+	frames *queue[linkFrame]
+	stats  LinkStats
+}
+
+// linkFrame is a frame in transit on a link.
+type linkFrame struct {
+	wire    []byte
+	arrival time.Time
+	epoch   uint64
+}
+
+// newLink connects two nodes. Loss is drawn from a generator seeded by the node
+// names, so that runs with the same sequence of frames lose the same frames.
+func newLink(a *Node, b *Node, config LinkConfig) *Link {
+	l := &Link{config: config, up: true}
+	l.dirs[0] = l.newDir(a.name + ">" + b.name)
+	l.dirs[1] = l.newDir(b.name + ">" + a.name)
+
+	// The face of a node to the other node sends frames in the direction from the node
+	aToB := face.MakeMemoryTransport(b.uri, a.uri, defn.NonLocal, l.dirs[0].send)
+	bToA := face.MakeMemoryTransport(a.uri, b.uri, defn.NonLocal, l.dirs[1].send)
+	l.dirs[0].dst, l.dirs[1].dst = bToA, aToB
+	a.addFace(aToB, face.MakeNDNLPLinkServiceOptions())
+	b.addFace(bToA, face.MakeNDNLPLinkServiceOptions())
+
+	return l
+}
+
+// newDir creates a direction of the link.
+func (l *Link) newDir(label string) *linkDir {
+	seed := fnv.New64a()
+	seed.Write([]byte(label))
+
+	d := &linkDir{
+		link: l,
+		rng:  rand.New(rand.NewPCG(seed.Sum64(), 0)),
+	}
+	d.frames = newQueue(d.deliver)
+	return d
+}
+
+// Config returns the configuration of the link.
+func (l *Link) Config() LinkConfig {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.config
+}
+
+// SetConfig changes the configuration of the link. Frames in transit are not affected.
+func (l *Link) SetConfig(config LinkConfig) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.config = config
+}
+
+// IsUp returns whether the link is up.
+func (l *Link) IsUp() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.up
+}
+
+// SetUp brings the link up or down. The faces of the link follow the state of the
+// link, and frames in transit are lost when the link goes down.
+func (l *Link) SetUp(up bool) {
+	l.mutex.Lock()
+	if l.up == up {
+		l.mutex.Unlock()
+		return
+	}
+	l.up = up
+	if !up {
+		l.epoch++
+	}
+	l.mutex.Unlock()
+
+	for _, d := range l.dirs {
+		d.dst.SetUp(up)
+	}
+}
+
+// Stats returns the counters of the link.
+func (l *Link) Stats() LinkStats {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	stats := LinkStats{}
+	for _, d := range l.dirs {
+		stats.Sent += d.stats.Sent
+		stats.Lost += d.stats.Lost
+		stats.Delivered += d.stats.Delivered
+	}
+	return stats
+}
+
+// close stops the delivery of frames.
+func (l *Link) close() {
+	for _, d := range l.dirs {
+		d.frames.close()
+	}
+}
+
+// send transmits a frame. The frame arrives after it is serialized behind the
+// frames before it, and propagated over the link.
+func (d *linkDir) send(frame []byte) {
+	l := d.link
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	d.stats.Sent++
+	if !l.up || d.rng.Float64() < l.config.Loss {
+		d.stats.Lost++
+		return
+	}
+
+	now := time.Now()
+	start := now
+	if d.busy.After(start) {
+		start = d.busy
+	}
+	if l.config.Bandwidth > 0 {
+		bits := uint64(len(frame)) * 8
+		d.busy = start.Add(time.Duration(bits * uint64(time.Second) / l.config.Bandwidth))
+	} else {
+		d.busy = start
+	}
+
+	d.frames.push(linkFrame{wire: frame, arrival: d.busy.Add(l.config.Delay), epoch: l.epoch})
+}
+
+// deliver waits for a frame to arrive, and passes it to the face of the receiving forwarder.
+func (d *linkDir) deliver(frame linkFrame) {
+	time.Sleep(time.Until(frame.arrival))
+
+	l := d.link
+	l.mutex.Lock()
+	if !l.up || frame.epoch != l.epoch {
+		d.stats.Lost++
+		l.mutex.Unlock()
+		return
+	}
+	d.stats.Delivered++
+	l.mutex.Unlock()
+
+	d.dst.Receive(frame.wire)
+}
@@ -0,0 +1,192 @@
+// Package emu emulates a network of NDN nodes in a single process, for testing.
+//
+// Each node runs the forwarding threads, faces and management of YaNFD, and
+// std engines and ndn-dv routers connected to it with in-memory faces. Nodes are
+// connected by links with a delay, a loss rate and a bandwidth, which carry the
+// NDNLP frames of the faces of the nodes.
+//
+// Networks run inside a testing/synctest bubble, where time is virtual and only
+// advances when every goroutine of the test is blocked. Runs are therefore
+// deterministic and fast, regardless of the configured delays and timers.
+//
+// The nodes share the YaNFD configuration in core.C, which must not change
+// while a network runs.
+package emu
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/named-data/ndnd/fw/table"
+)
+
+// initTables initializes the table configuration shared by the forwarders of all networks.
+var initTables sync.Once
+
+// Network is an emulated network of nodes.
+type Network struct {
+	mutex   sync.Mutex
+	nodes   map[string]*Node
+	order   []*Node
+	links   map[[2]string]*Link
+	stopped bool
+}
+
+// NewNetwork creates an empty network. Networks must be created inside a
+// synctest bubble, usually with Test.
+func NewNetwork() *Network {
+	initTables.Do(table.Initialize)
+	return &Network{
+		nodes: make(map[string]*Node),
+		links: make(map[[2]string]*Link),
+	}
+}
+
+// Test runs a test with a new network in a synctest bubble.
+// The network is stopped when the test function returns.
+func Test(t *testing.T, f func(t *testing.T, net *Network)) {
+	synctest.Test(t, func(t *testing.T) {
+		net := NewNetwork()
+		defer net.Stop()
+		f(t, net)
+	})
+}
+
+// AddNode adds a node to the network. It panics if the name is already used.
+// The faces of other nodes to the node have the URI udp4://10.x.y.z:6363,
+// with an address in 10.0.0.0/8 assigned in the order nodes are added.
+func (net *Network) AddNode(name string) *Node {
+	net.mutex.Lock()
+	defer net.mutex.Unlock()
+
+	if _, ok := net.nodes[name]; ok {
+		panic(fmt.Errorf("duplicate node %s", name))
+	}
+	id := len(net.order) + 1
+	n := newNode(net, name, fmt.Sprintf("10.%d.%d.%d", id>>16&0xff, id>>8&0xff, id&0xff))
+	net.nodes[name] = n
+	net.order = append(net.order, n)
+	return n
+}
+
+// Node returns the node with a name, or nil if it does not exist.
+func (net *Network) Node(name string) *Node {
+	net.mutex.Lock()
+	defer net.mutex.Unlock()
+	return net.nodes[name]
+}
+
+// Nodes returns the nodes of the network, in the order they were added.
+func (net *Network) Nodes() []*Node {
+	net.mutex.Lock()
+	defer net.mutex.Unlock()
+	return slices.Clone(net.order)
+}
+
+// Connect links two nodes. It panics if the nodes are already linked.
+func (net *Network) Connect(a *Node, b *Node, config LinkConfig) *Link {
+	net.mutex.Lock()
+	defer net.mutex.Unlock()
+
+	key := linkKey(a, b)
+	if a == b {
+		panic(fmt.Errorf("cannot link %s to itself", a.name))
+	}
+	if _, ok := net.links[key]; ok {
+		panic(fmt.Errorf("duplicate link %s-%s", a.name, b.name))
+	}
+	link := newLink(a, b, config)
+	net.links[key] = link
+	return link
+}
+
+// Link returns the link between two nodes, or nil if they are not linked.
+func (net *Network) Link(a *Node, b *Node) *Link {
+	net.mutex.Lock()
+	defer net.mutex.Unlock()
+	return net.links[linkKey(a, b)]
+}
+
+// StartDV starts an ndn-dv router on every node, with the configuration
+// returned by Node.DVConfig for the network name.
+func (net *Network) StartDV(network string) error {
+	for _, n := range net.Nodes() {
+		if _, err := n.StartDV(n.DVConfig(network)); err != nil {
+			return fmt.Errorf("%s: %w", n.name, err)
+		}
+	}
+	return nil
+}
+
+// Advance lets virtual time pass, and waits for the network to settle.
+func (net *Network) Advance(d time.Duration) {
+	time.Sleep(d)
+	synctest.Wait()
+}
+
+// Settle waits until every goroutine of the network is blocked,
+// without letting virtual time pass.
+func (net *Network) Settle() {
+	synctest.Wait()
+}
+
+// Until advances virtual time until a condition holds, checking it every
+// 100ms. It returns false if the condition does not hold after the timeout.
+func (net *Network) Until(timeout time.Duration, cond func() bool) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		synctest.Wait()
+		if cond() {
+			return true
+		}
+		if !time.Now().Before(deadline) {
+			return false
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+// Stop stops every node and link of the network.
+func (net *Network) Stop() {
+	net.mutex.Lock()
+	if net.stopped {
+		net.mutex.Unlock()
+		return
+	}
+	net.stopped = true
+	nodes, links := net.order, net.links
+	net.mutex.Unlock()
+
+	for _, n := range nodes {
+		n.stop()
+	}
+	for _, link := range links {
+		link.close()
+	}
+}
+
+// peers returns the nodes linked to a node.
+func (net *Network) peers(n *Node) []*Node {
+	net.mutex.Lock()
+	defer net.mutex.Unlock()
+
+	peers := make([]*Node, 0)
+	for _, other := range net.order {
+		if _, ok := net.links[linkKey(n, other)]; ok && other != n {
+			peers = append(peers, other)
+		}
+	}
+	return peers
+}
+
+// linkKey returns the key of the link between two nodes.
+func linkKey(a *Node, b *Node) [2]string {
+	if a.name > b.name {
+		a, b = b, a
+	}
+	return [2]string{a.name, b.name}
+}
@@ -0,0 +1,211 @@
+package emu
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/named-data/ndnd/dv/config"
+	"github.com/named-data/ndnd/dv/dv"
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/dispatch"
+	"github.com/named-data/ndnd/fw/face"
+	"github.com/named-data/ndnd/fw/fw"
+	fw_mgmt "github.com/named-data/ndnd/fw/mgmt"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	basic_engine "github.com/named-data/ndnd/std/engine/basic"
+	"github.com/named-data/ndnd/std/ndn"
+)
+
+// NodeThreads is the number of forwarding threads of each node.
+const NodeThreads = 1
+
+// NextHop is a nexthop of a FIB entry.
+type NextHop struct {
+	FaceID uint64
+	Cost   uint64
+}
+
+// Node is an emulated host with a YaNFD forwarder. Engines and ndn-dv routers
+// on the node are connected to the forwarder with local faces.
+type Node struct {
+	net  *Network
+	name string
+	// uri is the URI of the faces of other nodes to this node
+	uri *defn.URI
+
+	fwd      *dispatch.Forwarder
+	faces    *face.Table
+	threads  []*fw.Thread
+	mgmt     *fw_mgmt.Thread
+	mgmtDone chan struct{}
+
+	mutex   sync.Mutex
+	engines []ndn.Engine
+	routers []*nodeRouter
+	// Number of application faces created on the node
+	nAppFaces int
+}
+
+// nodeRouter is an ndn-dv router running on a node.
+type nodeRouter struct {
+	router *dv.Router
+	engine ndn.Engine
+	// done is closed when the router has stopped
+	done chan struct{}
+}
+
+// newNode creates a node with the address, and starts its forwarder.
+func newNode(net *Network, name string, addr string) *Node {
+	fib := table.NewFibStrategyTable()
+	fwd := dispatch.NewForwarder(fib, table.NewRibTable(fib))
+	n := &Node{
+		net:      net,
+		name:     name,
+		uri:      defn.MakeUDPFaceURI(4, addr, 6363),
+		fwd:      fwd,
+		faces:    face.NewTable(fwd),
+		threads:  make([]*fw.Thread, NodeThreads),
+		mgmtDone: make(chan struct{}),
+	}
+
+	fwForDispatch := make([]dispatch.FWThread, len(n.threads))
+	for i := range n.threads {
+		n.threads[i] = fw.NewThread(i, fwd)
+		fwForDispatch[i] = n.threads[i]
+	}
+	fwd.InitializeFWThreads(fwForDispatch)
+
+	n.mgmt = fw_mgmt.MakeMgmtThread(n.faces, n.threads)
+	go func() {
+		defer close(n.mgmtDone)
+		n.mgmt.Run()
+	}()
+	for _, thread := range n.threads {
+		go thread.Run()
+	}
+	return n
+}
+
+// String returns the log identifier of the node.
+func (n *Node) String() string {
+	return "emu-node (" + n.name + ")"
+}
+
+// Name returns the name of the node.
+func (n *Node) Name() string {
+	return n.name
+}
+
+// URI returns the URI of the faces of other nodes to this node.
+func (n *Node) URI() string {
+	return n.uri.String()
+}
+
+// NewEngine starts an engine connected to the forwarder of the node.
+// The engine is stopped with the network.
+func (n *Node) NewEngine() ndn.Engine {
+	engine := basic_engine.NewEngine(newAppFace(n), basic_engine.NewTimer())
+	if err := engine.Start(); err != nil {
+		panic(fmt.Errorf("[BUG] unable to start emulated engine: %w", err))
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.engines = append(n.engines, engine)
+	return engine
+}
+
+// DVConfig returns an ndn-dv configuration for the node in the network, with
+// security disabled and a neighbor for each link of the node. The router name
+// is the network name followed by the node name.
+func (n *Node) DVConfig(network string) *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.Network = network
+	cfg.Router = network + "/" + n.name
+	cfg.KeyChainUri = "insecure"
+	for _, peer := range n.net.peers(n) {
+		cfg.Neighbors = append(cfg.Neighbors, config.Neighbor{Uri: peer.URI()})
+	}
+	return cfg
+}
+
+// StartDV starts an ndn-dv router on the node. The router is stopped with the network.
+func (n *Node) StartDV(cfg *config.Config) (*dv.Router, error) {
+	engine := n.NewEngine()
+	router, err := dv.NewRouter(cfg, engine)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &nodeRouter{router: router, engine: engine, done: make(chan struct{})}
+	go func() {
+		defer close(r.done)
+		if err := router.Start(); err != nil {
+			panic(fmt.Errorf("unable to start dv router on %s: %w", n.name, err))
+		}
+	}()
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.routers = append(n.routers, r)
+	return router, nil
+}
+
+// NextHops returns the nexthops of the FIB entry used for a name, by increasing cost.
+func (n *Node) NextHops(name enc.Name) []NextHop {
+	nexthops := make([]NextHop, 0)
+	for _, nh := range n.fwd.Fib.FindNextHopsEnc(name) {
+		nexthops = append(nexthops, NextHop{FaceID: nh.Nexthop, Cost: nh.Cost})
+	}
+	slices.SortFunc(nexthops, func(a, b NextHop) int {
+		return cmp.Or(cmp.Compare(a.Cost, b.Cost), cmp.Compare(a.FaceID, b.FaceID))
+	})
+	return nexthops
+}
+
+// FaceTo returns the ID of the face of the node to a neighbor, or 0 if they are not linked.
+func (n *Node) FaceTo(peer *Node) uint64 {
+	if link := n.faces.GetByURI(peer.uri); link != nil {
+		return link.FaceID()
+	}
+	return 0
+}
+
+// addFace creates a face of the forwarder over the transport.
+func (n *Node) addFace(transport *face.MemoryTransport, options face.NDNLPLinkServiceOptions) {
+	link := face.MakeNDNLPLinkService(transport, options)
+	link.SetFaceTable(n.faces)
+	link.Run(nil)
+}
+
+// stop stops the routers and engines of the node, and then its forwarder.
+func (n *Node) stop() {
+	n.mutex.Lock()
+	routers, engines := n.routers, n.engines
+	n.routers, n.engines = nil, nil
+	n.mutex.Unlock()
+
+	for _, r := range routers {
+		r.router.Stop()
+		<-r.done
+	}
+	for _, engine := range engines {
+		engine.Stop()
+	}
+
+	// Management quits with its internal face
+	for _, link := range n.faces.GetAll() {
+		link.Close()
+	}
+	<-n.mgmtDone
+
+	for _, thread := range n.threads {
+		thread.TellToQuit()
+	}
+	for _, thread := range n.threads {
+		<-thread.HasQuit
+	}
+}
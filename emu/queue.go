@@ -0,0 +1,74 @@
+package emu
+
+import "sync"
+
+// queue is an unbounded FIFO that is drained by its own goroutine, so that
+// producers never block. Nodes only exchange packets through queues, which
+// rules out deadlocks between forwarders, links and engines.
+type queue[T any] struct {
+	mutex  sync.Mutex
+	items  []T
+	closed bool
+	// notify has a pending signal when items were pushed
+	notify chan struct{}
+	// done is closed when the goroutine has quit
+	done chan struct{}
+}
+
+// newQueue creates a queue, and starts delivering its items to the handler in order.
+func newQueue[T any](handler func(T)) *queue[T] {
+	q := &queue[T]{
+		notify: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+	go q.run(handler)
+	return q
+}
+
+// push appends an item to the queue. Returns false if the queue is closed.
+func (q *queue[T]) push(item T) bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.closed {
+		return false
+	}
+	q.items = append(q.items, item)
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// close stops the queue, dropping the pending items, and waits for the goroutine to quit.
+func (q *queue[T]) close() {
+	q.mutex.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.notify)
+	}
+	q.mutex.Unlock()
+	<-q.done
+}
+
+// run delivers the items until the queue is closed.
+func (q *queue[T]) run(handler func(T)) {
+	defer close(q.done)
+	for range q.notify {
+		for {
+			q.mutex.Lock()
+			if q.closed || len(q.items) == 0 {
+				q.mutex.Unlock()
+				break
+			}
+			item := q.items[0]
+			var zero T
+			q.items[0] = zero
+			q.items = q.items[1:]
+			q.mutex.Unlock()
+
+			handler(item)
+		}
+	}
+}
@@ -42,11 +42,18 @@ func run(cmd *cobra.Command, args []string) {
 	yanfd := NewYaNFD(config)
 	yanfd.Start()
 
-	// set up signal handler channel and wait for interrupt
+	// set up signal handler channel and wait for interrupt,
+	// reloading the configuration file on hangup
 	sigChannel := make(chan os.Signal, 1)
-	signal.Notify(sigChannel, os.Interrupt, syscall.SIGTERM)
-	receivedSig := <-sigChannel
-	core.Log.Info(yanfd, "Received signal - exit", "signal", receivedSig)
+	signal.Notify(sigChannel, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+	for receivedSig := range sigChannel {
+		if receivedSig == syscall.SIGHUP {
+			yanfd.Reload(configfile)
+			continue
+		}
+		core.Log.Info(yanfd, "Received signal - exit", "signal", receivedSig)
+		break
+	}
 
 	yanfd.Stop()
 }
@@ -0,0 +1,326 @@
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"runtime"
+	"slices"
+
+	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/face"
+)
+
+// Listeners and multicast faces are started and stopped in groups, one for each
+// enabled setting of the configuration, so they can be changed on reload.
+// Each start function returns the number of listeners and faces created.
+
+// startTcpListeners creates the unicast TCP listeners.
+func (y *YaNFD) startTcpListeners() int {
+	tcpAddrs := []*net.TCPAddr{{
+		IP:   net.IPv4zero,
+		Port: face.CfgTCPUnicastPort(),
+	}, {
+		IP:   net.IPv6zero,
+		Port: face.CfgTCPUnicastPort(),
+	}}
+
+	for _, tcpAddr := range tcpAddrs {
+		uri := fmt.Sprintf("tcp://%s", tcpAddr)
+		tcpListener, err := face.MakeTCPListener(defn.DecodeURIString(uri))
+		if err != nil {
+			core.Log.Error(y, "Unable to create TCP listener", "uri", uri, "err", err)
+		} else {
+			go tcpListener.Run()
+			y.tcpListeners = append(y.tcpListeners, tcpListener)
+			core.Log.Info(y, "Created unicast TCP listener", "uri", uri)
+		}
+	}
+	return len(y.tcpListeners)
+}
+
+// stopTcpListeners closes the unicast TCP listeners.
+func (y *YaNFD) stopTcpListeners() {
+	for _, tcpListener := range y.tcpListeners {
+		tcpListener.Close()
+	}
+	y.tcpListeners = nil
+}
+
+// startUdpListeners creates the unicast UDP listeners.
+func (y *YaNFD) startUdpListeners() int {
+	createUdpFace := func(ipAddr net.IP, zone string) {
+		uri := fmt.Sprintf("udp://%s", &net.UDPAddr{
+			IP:   ipAddr,
+			Port: face.CfgUDPUnicastPort(),
+			Zone: zone,
+		})
+
+		udpListener, err := face.MakeUDPListener(defn.DecodeURIString(uri))
+		if err != nil {
+			core.Log.Error(y, "Unable to create UDP listener", "uri", uri, "err", err)
+		} else {
+			go udpListener.Run()
+			y.udpListeners = append(y.udpListeners, udpListener)
+			core.Log.Info(y, "Created unicast UDP listener", "uri", uri)
+		}
+	}
+
+	// On Linux and Windows, create a single UDP face for all interfaces.
+	// On macOS, create a UDP face for each interface.
+	// Do not make a single listener here for all interfaces.
+	// https://github.com/named-data/ndnd/issues/144
+	if runtime.GOOS != "darwin" {
+		createUdpFace(net.IPv4zero, "")
+		createUdpFace(net.IPv6zero, "")
+	} else {
+		y.forEachInterfaceAddr(func(iface net.Interface, addr *net.IPNet) {
+			createUdpFace(addr.IP, iface.Name)
+		})
+	}
+	return len(y.udpListeners)
+}
+
+// stopUdpListeners closes the unicast UDP listeners.
+func (y *YaNFD) stopUdpListeners() {
+	for _, udpListener := range y.udpListeners {
+		udpListener.Close()
+	}
+	y.udpListeners = nil
+}
+
+// startUdpMulticastFaces creates multicast UDP faces on each non-loopback interface.
+func (y *YaNFD) startUdpMulticastFaces() int {
+	y.forEachInterfaceAddr(func(iface net.Interface, addr *net.IPNet) {
+		if addr.IP.IsLoopback() {
+			return
+		}
+
+		uri := fmt.Sprintf("udp://%s", &net.UDPAddr{
+			IP:   addr.IP,
+			Port: face.CfgUDPMulticastPort(),
+			Zone: iface.Name,
+		})
+
+		multicastUDPTransport, err := face.MakeMulticastUDPTransport(defn.DecodeURIString(uri))
+		if err != nil {
+			core.Log.Error(y, "Unable to create MulticastUDPTransport", "uri", uri, "err", err)
+			return
+		}
+		link := face.MakeNDNLPLinkService(multicastUDPTransport, face.MakeNDNLPLinkServiceOptions())
+		link.Run(nil)
+
+		y.udpMulticastFaces = append(y.udpMulticastFaces, link)
+		core.Log.Info(y, "Created multicast UDP face", "uri", uri)
+	})
+	return len(y.udpMulticastFaces)
+}
+
+// stopUdpMulticastFaces closes the multicast UDP faces.
+func (y *YaNFD) stopUdpMulticastFaces() {
+	for _, link := range y.udpMulticastFaces {
+		link.Close()
+	}
+	y.udpMulticastFaces = nil
+}
+
+// forEachInterfaceAddr calls fn for each address of the network interfaces that are up.
+func (y *YaNFD) forEachInterfaceAddr(fn func(iface net.Interface, addr *net.IPNet)) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		core.Log.Error(y, "Unable to access network interfaces", "err", err)
+	}
+
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagUp == 0 {
+			core.Log.Info(y, "Skipping interface because not up", "iface", iface.Name)
+			continue
+		}
+
+		addrs, err := iface.Addrs()
+		if err != nil {
+			core.Log.Error(y, "Unable to access addresses on network interface", "iface", iface.Name, "err", err)
+			continue
+		}
+
+		for _, addr := range addrs {
+			fn(iface, addr.(*net.IPNet))
+		}
+	}
+}
+
+// startEtherMulticastFaces creates multicast Ethernet faces on each selected interface.
+func (y *YaNFD) startEtherMulticastFaces() int {
+	for _, iface := range y.etherInterfaces() {
+		uri := defn.MakeDevFaceURI(iface.Name)
+		multicastEtherTransport, err := face.MakeMulticastEthernetTransport(uri)
+		if err != nil {
+			core.Log.Error(y, "Unable to create multicast Ethernet face", "uri", uri, "err", err)
+			continue
+		}
+		link := face.MakeNDNLPLinkService(multicastEtherTransport, face.MakeNDNLPLinkServiceOptions())
+		link.Run(nil)
+
+		y.etherMulticastFaces = append(y.etherMulticastFaces, link)
+		core.Log.Info(y, "Created multicast Ethernet face", "uri", uri)
+	}
+	return len(y.etherMulticastFaces)
+}
+
+// stopEtherMulticastFaces closes the multicast Ethernet faces.
+func (y *YaNFD) stopEtherMulticastFaces() {
+	for _, link := range y.etherMulticastFaces {
+		link.Close()
+	}
+	y.etherMulticastFaces = nil
+}
+
+// startEtherListeners creates on-demand unicast Ethernet listeners on each selected interface.
+func (y *YaNFD) startEtherListeners() int {
+	for _, iface := range y.etherInterfaces() {
+		uri := defn.MakeDevFaceURI(iface.Name)
+		etherListener, err := face.MakeEthernetListener(uri)
+		if err != nil {
+			core.Log.Error(y, "Unable to create Ethernet listener", "uri", uri, "err", err)
+			continue
+		}
+		go etherListener.Run()
+		y.etherListeners = append(y.etherListeners, etherListener)
+		core.Log.Info(y, "Created Ethernet listener", "uri", uri)
+	}
+	return len(y.etherListeners)
+}
+
+// stopEtherListeners closes the unicast Ethernet listeners.
+func (y *YaNFD) stopEtherListeners() {
+	for _, etherListener := range y.etherListeners {
+		etherListener.Close()
+	}
+	y.etherListeners = nil
+}
+
+// etherInterfaces returns the interfaces selected for Ethernet faces.
+func (y *YaNFD) etherInterfaces() []net.Interface {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		core.Log.Error(y, "Unable to access network interfaces", "err", err)
+	}
+
+	selected := make([]net.Interface, 0, len(ifaces))
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagUp == 0 || iface.Flags&net.FlagLoopback != 0 || len(iface.HardwareAddr) != 6 {
+			continue
+		}
+		if len(core.C.Faces.Ether.Interfaces) > 0 && !slices.Contains(core.C.Faces.Ether.Interfaces, iface.Name) {
+			continue
+		}
+		selected = append(selected, iface)
+	}
+	return selected
+}
+
+// startUnixListener creates the Unix stream listener.
+func (y *YaNFD) startUnixListener() int {
+	uri := defn.MakeUnixFaceURI(face.CfgUnixSocketPath())
+	unixListener, err := face.MakeUnixStreamListener(uri)
+	if err != nil {
+		core.Log.Error(y, "Unable to create Unix stream listener", "path", face.CfgUnixSocketPath(), "err", err)
+		return 0
+	}
+
+	go unixListener.Run()
+	y.unixListener = unixListener
+	core.Log.Info(y, "Created unix stream listener", "uri", uri)
+	return 1
+}
+
+// stopUnixListener closes the Unix stream listener.
+func (y *YaNFD) stopUnixListener() {
+	if y.unixListener != nil {
+		y.unixListener.Close()
+		y.unixListener = nil
+	}
+}
+
+// startWebSocketListener creates the WebSocket listener.
+func (y *YaNFD) startWebSocketListener() int {
+	cfg := face.WebSocketListenerConfig{
+		Bind:       core.C.Faces.WebSocket.Bind,
+		Port:       core.C.Faces.WebSocket.Port,
+		TLSEnabled: core.C.Faces.WebSocket.TlsEnabled,
+		TLSCert:    core.C.ResolveRelPath(core.C.Faces.WebSocket.TlsCert),
+		TLSKey:     core.C.ResolveRelPath(core.C.Faces.WebSocket.TlsKey),
+	}
+
+	wsListener, err := face.NewWebSocketListener(cfg)
+	if err != nil {
+		core.Log.Error(y, "Unable to create WebSocket Listener", "cfg", cfg, "err", err)
+		return 0
+	}
+
+	go wsListener.Run()
+	y.wsListener = wsListener
+	core.Log.Info(y, "Created WebSocket listener", "uri", cfg.URL().String())
+	return 1
+}
+
+// stopWebSocketListener closes the WebSocket listener.
+func (y *YaNFD) stopWebSocketListener() {
+	if y.wsListener != nil {
+		y.wsListener.Close()
+		y.wsListener = nil
+	}
+}
+
+// startHTTP3Listener creates the HTTP/3 WebTransport listener.
+func (y *YaNFD) startHTTP3Listener() int {
+	c := core.C.Faces.HTTP3
+	cfg := face.HTTP3ListenerConfig{
+		Bind:    c.Bind,
+		Port:    c.Port,
+		TLSCert: c.TlsCert,
+		TLSKey:  c.TlsKey,
+	}
+
+	h3Listener, err := face.NewHTTP3Listener(cfg)
+	if err != nil {
+		core.Log.Error(y, "Unable to create HTTP/3 WebTransport Listener", "cfg", cfg, "err", err)
+		return 0
+	}
+
+	go h3Listener.Run()
+	y.h3Listener = h3Listener
+	core.Log.Info(y, "Created HTTP/3 WebTransport listener", "uri", cfg.URL().String())
+	return 1
+}
+
+// stopHTTP3Listener closes the HTTP/3 WebTransport listener.
+func (y *YaNFD) stopHTTP3Listener() {
+	if y.h3Listener != nil {
+		y.h3Listener.Close()
+		y.h3Listener = nil
+	}
+}
+
+// startCaptureListener creates the packet capture listener.
+// It is not a face listener, and is not counted as one.
+func (y *YaNFD) startCaptureListener() error {
+	capListener := face.MakeCaptureListener(face.CfgCaptureSocketPath())
+	if err := capListener.Listen(); err != nil {
+		core.Log.Error(y, "Unable to create capture listener", "path", face.CfgCaptureSocketPath(), "err", err)
+		return err
+	}
+
+	go capListener.Run()
+	y.capListener = capListener
+	core.Log.Info(y, "Created capture listener", "path", face.CfgCaptureSocketPath())
+	return nil
+}
+
+// stopCaptureListener closes the packet capture listener.
+func (y *YaNFD) stopCaptureListener() {
+	if y.capListener != nil {
+		y.capListener.Close()
+		y.capListener = nil
+	}
+}
@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/face"
+	"github.com/named-data/ndnd/fw/fw"
+	"github.com/named-data/ndnd/fw/table"
+)
+
+// MetricsContentType is the content type of the OpenMetrics text format.
+const MetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// MetricsPrefix is the prefix of the names of all exported metrics.
+const MetricsPrefix = "ndnd_fw_"
+
+// Metrics exports the forwarder counters over HTTP in the OpenMetrics text format.
+type Metrics struct {
+	config  *core.Config
+	server  *http.Server
+	threads []*fw.Thread
+}
+
+// metric describes a metric family whose value is read from a counter struct.
+type metric[T any] struct {
+	name  string
+	typ   string
+	help  string
+	value func(T) uint64
+}
+
+// threadMetrics are the metrics exported for each forwarding thread.
+var threadMetrics = []metric[defn.FWThreadCounters]{
+	{"pit_entries", "gauge", "Number of PIT entries",
+		func(c defn.FWThreadCounters) uint64 { return uint64(c.NPitEntries) }},
+	{"cs_entries", "gauge", "Number of CS entries",
+		func(c defn.FWThreadCounters) uint64 { return uint64(c.NCsEntries) }},
+	{"measurements_entries", "gauge", "Number of Measurements entries",
+		func(c defn.FWThreadCounters) uint64 { return uint64(c.NMeasurementsEntries) }},
+	{"in_interests", "counter", "Interests received",
+		func(c defn.FWThreadCounters) uint64 { return c.NInInterests }},
+	{"in_data", "counter", "Data packets received",
+		func(c defn.FWThreadCounters) uint64 { return c.NInData }},
+	{"in_nacks", "counter", "Nacks received",
+		func(c defn.FWThreadCounters) uint64 { return c.NInNacks }},
+	{"out_interests", "counter", "Interests sent",
+		func(c defn.FWThreadCounters) uint64 { return c.NOutInterests }},
+	{"out_data", "counter", "Data packets sent",
+		func(c defn.FWThreadCounters) uint64 { return c.NOutData }},
+	{"out_nacks", "counter", "Nacks sent",
+		func(c defn.FWThreadCounters) uint64 { return c.NOutNacks }},
+	{"satisfied_interests", "counter", "Interests satisfied by Data",
+		func(c defn.FWThreadCounters) uint64 { return c.NSatisfiedInterests }},
+	{"unsatisfied_interests", "counter", "Interests expired without Data",
+		func(c defn.FWThreadCounters) uint64 { return c.NUnsatisfiedInterests }},
+	{"cs_hits", "counter", "Interests satisfied from the CS",
+		func(c defn.FWThreadCounters) uint64 { return c.NCsHits }},
+	{"cs_misses", "counter", "Interests not found in the CS",
+		func(c defn.FWThreadCounters) uint64 { return c.NCsMisses }},
+}
+
+// faceMetrics are the metrics exported for each face.
+var faceMetrics = []metric[face.LinkService]{
+	{"face_in_interests", "counter", "Interests received on the face",
+		func(f face.LinkService) uint64 { return f.NInInterests() }},
+	{"face_in_data", "counter", "Data packets received on the face",
+		func(f face.LinkService) uint64 { return f.NInData() }},
+	{"face_in_nacks", "counter", "Nacks received on the face",
+		func(f face.LinkService) uint64 { return f.NInNacks() }},
+	{"face_in_bytes", "counter", "Link-layer bytes received on the face",
+		func(f face.LinkService) uint64 { return f.NInBytes() }},
+	{"face_out_interests", "counter", "Interests sent on the face",
+		func(f face.LinkService) uint64 { return f.NOutInterests() }},
+	{"face_out_data", "counter", "Data packets sent on the face",
+		func(f face.LinkService) uint64 { return f.NOutData() }},
+	{"face_out_nacks", "counter", "Nacks sent on the face",
+		func(f face.LinkService) uint64 { return f.NOutNacks() }},
+	{"face_out_bytes", "counter", "Link-layer bytes sent on the face",
+		func(f face.LinkService) uint64 { return f.NOutBytes() }},
+}
+
+// strategyMetrics are the metrics exported for each strategy of each forwarding thread.
+var strategyMetrics = []metric[defn.StrategyCounters]{
+	{"strategy_interests", "counter", "Interests passed to the strategy",
+		func(c defn.StrategyCounters) uint64 { return c.NInterests }},
+	{"strategy_data", "counter", "Data packets passed to the strategy",
+		func(c defn.StrategyCounters) uint64 { return c.NData }},
+	{"strategy_nacks", "counter", "Nacks passed to the strategy",
+		func(c defn.StrategyCounters) uint64 { return c.NNacks }},
+	{"strategy_cs_hits", "counter", "CS hits passed to the strategy",
+		func(c defn.StrategyCounters) uint64 { return c.NCsHits }},
+}
+
+// NewMetrics creates the metrics exporter.
+func NewMetrics(config *core.Config) *Metrics {
+	return &Metrics{config: config}
+}
+
+// String returns the log identifier of the metrics exporter.
+func (m *Metrics) String() string {
+	return "metrics"
+}
+
+// Start serves the metrics endpoint of the forwarding threads, if an address is configured.
+func (m *Metrics) Start(threads []*fw.Thread) {
+	m.threads = threads
+
+	addr := m.config.Core.MetricsAddress
+	if addr == "" {
+		return
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		core.Log.Error(m, "Unable to start metrics endpoint", "addr", addr, "err", err)
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /metrics", m.serve)
+	m.server = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		err := m.server.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			core.Log.Error(m, "Metrics endpoint failed", "err", err)
+		}
+	}()
+	core.Log.Info(m, "Serving metrics", "url", fmt.Sprintf("http://%s/metrics", listener.Addr()))
+}
+
+// Stop closes the metrics endpoint.
+func (m *Metrics) Stop() {
+	if m.server != nil {
+		m.server.Close()
+		m.server = nil
+	}
+}
+
+// serve writes all metrics in the OpenMetrics text format.
+func (m *Metrics) serve(w http.ResponseWriter, r *http.Request) {
+	var b bytes.Buffer
+	writeMetrics(&b, m.threads)
+	w.Header().Set("Content-Type", MetricsContentType)
+	w.Write(b.Bytes())
+}
+
+// writeMetrics writes the thread, strategy, face and table metrics, and the EOF marker.
+func writeMetrics(b *bytes.Buffer, fwThreads []*fw.Thread) {
+	// Per-thread metrics
+	threads := make([]defn.FWThreadCounters, len(fwThreads))
+	strategies := make([][]defn.StrategyCounters, len(fwThreads))
+	for i, thread := range fwThreads {
+		threads[i] = thread.Counters()
+		strategies[i] = thread.StrategyCounters()
+		slices.SortFunc(strategies[i], func(a, b defn.StrategyCounters) int {
+			return a.Strategy.Compare(b.Strategy)
+		})
+	}
+
+	for _, metric := range threadMetrics {
+		writeFamily(b, metric.name, metric.typ, metric.help)
+		for i, counters := range threads {
+			writeSample(b, metric.name, metric.typ, metric.value(counters),
+				"thread", strconv.Itoa(i))
+		}
+	}
+
+	for _, metric := range strategyMetrics {
+		writeFamily(b, metric.name, metric.typ, metric.help)
+		for i, counters := range strategies {
+			for _, c := range counters {
+				writeSample(b, metric.name, metric.typ, metric.value(c),
+					"thread", strconv.Itoa(i), "strategy", c.Strategy.String())
+			}
+		}
+	}
+
+	// Per-face metrics
+	faces := face.FaceTable.GetAll()
+	slices.SortFunc(faces, func(a, b face.LinkService) int {
+		return cmp.Compare(a.FaceID(), b.FaceID())
+	})
+
+	for _, metric := range faceMetrics {
+		writeFamily(b, metric.name, metric.typ, metric.help)
+		for _, f := range faces {
+			writeSample(b, metric.name, metric.typ, metric.value(f),
+				"face", strconv.FormatUint(f.FaceID(), 10),
+				"remote", f.RemoteURI().String(),
+				"local", f.LocalURI().String())
+		}
+	}
+
+	// Table metrics
+	writeGauge(b, "faces", "Number of faces", uint64(len(faces)))
+	writeGauge(b, "fib_entries", "Number of FIB entries", uint64(table.FibStrategyTable.GetNumFIBEntries()))
+	writeGauge(b, "rib_entries", "Number of RIB entries", uint64(len(table.Rib.GetAllEntries())))
+	writeGauge(b, "strategy_choice_entries", "Number of strategy choice entries",
+		uint64(len(table.FibStrategyTable.GetAllForwardingStrategies())))
+	writeGauge(b, "cs_total_entries", "Number of CS entries of all threads", uint64(table.CsTotalEntries()))
+	writeGauge(b, "cs_total_bytes", "Size in bytes of the CS entries of all threads", uint64(table.CsTotalBytes()))
+	writeGauge(b, "start_time_seconds", "Start time of the forwarder since the Unix epoch",
+		uint64(core.StartTimestamp.Unix()))
+
+	b.WriteString("# EOF\n")
+}
+
+// writeFamily writes the metadata of a metric family.
+func writeFamily(b *bytes.Buffer, name, typ, help string) {
+	fmt.Fprintf(b, "# TYPE %s%s %s\n", MetricsPrefix, name, typ)
+	fmt.Fprintf(b, "# HELP %s%s %s\n", MetricsPrefix, name, help)
+}
+
+// writeSample writes a sample of a metric family with the given label name and value pairs.
+// Samples of counters are suffixed with _total.
+func writeSample(b *bytes.Buffer, name, typ string, value uint64, labels ...string) {
+	b.WriteString(MetricsPrefix)
+	b.WriteString(name)
+	if typ == "counter" {
+		b.WriteString("_total")
+	}
+
+	if len(labels) > 0 {
+		b.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(labels[i])
+			b.WriteString(`="`)
+			b.WriteString(metricsLabelEscaper.Replace(labels[i+1]))
+			b.WriteByte('"')
+		}
+		b.WriteByte('}')
+	}
+
+	b.WriteByte(' ')
+	b.WriteString(strconv.FormatUint(value, 10))
+	b.WriteByte('\n')
+}
+
+// writeGauge writes a gauge family with a single sample.
+func writeGauge(b *bytes.Buffer, name, help string, value uint64) {
+	writeFamily(b, name, "gauge", help)
+	writeSample(b, name, "gauge", value)
+}
+
+// metricsLabelEscaper escapes label values in the OpenMetrics text format.
+var metricsLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
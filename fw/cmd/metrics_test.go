@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/dispatch"
+	"github.com/named-data/ndnd/fw/face"
+	"github.com/named-data/ndnd/fw/fw"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteSample(t *testing.T) {
+	tests := []struct {
+		name   string
+		typ    string
+		value  uint64
+		labels []string
+		out    string
+	}{
+		{"faces", "gauge", 3, nil, "ndnd_fw_faces 3\n"},
+		{"in_data", "counter", 7, []string{"thread", "1"}, "ndnd_fw_in_data_total{thread=\"1\"} 7\n"},
+		{"face_in_bytes", "counter", 0, []string{"face", "2", "remote", "udp4://[::1]:6363"},
+			"ndnd_fw_face_in_bytes_total{face=\"2\",remote=\"udp4://[::1]:6363\"} 0\n"},
+		{"strategy_data", "counter", 1, []string{"strategy", "a\"b\\c\nd"},
+			"ndnd_fw_strategy_data_total{strategy=\"a\\\"b\\\\c\\nd\"} 1\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			writeSample(&b, tt.name, tt.typ, tt.value, tt.labels...)
+			assert.Equal(t, tt.out, b.String())
+		})
+	}
+}
+
+// metricsLinePattern matches the lines of the OpenMetrics text format written by writeMetrics.
+var metricsLinePattern = regexp.MustCompile(
+	`^(# (TYPE|HELP) ndnd_fw_[a-z_]+ .+|ndnd_fw_[a-z_]+(\{[a-z]+="([^"\\]|\\.)*"(,[a-z]+="([^"\\]|\\.)*")*\})? [0-9]+)$`)
+
+func TestWriteMetrics(t *testing.T) {
+	table.Initialize()
+	face.Initialize(dispatch.NewForwarder(table.FibStrategyTable, table.Rib))
+
+	thread := fw.NewThread(0, face.FaceTable.Forwarder())
+	go thread.Run()
+	t.Cleanup(func() {
+		thread.TellToQuit()
+		<-thread.HasQuit
+	})
+
+	transport := face.MakeNullTransport()
+	link := face.MakeNullLinkService(transport)
+	link.Run(nil)
+	t.Cleanup(transport.Close)
+
+	// Count an Interest received by the thread
+	name := enc.Name{enc.NewGenericComponent("metrics")}
+	L3 := &defn.FwPacket{Interest: &defn.FwInterest{
+		NameV:             name,
+		NonceV:            optional.Some(uint32(1)),
+		InterestLifetimeV: optional.Some(time.Second),
+	}}
+	thread.QueueInterest(&defn.Pkt{
+		Name:           name,
+		L3:             L3,
+		Raw:            L3.Encode(),
+		IncomingFaceID: link.FaceID(),
+	})
+	require.Eventually(t, func() bool {
+		return thread.Counters().NInInterests == 1
+	}, time.Second, time.Millisecond)
+
+	var b bytes.Buffer
+	writeMetrics(&b, []*fw.Thread{thread})
+	out := b.String()
+
+	// All lines are valid, and the exposition ends with the EOF marker
+	require.True(t, strings.HasSuffix(out, "\n# EOF\n"))
+	lines := strings.Split(strings.TrimSuffix(out, "\n# EOF\n"), "\n")
+	types := make(map[string]string)
+	family := ""
+	for _, line := range lines {
+		require.Regexp(t, metricsLinePattern, line)
+
+		fields := strings.Fields(line)
+		switch {
+		case fields[1] == "TYPE":
+			assert.NotContains(t, types, fields[2], "duplicate family")
+			types[fields[2]] = fields[3]
+			family = fields[2]
+		case fields[1] == "HELP":
+			assert.Equal(t, family, fields[2], "help before type")
+		default:
+			// Samples follow the metadata of their family
+			sample, _, _ := strings.Cut(fields[0], "{")
+			if types[family] == "counter" {
+				assert.Equal(t, family+"_total", sample)
+			} else {
+				assert.Equal(t, family, sample)
+			}
+		}
+	}
+
+	// Counters and gauges have the values of the forwarder
+	assert.Contains(t, lines, `ndnd_fw_in_interests_total{thread="0"} 1`)
+	assert.Contains(t, lines, `ndnd_fw_pit_entries{thread="0"} 1`)
+	assert.Contains(t, lines, fmt.Sprintf(
+		`ndnd_fw_face_in_interests_total{face="%d",remote="null://",local="null://"} 0`, link.FaceID()))
+	assert.Contains(t, lines, fmt.Sprintf(`ndnd_fw_faces %d`, len(face.FaceTable.GetAll())))
+	assert.Equal(t, "counter", types["ndnd_fw_in_interests"])
+	assert.Equal(t, "gauge", types["ndnd_fw_cs_total_bytes"])
+}
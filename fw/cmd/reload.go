@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/utils/toolutils"
+)
+
+// Reload re-reads the configuration file and applies the changed settings that
+// can be applied while the forwarder is running: the log level, the enabled
+// listeners and packet capture, management over /localhop, the content store
+// limits and flags, and the network regions. Other changed settings are
+// reported, and take effect when the forwarder is restarted. Invalid settings,
+// and listeners that cannot be started or whose other settings changed, keep
+// their current value.
+//
+// The startup configuration in core.C is read without synchronization by all
+// goroutines, so it is never modified. The settings applied at runtime are
+// tracked in y.applied, which is only used by Reload.
+func (y *YaNFD) Reload(configfile string) {
+	core.Log.Info(y, "Reloading configuration", "file", configfile)
+
+	config := core.DefaultConfig()
+	if err := toolutils.LoadYaml(config, configfile); err != nil {
+		core.Log.Error(y, "Unable to reload configuration", "err", err)
+		return
+	}
+	config.Core.BaseDir = y.applied.Core.BaseDir
+
+	y.reloadLogging(config)
+	y.reloadListeners(config)
+	y.reloadMgmt(config)
+	y.reloadTables(config)
+
+	// Settings that were not applied differ from the running configuration
+	if restart := y.applied.Changes(config); len(restart) > 0 {
+		core.Log.Warn(y, "Some settings need a restart to take effect", "settings", restart)
+	}
+	core.Log.Info(y, "Reloaded configuration")
+}
+
+// reloadLogging applies the log level.
+func (y *YaNFD) reloadLogging(config *core.Config) {
+	if config.Core.LogLevel == y.applied.Core.LogLevel {
+		return
+	}
+
+	level, err := log.ParseLevel(config.Core.LogLevel)
+	if err != nil {
+		core.Log.Error(y, "Invalid log level", "log_level", config.Core.LogLevel, "err", err)
+		config.Core.LogLevel = y.applied.Core.LogLevel
+		return
+	}
+	core.Log.SetLevel(level)
+	y.applied.Core.LogLevel = config.Core.LogLevel
+	core.Log.Info(y, "Set log level", "log_level", level)
+}
+
+// reloadListeners starts or stops the listeners that were enabled or disabled.
+// Listeners that cannot be started stay disabled, and are started again on the next reload.
+// Listeners read their settings from the startup configuration, so they are not started
+// if other settings of their section changed, since these need a restart.
+func (y *YaNFD) reloadListeners(config *core.Config) {
+	toggle := func(key string, cur *bool, next *bool, start func() error, stop func()) {
+		if *cur == *next {
+			return
+		}
+		if *next {
+			if restart := sectionChanges(key, config); len(restart) > 0 {
+				core.Log.Error(y, "Unable to start enabled listeners with changed settings",
+					"setting", key, "restart", restart)
+				*next = *cur
+				return
+			}
+		}
+		if !*next {
+			stop()
+		} else if err := start(); err != nil {
+			core.Log.Error(y, "Unable to start enabled listeners", "setting", key, "err", err)
+			*next = *cur
+			return
+		}
+		*cur = *next
+	}
+
+	// Face listeners fail to start if none of them is created
+	listeners := func(start func() int) func() error {
+		return func() error {
+			if start() == 0 {
+				return errors.New("no listener created")
+			}
+			return nil
+		}
+	}
+
+	cur, next := &y.applied.Faces, &config.Faces
+	toggle("faces.tcp.enabled", &cur.Tcp.Enabled, &next.Tcp.Enabled,
+		listeners(y.startTcpListeners), y.stopTcpListeners)
+	toggle("faces.udp.enabled_unicast", &cur.Udp.EnabledUnicast, &next.Udp.EnabledUnicast,
+		listeners(y.startUdpListeners), y.stopUdpListeners)
+	toggle("faces.udp.enabled_multicast", &cur.Udp.EnabledMulticast, &next.Udp.EnabledMulticast,
+		listeners(y.startUdpMulticastFaces), y.stopUdpMulticastFaces)
+	toggle("faces.ether.enabled_multicast", &cur.Ether.EnabledMulticast, &next.Ether.EnabledMulticast,
+		listeners(y.startEtherMulticastFaces), y.stopEtherMulticastFaces)
+	toggle("faces.ether.enabled_unicast", &cur.Ether.EnabledUnicast, &next.Ether.EnabledUnicast,
+		listeners(y.startEtherListeners), y.stopEtherListeners)
+	toggle("faces.unix.enabled", &cur.Unix.Enabled, &next.Unix.Enabled,
+		listeners(y.startUnixListener), y.stopUnixListener)
+	toggle("faces.websocket.enabled", &cur.WebSocket.Enabled, &next.WebSocket.Enabled,
+		listeners(y.startWebSocketListener), y.stopWebSocketListener)
+	toggle("faces.http3.enabled", &cur.HTTP3.Enabled, &next.HTTP3.Enabled,
+		listeners(y.startHTTP3Listener), y.stopHTTP3Listener)
+	toggle("faces.capture.enabled", &cur.Capture.Enabled, &next.Capture.Enabled,
+		y.startCaptureListener, y.stopCaptureListener)
+}
+
+// sectionChanges returns the settings that differ from the startup configuration in the
+// section of an enabled setting (e.g. faces.tcp for faces.tcp.enabled), except the
+// enabled settings themselves.
+func sectionChanges(key string, config *core.Config) []string {
+	section := key[:strings.LastIndex(key, ".")+1]
+	return slices.DeleteFunc(core.C.Changes(config), func(change string) bool {
+		setting, found := strings.CutPrefix(change, section)
+		return !found || strings.HasPrefix(setting, "enabled")
+	})
+}
+
+// reloadMgmt applies whether management over /localhop is allowed.
+func (y *YaNFD) reloadMgmt(config *core.Config) {
+	if config.Mgmt.AllowLocalhop == y.applied.Mgmt.AllowLocalhop {
+		return
+	}
+
+	y.applied.Mgmt.AllowLocalhop = config.Mgmt.AllowLocalhop
+	y.mgmt.UpdateLocalhop(config.Mgmt.AllowLocalhop)
+	core.Log.Info(y, "Set management over /localhop", "allow", config.Mgmt.AllowLocalhop)
+}
+
+// reloadTables applies the content store limits and flags, and the network regions.
+func (y *YaNFD) reloadTables(config *core.Config) {
+	cs, newCs := &y.applied.Tables.ContentStore, &config.Tables.ContentStore
+
+	if newCs.Capacity != cs.Capacity || newCs.CapacityBytes != cs.CapacityBytes {
+		cs.Capacity, cs.CapacityBytes = newCs.Capacity, newCs.CapacityBytes
+		table.CfgSetCsCapacity(int(cs.Capacity))
+		table.CfgSetCsCapacityBytes(int(cs.CapacityBytes))
+		core.Log.Info(y, "Set CS capacity", "capacity", cs.Capacity, "bytes", cs.CapacityBytes)
+
+		// Shrink the CS of all forwarding threads to the new capacity
+		for _, thread := range y.threads {
+			err := thread.RunTask(func() {
+				thread.PitCs().EvictCsEntries()
+			})
+			if err != nil {
+				core.Log.Warn(y, "Unable to shrink CS", "thread", thread.GetID(), "err", err)
+			}
+		}
+	}
+
+	if newCs.Admit != cs.Admit {
+		cs.Admit = newCs.Admit
+		table.CfgSetCsAdmit(cs.Admit)
+		core.Log.Info(y, "Set CS admit flag", "value", cs.Admit)
+	}
+
+	if newCs.Serve != cs.Serve {
+		cs.Serve = newCs.Serve
+		table.CfgSetCsServe(cs.Serve)
+		core.Log.Info(y, "Set CS serve flag", "value", cs.Serve)
+	}
+
+	y.reloadNetworkRegion(config)
+}
+
+// reloadNetworkRegion applies the network regions, unless one of them is invalid.
+func (y *YaNFD) reloadNetworkRegion(config *core.Config) {
+	cur, next := &y.applied.Tables.NetworkRegion, &config.Tables.NetworkRegion
+	if slices.Equal(next.Regions, cur.Regions) {
+		return
+	}
+
+	regions := make([]enc.Name, 0, len(next.Regions))
+	for _, region := range next.Regions {
+		name, err := enc.NameFromStr(region)
+		if err != nil {
+			core.Log.Error(y, "Invalid producer region", "name", region, "err", err)
+			next.Regions = cur.Regions
+			return
+		}
+		regions = append(regions, name)
+	}
+	table.NetworkRegion.Set(regions)
+	cur.Regions = next.Regions
+	core.Log.Info(y, "Set producer regions", "regions", next.Regions)
+}
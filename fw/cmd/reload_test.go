@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloadTables(t *testing.T) {
+	y := &YaNFD{applied: core.DefaultConfig()}
+	t.Cleanup(func() {
+		table.CfgSetCsCapacity(int(core.C.Tables.ContentStore.Capacity))
+		table.CfgSetCsAdmit(core.C.Tables.ContentStore.Admit)
+		table.NetworkRegion.Set(nil)
+	})
+	name, _ := enc.NameFromStr("/a/b")
+
+	config := core.DefaultConfig()
+	config.Tables.ContentStore.Capacity = 10
+	config.Tables.ContentStore.Admit = false
+	config.Tables.NetworkRegion.Regions = []string{"/a"}
+	y.reloadTables(config)
+	assert.Equal(t, 10, table.CfgCsCapacity())
+	assert.False(t, table.CfgCsAdmit())
+	assert.True(t, table.NetworkRegion.IsProducer(name))
+	assert.Empty(t, y.applied.Changes(config))
+
+	// The running configuration is not modified
+	assert.Empty(t, core.C.Changes(core.DefaultConfig()))
+}
+
+func TestReloadInvalidSettings(t *testing.T) {
+	y := &YaNFD{applied: core.DefaultConfig()}
+	t.Cleanup(func() {
+		table.CfgSetCsServe(core.C.Tables.ContentStore.Serve)
+		table.NetworkRegion.Set(nil)
+	})
+	name, _ := enc.NameFromStr("/a/b")
+
+	config := core.DefaultConfig()
+	config.Core.LogLevel = "LOUD"
+	config.Tables.NetworkRegion.Regions = []string{"/a", "/%"}
+	config.Tables.ContentStore.Serve = false
+	y.reloadLogging(config)
+	y.reloadTables(config)
+
+	// Invalid settings keep their value, and do not prevent other settings
+	// from being applied nor are reported as needing a restart
+	assert.Equal(t, "INFO", config.Core.LogLevel)
+	assert.Empty(t, config.Tables.NetworkRegion.Regions)
+	assert.False(t, table.NetworkRegion.IsProducer(name))
+	assert.False(t, table.CfgCsServe())
+	assert.Empty(t, y.applied.Changes(config))
+}
+
+func TestReloadCaptureListener(t *testing.T) {
+	socketPath := core.C.Faces.Capture.SocketPath
+	core.C.Faces.Capture.SocketPath = filepath.Join(t.TempDir(), "capture.sock")
+	applied := *core.C
+	y := &YaNFD{applied: &applied}
+	t.Cleanup(func() {
+		y.stopCaptureListener()
+		core.C.Faces.Capture.SocketPath = socketPath
+	})
+
+	// The listener is not started on a changed socket path, which needs a restart
+	config := core.DefaultConfig()
+	config.Faces.Capture.Enabled = true
+	y.reloadListeners(config)
+	assert.False(t, y.applied.Faces.Capture.Enabled)
+	assert.False(t, config.Faces.Capture.Enabled)
+	assert.Nil(t, y.capListener)
+	assert.Equal(t, []string{"faces.capture.socket_path"}, y.applied.Changes(config))
+
+	// The listener is started once, and stopped when disabled
+	config.Faces.Capture.Enabled = true
+	config.Faces.Capture.SocketPath = core.C.Faces.Capture.SocketPath
+	y.reloadListeners(config)
+	assert.True(t, y.applied.Faces.Capture.Enabled)
+	assert.NotNil(t, y.capListener)
+	capListener := y.capListener
+
+	y.reloadListeners(config)
+	assert.Same(t, capListener, y.capListener)
+
+	config.Faces.Capture.Enabled = false
+	y.reloadListeners(config)
+	assert.False(t, y.applied.Faces.Capture.Enabled)
+	assert.Nil(t, y.capListener)
+}
+
+func TestReloadListenerSectionChanges(t *testing.T) {
+	config := core.DefaultConfig()
+	config.Faces.Udp.EnabledMulticast = !core.C.Faces.Udp.EnabledMulticast
+	config.Faces.Tcp.PortUnicast = core.C.Faces.Tcp.PortUnicast + 1
+
+	// Enabled settings and other sections do not prevent starting listeners
+	assert.Empty(t, sectionChanges("faces.udp.enabled_unicast", config))
+	assert.Equal(t, []string{"faces.tcp.port_unicast"}, sectionChanges("faces.tcp.enabled", config))
+}
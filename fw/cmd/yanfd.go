@@ -2,13 +2,10 @@ package cmd
 
 import (
 	"fmt"
-	"net"
 	"os"
-	"runtime"
 	"time"
 
 	"github.com/named-data/ndnd/fw/core"
-	"github.com/named-data/ndnd/fw/defn"
 	"github.com/named-data/ndnd/fw/dispatch"
 	"github.com/named-data/ndnd/fw/face"
 	"github.com/named-data/ndnd/fw/fw"
@@ -21,13 +18,24 @@ import (
 // Note: only one instance of this class should be created.
 type YaNFD struct {
 	config   *core.Config
+	applied  *core.Config // settings applied at runtime, see Reload
 	profiler *Profiler
+	metrics  *Metrics
+	mgmt     *mgmt.Thread
+	threads  []*fw.Thread
 
 	unixListener *face.UnixStreamListener
 	wsListener   *face.WebSocketListener
 	h3Listener   *face.HTTP3Listener
+	capListener  *face.CaptureListener
 	tcpListeners []*face.TCPListener
 	udpListeners []*face.UDPListener
+
+	etherListeners []*face.EthernetListener
+
+	// Multicast faces created with the listeners
+	udpMulticastFaces   []face.LinkService
+	etherMulticastFaces []face.LinkService
 }
 
 // NewYaNFD creates a YaNFD. Don't call this function twice.
@@ -38,12 +46,17 @@ func NewYaNFD(config *core.Config) *YaNFD {
 
 	// Initialize all modules here
 	core.OpenLogger()
-	face.Initialize()
 	table.Initialize()
+	face.Initialize(dispatch.NewForwarder(table.FibStrategyTable, table.Rib))
+
+	// Settings changed at runtime by Reload
+	applied := *config
 
 	return &YaNFD{
 		config:   config,
+		applied:  &applied,
 		profiler: NewProfiler(config),
+		metrics:  NewMetrics(config),
 	}
 }
 
@@ -63,183 +76,53 @@ func (y *YaNFD) Start() {
 	// Create null face
 	face.MakeNullLinkService(face.MakeNullTransport()).Run(nil)
 
-	// Start management thread
-	go mgmt.MakeMgmtThread().Run()
-
 	// Create forwarding threads
 	if fw.CfgNumThreads() < 1 || fw.CfgNumThreads() > fw.MaxFwThreads {
 		core.Log.Fatal(y, "Number of forwarding threads out of range", "range", fmt.Sprintf("[1, %d]", fw.MaxFwThreads))
 		os.Exit(2)
 	}
-	fw.Threads = make([]*fw.Thread, fw.CfgNumThreads())
-	var fwForDispatch []dispatch.FWThread
-	for i := range fw.CfgNumThreads() {
-		newThread := fw.NewThread(i)
-		fw.Threads[i] = newThread
-		fwForDispatch = append(fwForDispatch, newThread)
-		go fw.Threads[i].Run()
+	fwd := face.FaceTable.Forwarder()
+	y.threads = make([]*fw.Thread, fw.CfgNumThreads())
+	fwForDispatch := make([]dispatch.FWThread, fw.CfgNumThreads())
+	for i := range y.threads {
+		y.threads[i] = fw.NewThread(i, fwd)
+		fwForDispatch[i] = y.threads[i]
+	}
+	fwd.InitializeFWThreads(fwForDispatch)
+
+	// Start management thread, which sets up the forwarding threads
+	y.mgmt = mgmt.MakeMgmtThread(face.FaceTable, y.threads)
+	go y.mgmt.Run()
+
+	for _, thread := range y.threads {
+		go thread.Run()
 	}
-	dispatch.InitializeFWThreads(fwForDispatch)
 
 	// Set up listeners for faces
 	listenerCount := 0
-
-	// Create unicast TCP face
 	if core.C.Faces.Tcp.Enabled {
-		tcpAddrs := []*net.TCPAddr{{
-			IP:   net.IPv4zero,
-			Port: face.CfgTCPUnicastPort(),
-		}, {
-			IP:   net.IPv6zero,
-			Port: face.CfgTCPUnicastPort(),
-		}}
-
-		for _, tcpAddr := range tcpAddrs {
-			uri := fmt.Sprintf("tcp://%s", tcpAddr)
-			tcpListener, err := face.MakeTCPListener(defn.DecodeURIString(uri))
-			if err != nil {
-				core.Log.Error(y, "Unable to create TCP listener", "uri", uri, "err", err)
-			} else {
-				listenerCount++
-				go tcpListener.Run()
-				y.tcpListeners = append(y.tcpListeners, tcpListener)
-				core.Log.Info(y, "Created unicast TCP listener", "uri", uri)
-			}
-		}
+		listenerCount += y.startTcpListeners()
 	}
-
-	// Utility to create unicast UDP face
-	createUdpFace := func(ipAddr net.IP, zone string) {
-		uri := fmt.Sprintf("udp://%s", &net.UDPAddr{
-			IP:   ipAddr,
-			Port: face.CfgUDPUnicastPort(),
-			Zone: zone,
-		})
-
-		udpListener, err := face.MakeUDPListener(defn.DecodeURIString(uri))
-		if err != nil {
-			core.Log.Error(y, "Unable to create UDP listener", "uri", uri, "err", err)
-		} else {
-			listenerCount++
-			go udpListener.Run()
-			y.udpListeners = append(y.udpListeners, udpListener)
-			core.Log.Info(y, "Created unicast UDP listener", "uri", uri)
-		}
+	if core.C.Faces.Udp.EnabledUnicast {
+		listenerCount += y.startUdpListeners()
 	}
-
-	// On Linux and Windows, create a single UDP face for all interfaces.
-	// On macOS, create a UDP face for each interface (see below)
-	if runtime.GOOS != "darwin" {
-		createUdpFace(net.IPv4zero, "")
-		createUdpFace(net.IPv6zero, "")
+	if core.C.Faces.Udp.EnabledMulticast {
+		listenerCount += y.startUdpMulticastFaces()
 	}
-
-	// Create UDP faces on each non-loopback interface
-	if core.C.Faces.Udp.EnabledUnicast || core.C.Faces.Udp.EnabledMulticast {
-		ifaces, err := net.Interfaces()
-		if err != nil {
-			core.Log.Error(y, "Unable to access network interfaces", "err", err)
-		}
-
-		for _, iface := range ifaces {
-			if iface.Flags&net.FlagUp == 0 {
-				core.Log.Info(y, "Skipping interface because not up", "iface", iface.Name)
-				continue
-			}
-
-			addrs, err := iface.Addrs()
-			if err != nil {
-				core.Log.Error(y, "Unable to access addresses on network interface", "iface", iface.Name, "err", err)
-				continue
-			}
-
-			for _, addr := range addrs {
-				ipAddr := addr.(*net.IPNet)
-
-				// Create unicast UDP faces on each interface for macOS.
-				// Do not make a single listener here for all interfaces.
-				// https://github.com/named-data/ndnd/issues/144
-				if runtime.GOOS == "darwin" && core.C.Faces.Udp.EnabledUnicast {
-					createUdpFace(ipAddr.IP, iface.Name)
-				}
-
-				// Create multicast UDP faces
-				if core.C.Faces.Udp.EnabledMulticast {
-					uri := fmt.Sprintf("udp://%s", &net.UDPAddr{
-						IP:   ipAddr.IP,
-						Port: face.CfgUDPMulticastPort(),
-						Zone: iface.Name,
-					})
-
-					if !addr.(*net.IPNet).IP.IsLoopback() {
-						multicastUDPTransport, err := face.MakeMulticastUDPTransport(defn.DecodeURIString(uri))
-						if err != nil {
-							core.Log.Error(y, "Unable to create MulticastUDPTransport", "uri", uri, "err", err)
-							continue
-						}
-						face.MakeNDNLPLinkService(multicastUDPTransport, face.MakeNDNLPLinkServiceOptions()).Run(nil)
-
-						listenerCount++
-						core.Log.Info(y, "Created multicast UDP face", "uri", uri)
-					}
-				}
-			}
-		}
+	if core.C.Faces.Ether.EnabledMulticast {
+		listenerCount += y.startEtherMulticastFaces()
+	}
+	if core.C.Faces.Ether.EnabledUnicast {
+		listenerCount += y.startEtherListeners()
 	}
-
-	// Set up Unix stream listener
 	if core.C.Faces.Unix.Enabled {
-		uri := defn.MakeUnixFaceURI(face.CfgUnixSocketPath())
-		unixListener, err := face.MakeUnixStreamListener(uri)
-		if err != nil {
-			core.Log.Error(y, "Unable to create Unix stream listener", "path", face.CfgUnixSocketPath(), "err", err)
-		} else {
-			listenerCount++
-			go unixListener.Run()
-			y.unixListener = unixListener
-			core.Log.Info(y, "Created unix stream listener", "uri", uri)
-		}
+		listenerCount += y.startUnixListener()
 	}
-
-	// Set up WebSocket listener
 	if core.C.Faces.WebSocket.Enabled {
-		cfg := face.WebSocketListenerConfig{
-			Bind:       core.C.Faces.WebSocket.Bind,
-			Port:       core.C.Faces.WebSocket.Port,
-			TLSEnabled: core.C.Faces.WebSocket.TlsEnabled,
-			TLSCert:    core.C.ResolveRelPath(core.C.Faces.WebSocket.TlsCert),
-			TLSKey:     core.C.ResolveRelPath(core.C.Faces.WebSocket.TlsKey),
-		}
-
-		wsListener, err := face.NewWebSocketListener(cfg)
-		if err != nil {
-			core.Log.Error(y, "Unable to create WebSocket Listener", "cfg", cfg, "err", err)
-		} else {
-			listenerCount++
-			go wsListener.Run()
-			y.wsListener = wsListener
-			core.Log.Info(y, "Created WebSocket listener", "uri", cfg.URL().String())
-		}
+		listenerCount += y.startWebSocketListener()
 	}
-
-	// Set up HTTP/3 WebTransport listener
-	if c := core.C.Faces.HTTP3; c.Enabled {
-		cfg := face.HTTP3ListenerConfig{
-			Bind:    c.Bind,
-			Port:    c.Port,
-			TLSCert: c.TlsCert,
-			TLSKey:  c.TlsKey,
-		}
-
-		h3Listener, err := face.NewHTTP3Listener(cfg)
-		if err != nil {
-			core.Log.Error(y, "Unable to create HTTP/3 WebTransport Listener", "cfg", cfg, "err", err)
-		} else {
-			listenerCount++
-			go h3Listener.Run()
-			y.h3Listener = h3Listener
-			core.Log.Info(y, "Created HTTP/3 WebTransport listener", "uri", cfg.URL().String())
-		}
+	if core.C.Faces.HTTP3.Enabled {
+		listenerCount += y.startHTTP3Listener()
 	}
 
 	// Check if any faces were created
@@ -247,6 +130,14 @@ func (y *YaNFD) Start() {
 		core.Log.Fatal(y, "No face or listener is successfully created. Quit.")
 		os.Exit(2)
 	}
+
+	// Start packet capture listener
+	if core.C.Faces.Capture.Enabled {
+		y.startCaptureListener()
+	}
+
+	// Start metrics endpoint
+	y.metrics.Start(y.threads)
 }
 
 // Stop shuts down YaNFD.
@@ -261,26 +152,18 @@ func (y *YaNFD) Stop() {
 	// Break all loops
 	core.ShouldQuit = true
 
-	// Stop profiler
+	// Stop profiler and metrics endpoint
 	y.profiler.Stop()
+	y.metrics.Stop()
 
-	// Wait for unix socket listener to quit
-	if y.unixListener != nil {
-		y.unixListener.Close()
-	}
-	if y.wsListener != nil {
-		y.wsListener.Close()
-	}
-
-	// Wait for UDP listener to quit
-	for _, udpListener := range y.udpListeners {
-		udpListener.Close()
-	}
-
-	// Wait for TCP listeners to quit
-	for _, tcpListener := range y.tcpListeners {
-		tcpListener.Close()
-	}
+	// Wait for listeners to quit
+	y.stopUnixListener()
+	y.stopWebSocketListener()
+	y.stopHTTP3Listener()
+	y.stopCaptureListener()
+	y.stopUdpListeners()
+	y.stopTcpListeners()
+	y.stopEtherListeners()
 
 	// Tell all faces to quit
 	for _, face := range face.FaceTable.GetAll() {
@@ -288,12 +171,12 @@ func (y *YaNFD) Stop() {
 	}
 
 	// Tell all forwarding threads to quit
-	for _, fw := range fw.Threads {
+	for _, fw := range y.threads {
 		fw.TellToQuit()
 	}
 
 	// Wait for all forwarding threads to have quit
-	for _, fw := range fw.Threads {
+	for _, fw := range y.threads {
 		<-fw.HasQuit
 	}
 }
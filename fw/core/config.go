@@ -9,11 +9,15 @@ package core
 
 import (
 	"path/filepath"
+	"reflect"
 	"runtime"
+	"strings"
 )
 
-// Global initial configuration of the forwarder.
-// This configuration is IMMUTABLE. Do not modify it.
+// Global configuration of the forwarder, as loaded at startup.
+// This configuration is IMMUTABLE, since it is read without synchronization.
+// Settings changed when the configuration is reloaded are applied to the
+// components directly, and are not written here.
 var C = DefaultConfig()
 
 // Config represents the configuration of the forwarder.
@@ -23,6 +27,9 @@ type Config struct {
 		LogLevel string `json:"log_level"`
 		// Output log to file
 		LogFile string `json:"log_file"`
+		// Address of the HTTP endpoint exporting metrics at /metrics (e.g. 127.0.0.1:9696)
+		// Leave empty to disable the endpoint
+		MetricsAddress string `json:"metrics_address"`
 
 		// Config file base dir
 		BaseDir string `json:"-"`
@@ -72,6 +79,19 @@ type Config struct {
 			ReconnectInterval uint64 `json:"reconnect_interval"`
 		} `json:"tcp"`
 
+		Ether struct {
+			// Whether to enable on-demand unicast Ethernet faces
+			EnabledUnicast bool `json:"enabled_unicast"`
+			// Whether to enable multicast Ethernet faces
+			EnabledMulticast bool `json:"enabled_multicast"`
+			// Group address used for multicast Ethernet faces
+			MulticastAddress string `json:"multicast_address"`
+			// Names of the interfaces to create Ethernet faces on (all if empty)
+			Interfaces []string `json:"interfaces"`
+			// Lifetime of on-demand faces (in seconds)
+			Lifetime uint64 `json:"lifetime"`
+		} `json:"ether"`
+
 		Unix struct {
 			// Whether to enable Unix stream transports
 			Enabled bool `json:"enabled"`
@@ -106,6 +126,27 @@ type Config struct {
 			// TLS private key (relative to the config file)
 			TlsKey string `json:"tls_key"`
 		} `json:"http3"`
+
+		Capture struct {
+			// Whether to serve packet captures to local subscribers (e.g. ndnd dump)
+			Enabled bool `json:"enabled"`
+			// Unix socket path of packet captures, only accessible by the forwarder user
+			SocketPath string `json:"socket_path"`
+		} `json:"capture"`
+
+		Shaping struct {
+			// Rate limit of non-local faces in bytes per second, or 0 for no limit
+			Rate uint64 `json:"rate"`
+			// Maximum burst of rate-limited faces in bytes
+			Burst uint64 `json:"burst"`
+			// Rate limits of the faces whose remote URI starts with a given prefix,
+			// which take precedence over the default rate limit. The first match is used.
+			Faces []FaceRateRule `json:"faces"`
+			// Priority classes of the packets sent on non-local faces. Each round sends
+			// up to the weight of each class in packets, in order. Packets matching no
+			// class are in a default class of weight 1.
+			Classes []ShapingClass `json:"classes"`
+		} `json:"shaping"`
 	} `json:"faces"`
 
 	Fw struct {
@@ -120,6 +161,39 @@ type Config struct {
 	Mgmt struct {
 		// Controls whether management over /localhop is enabled or disabled
 		AllowLocalhop bool `json:"allow_localhop"`
+
+		PrefixAnnouncement struct {
+			// Keychain holding the trust anchors and the certificates of announcement signers
+			// (e.g. dir:///etc/ndn/keys). Announcements are refused if empty, and accepted
+			// without validation if "insecure".
+			Keychain string `json:"keychain"`
+			// LVS trust schema file for announcements (relative to the config file).
+			// If empty, any signer with a certificate chain to a trust anchor is accepted.
+			TrustSchema string `json:"trust_schema"`
+			// Full names of the trust anchor certificates in the keychain
+			TrustAnchors []string `json:"trust_anchors"`
+		} `json:"prefix_announcement"`
+
+		Security struct {
+			// Keychain holding the forwarder key, the trust anchors and the certificates of
+			// command signers (e.g. dir:///etc/ndn/keys). If empty, control commands over /localhop
+			// are rejected, those over /localhost are not validated, and management responses are
+			// signed with a SHA-256 digest.
+			Keychain string `json:"keychain"`
+			// Identity in the keychain whose key signs management responses
+			Identity string `json:"identity"`
+			// LVS trust schema file for control commands (relative to the config file).
+			// If empty, any signer with a certificate chain to a trust anchor is accepted.
+			TrustSchema string `json:"trust_schema"`
+			// Full names of the trust anchor certificates in the keychain
+			TrustAnchors []string `json:"trust_anchors"`
+			// Whether control commands over /localhost are also validated.
+			// Control commands over /localhop are always validated if a keychain is set.
+			ValidateLocalhost bool `json:"validate_localhost"`
+			// Rules authorizing the signers of control commands. A command is accepted
+			// if any rule allows it. If empty, any trusted signer may run any command.
+			Authorization []CommandAuthRule `json:"authorization"`
+		} `json:"security"`
 	} `json:"mgmt"`
 
 	Tables struct {
@@ -128,12 +202,17 @@ type Config struct {
 			// total capacity of all content stores in the forwarder will be the number of threads
 			// multiplied by this value. This is the startup configuration value and can be changed at
 			// runtime via management.
-			Capacity uint16 `json:"capacity"`
+			Capacity uint32 `json:"capacity"`
+			// Capacity of each forwarding thread's content store (in bytes of Data packets), or zero
+			// for no limit. As with the packet capacity, the total is multiplied by the number of
+			// threads, and this can be changed at runtime via management.
+			CapacityBytes uint64 `json:"capacity_bytes"`
 			// Whether contents will be admitted to the Content Store.
 			Admit bool `json:"admit"`
 			// Whether contents will be served from the Content Store.
 			Serve bool `json:"serve"`
 			// Cache replacement policy to use in each thread's content store.
+			// One of "lru", "arc", "lfu" (with dynamic aging) or "priority_fifo".
 			ReplacementPolicy string `json:"replacement_policy"`
 		} `json:"content_store"`
 
@@ -150,6 +229,9 @@ type Config struct {
 		Rib struct {
 			// Enables or disables readvertising to the routing daemon
 			ReadvertiseNlsr bool `json:"readvertise_nlsr"`
+			// Enables or disables propagating Prefix Announcements to upstream forwarders
+			// that are reachable through routes for /localhop/nfd
+			ReadvertisePrefixAnn bool `json:"readvertise_prefix_ann"`
 		} `json:"rib"`
 
 		Fib struct {
@@ -165,11 +247,48 @@ type Config struct {
 	} `json:"tables"`
 }
 
+// CommandAuthRule authorizes signers to run control commands.
+type CommandAuthRule struct {
+	// Prefix of the key names of the signers
+	Signer string `json:"signer"`
+	// Allowed commands, as module or module/verb (e.g. rib/register), or * for all
+	Commands []string `json:"commands"`
+	// Prefixes of the names the commands may act on (e.g. the prefix of rib/register).
+	// If empty, the commands may act on any name. Otherwise, commands that do not act
+	// on a name are only allowed if listed as module or module/verb.
+	Prefixes []string `json:"prefixes"`
+}
+
+// FaceRateRule sets the rate limit of the faces with a remote URI prefix.
+type FaceRateRule struct {
+	// Prefix of the remote URIs of the faces (e.g. udp4://192.0.2.1)
+	Uri string `json:"uri"`
+	// Rate limit in bytes per second, or 0 for no limit
+	Rate uint64 `json:"rate"`
+	// Maximum burst in bytes, or 0 for the default burst
+	Burst uint64 `json:"burst"`
+}
+
+// ShapingClass is a priority class of outgoing packets.
+type ShapingClass struct {
+	// Name of the class, for logging
+	Name string `json:"name"`
+	// Number of packets of the class sent in each scheduling round
+	Weight int `json:"weight"`
+	// Name prefixes of the packets in the class
+	Prefixes []string `json:"prefixes"`
+	// Packet types in the class: interest, data or nack (the Nack field of the LP header)
+	Types []string `json:"types"`
+	// Whether the class holds packets with a congestion mark in the LP header
+	CongestionMarked bool `json:"congestion_marked"`
+}
+
 // (AI GENERATED DESCRIPTION): Creates and returns a `Config` object pre‑populated with default settings for core parameters, face types, forwarding, management, and table options.
 func DefaultConfig() *Config {
 	c := &Config{}
 	c.Core.LogLevel = "INFO"
 	c.Core.LogFile = ""
+	c.Core.MetricsAddress = ""
 
 	c.Core.BaseDir = ""
 	c.Core.CpuProfile = ""
@@ -194,6 +313,12 @@ func DefaultConfig() *Config {
 	c.Faces.Tcp.Lifetime = 600
 	c.Faces.Tcp.ReconnectInterval = 10
 
+	c.Faces.Ether.EnabledUnicast = false
+	c.Faces.Ether.EnabledMulticast = false
+	c.Faces.Ether.MulticastAddress = "01:00:5e:00:17:aa"
+	c.Faces.Ether.Interfaces = []string{}
+	c.Faces.Ether.Lifetime = 600
+
 	c.Faces.Unix.Enabled = true
 	c.Faces.Unix.SocketPath = "/run/nfd/nfd.sock"
 	if runtime.GOOS == "darwin" {
@@ -213,13 +338,34 @@ func DefaultConfig() *Config {
 	c.Faces.HTTP3.TlsCert = ""
 	c.Faces.HTTP3.TlsKey = ""
 
+	c.Faces.Capture.Enabled = false
+	c.Faces.Capture.SocketPath = "/run/nfd/capture.sock"
+	if runtime.GOOS == "darwin" {
+		c.Faces.Capture.SocketPath = "/var/run/nfd/capture.sock"
+	}
+
+	c.Faces.Shaping.Rate = 0
+	c.Faces.Shaping.Burst = 65536
+	c.Faces.Shaping.Faces = []FaceRateRule{}
+	c.Faces.Shaping.Classes = []ShapingClass{}
+
 	c.Fw.Threads = 8
 	c.Fw.QueueSize = 1024
 	c.Fw.LockThreadsToCores = false
 
 	c.Mgmt.AllowLocalhop = false
+	c.Mgmt.PrefixAnnouncement.Keychain = ""
+	c.Mgmt.PrefixAnnouncement.TrustSchema = ""
+	c.Mgmt.PrefixAnnouncement.TrustAnchors = []string{}
+	c.Mgmt.Security.Keychain = ""
+	c.Mgmt.Security.Identity = ""
+	c.Mgmt.Security.TrustSchema = ""
+	c.Mgmt.Security.TrustAnchors = []string{}
+	c.Mgmt.Security.ValidateLocalhost = false
+	c.Mgmt.Security.Authorization = []CommandAuthRule{}
 
 	c.Tables.ContentStore.Capacity = 1024
+	c.Tables.ContentStore.CapacityBytes = 0
 	c.Tables.ContentStore.Admit = true
 	c.Tables.ContentStore.Serve = true
 	c.Tables.ContentStore.ReplacementPolicy = "lru"
@@ -227,6 +373,7 @@ func DefaultConfig() *Config {
 	c.Tables.DeadNonceList.Lifetime = 6000
 	c.Tables.NetworkRegion.Regions = []string{}
 	c.Tables.Rib.ReadvertiseNlsr = true
+	c.Tables.Rib.ReadvertisePrefixAnn = false
 
 	c.Tables.Fib.Algorithm = "nametree"
 	c.Tables.Fib.Hashtable.M = 5
@@ -241,3 +388,32 @@ func (c *Config) ResolveRelPath(target string) string {
 	}
 	return filepath.Join(c.Core.BaseDir, target)
 }
+
+// Changes returns the settings that differ in another configuration,
+// as the dot-separated keys of the configuration file (e.g. "faces.tcp.enabled").
+// Settings that are not read from the configuration file are ignored.
+func (c *Config) Changes(other *Config) []string {
+	return configChanges(reflect.ValueOf(*c), reflect.ValueOf(*other), "")
+}
+
+// configChanges recursively compares the fields of two configuration structs.
+func configChanges(a reflect.Value, b reflect.Value, prefix string) (changes []string) {
+	for i := range a.NumField() {
+		key, _, _ := strings.Cut(a.Type().Field(i).Tag.Get("json"), ",")
+		if key == "-" || key == "" {
+			continue
+		}
+		key = prefix + key
+
+		fa, fb := a.Field(i), b.Field(i)
+		switch {
+		case fa.Kind() == reflect.Struct:
+			changes = append(changes, configChanges(fa, fb, key+".")...)
+		case fa.Kind() == reflect.Slice && fa.Len() == 0 && fb.Len() == 0:
+			// nil and empty lists are the same
+		case !reflect.DeepEqual(fa.Interface(), fb.Interface()):
+			changes = append(changes, key)
+		}
+	}
+	return changes
+}
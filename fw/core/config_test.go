@@ -0,0 +1,36 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigChanges(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(c *Config)
+		changes []string
+	}{
+		{"unchanged", func(c *Config) {}, nil},
+		{"top-level section", func(c *Config) { c.Core.LogLevel = "DEBUG" }, []string{"core.log_level"}},
+		{"nested section", func(c *Config) { c.Faces.Udp.EnabledMulticast = false }, []string{"faces.udp.enabled_multicast"}},
+		{"list", func(c *Config) { c.Tables.NetworkRegion.Regions = []string{"/a"} }, []string{"tables.network_region.regions"}},
+		{"nil and empty lists", func(c *Config) { c.Tables.NetworkRegion.Regions = nil }, nil},
+		{"not in configuration file", func(c *Config) { c.Core.BaseDir = "/etc/ndn" }, nil},
+		{"several settings", func(c *Config) {
+			c.Fw.Threads = 2
+			c.Tables.ContentStore.Capacity = 10
+			c.Mgmt.AllowLocalhop = true
+		}, []string{"fw.threads", "mgmt.allow_localhop", "tables.content_store.capacity"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			other := DefaultConfig()
+			tt.change(other)
+			assert.ElementsMatch(t, tt.changes, DefaultConfig().Changes(other))
+			assert.ElementsMatch(t, tt.changes, other.Changes(DefaultConfig()))
+		})
+	}
+}
@@ -1,14 +1,28 @@
 package defn
 
+import enc "github.com/named-data/ndnd/std/encoding"
+
 type FWThreadCounters struct {
 	NPitEntries           int
 	NCsEntries            int
+	NMeasurementsEntries  int
 	NInInterests          uint64
 	NInData               uint64
+	NInNacks              uint64
 	NOutInterests         uint64
 	NOutData              uint64
+	NOutNacks             uint64
 	NSatisfiedInterests   uint64
 	NUnsatisfiedInterests uint64
 	NCsHits               uint64
 	NCsMisses             uint64
 }
+
+// StrategyCounters counts the packets passed to a strategy of a forwarding thread.
+type StrategyCounters struct {
+	Strategy   enc.Name
+	NInterests uint64
+	NData      uint64
+	NNacks     uint64
+	NCsHits    uint64
+}
@@ -0,0 +1,99 @@
+package defn
+
+import (
+	enc "github.com/named-data/ndnd/std/encoding"
+)
+
+// ForwardingHint returns the first delegation name of the Interest's
+// forwarding hint, or nil if the Interest has no forwarding hint.
+func (p *FwInterest) ForwardingHint() enc.Name {
+	if p.ForwardingHintV == nil || len(p.ForwardingHintV.Names) == 0 {
+		return nil
+	}
+	return p.ForwardingHintV.Names[0]
+}
+
+// SetInterestNonce returns a copy of the encoded Interest with its Nonce replaced.
+// The Interest must already carry a Nonce; the original wire is not modified.
+func SetInterestNonce(wire enc.Wire, nonce uint32) (enc.Wire, error) {
+	buf := make([]byte, wire.Length())
+	copy(buf, wire.Join())
+
+	found := false
+	err := walkInterest(buf, func(typ enc.TLNum, start, value, end int) {
+		if typ == 0x0a && end-value == 4 {
+			// Not using encoding/binary, which conflicts with the TLV generator
+			buf[value], buf[value+1], buf[value+2], buf[value+3] =
+				byte(nonce>>24), byte(nonce>>16), byte(nonce>>8), byte(nonce)
+			found = true
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, enc.ErrFormat{Msg: "Interest has no Nonce"}
+	}
+	return enc.Wire{buf}, nil
+}
+
+// StripForwardingHint returns a copy of the encoded Interest without its
+// ForwardingHint element. The original wire is not modified.
+func StripForwardingHint(wire enc.Wire) (enc.Wire, error) {
+	buf := wire.Join()
+
+	hintStart, hintEnd := -1, -1
+	err := walkInterest(buf, func(typ enc.TLNum, start, value, end int) {
+		if typ == 0x1e {
+			hintStart, hintEnd = start, end
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if hintStart < 0 {
+		return nil, enc.ErrFormat{Msg: "Interest has no ForwardingHint"}
+	}
+
+	// Re-encode the outer TL of the Interest with the new length
+	_, p1 := enc.ParseTLNum(buf)
+	_, p2 := enc.ParseTLNum(buf[p1:])
+	innerLen := enc.TLNum(len(buf) - p1 - p2 - (hintEnd - hintStart))
+
+	out := make([]byte, 1+innerLen.EncodingLength()+int(innerLen))
+	pos := enc.TLNum(0x05).EncodeInto(out)
+	pos += innerLen.EncodeInto(out[pos:])
+	pos += copy(out[pos:], buf[p1+p2:hintStart])
+	copy(out[pos:], buf[hintEnd:])
+	return enc.Wire{out}, nil
+}
+
+// walkInterest calls fn with the type and offsets of each top-level element
+// of the encoded Interest in buf.
+func walkInterest(buf []byte, fn func(typ enc.TLNum, start, value, end int)) error {
+	reader := enc.NewBufferView(buf)
+	if typ, err := reader.ReadTLNum(); err != nil || typ != 0x05 {
+		return enc.ErrFormat{Msg: "not an Interest"}
+	}
+	if l, err := reader.ReadTLNum(); err != nil || int(l) != reader.Length()-reader.Pos() {
+		return enc.ErrFormat{Msg: "invalid Interest length"}
+	}
+
+	for !reader.IsEOF() {
+		start := reader.Pos()
+		typ, err := reader.ReadTLNum()
+		if err != nil {
+			return err
+		}
+		l, err := reader.ReadTLNum()
+		if err != nil {
+			return err
+		}
+		value := reader.Pos()
+		if err := reader.Skip(int(l)); err != nil {
+			return err
+		}
+		fn(typ, start, value, reader.Pos())
+	}
+	return nil
+}
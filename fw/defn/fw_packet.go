@@ -93,6 +93,14 @@ type FwLpPacket struct {
 	CachePolicy *FwCachePolicy `tlv:"0x0334"`
 	//+field:natural:optional
 	CongestionMark optional.Optional[uint64] `tlv:"0x0340"`
+	//+field:sequence:uint64:fixedUint:uint64
+	Acks []uint64 `tlv:"0x0344"`
+	//+field:fixedUint:uint64:optional
+	TxSequence optional.Optional[uint64] `tlv:"0x0348"`
+	//+field:bool
+	NonDiscovery bool `tlv:"0x034C"`
+	//+field:wire
+	PrefixAnnouncement enc.Wire `tlv:"0x0350"`
 
 	//+field:wire
 	Fragment enc.Wire `tlv:"0x50"`
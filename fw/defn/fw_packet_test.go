@@ -0,0 +1,93 @@
+package defn_test
+
+import (
+	"testing"
+
+	"github.com/named-data/ndnd/fw/defn"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetInterestNonce(t *testing.T) {
+	name, _ := enc.NameFromStr("/test/nonce")
+	hopLimit := byte(32)
+	interest := &defn.FwInterest{
+		NameV:     name,
+		NonceV:    optional.Some(uint32(0x01020304)),
+		HopLimitV: &hopLimit,
+	}
+	wire := (&defn.FwPacket{Interest: interest}).Encode()
+	orig := wire.Join()
+	origCopy := append([]byte{}, orig...)
+
+	patched, err := defn.SetInterestNonce(wire, 0xa0b0c0d0)
+	require.NoError(t, err)
+	assert.Equal(t, origCopy, wire.Join()) // original not modified
+
+	pkt, err := defn.ParseFwPacket(enc.NewWireView(patched), false)
+	require.NoError(t, err)
+	require.NotNil(t, pkt.Interest)
+	assert.Equal(t, uint32(0xa0b0c0d0), pkt.Interest.NonceV.Unwrap())
+	assert.True(t, pkt.Interest.NameV.Equal(name))
+	assert.Equal(t, byte(32), *pkt.Interest.HopLimitV)
+
+	// Interest without Nonce
+	interest.NonceV = optional.None[uint32]()
+	wire = (&defn.FwPacket{Interest: interest}).Encode()
+	_, err = defn.SetInterestNonce(wire, 1)
+	assert.Error(t, err)
+}
+
+func TestStripForwardingHint(t *testing.T) {
+	name, _ := enc.NameFromStr("/test/hint")
+	hint, _ := enc.NameFromStr("/producer/region")
+	interest := &defn.FwInterest{
+		NameV:           name,
+		CanBePrefixV:    true,
+		ForwardingHintV: &defn.FwLinks{Names: []enc.Name{hint}},
+		NonceV:          optional.Some(uint32(0x01020304)),
+	}
+	wire := (&defn.FwPacket{Interest: interest}).Encode()
+
+	stripped, err := defn.StripForwardingHint(wire)
+	require.NoError(t, err)
+	assert.Less(t, stripped.Length(), wire.Length())
+
+	pkt, err := defn.ParseFwPacket(enc.NewWireView(stripped), false)
+	require.NoError(t, err)
+	require.NotNil(t, pkt.Interest)
+	assert.Nil(t, pkt.Interest.ForwardingHintV)
+	assert.Nil(t, pkt.Interest.ForwardingHint())
+	assert.True(t, pkt.Interest.NameV.Equal(name))
+	assert.True(t, pkt.Interest.CanBePrefixV)
+	assert.Equal(t, uint32(0x01020304), pkt.Interest.NonceV.Unwrap())
+
+	// Interest without forwarding hint
+	_, err = defn.StripForwardingHint(stripped)
+	assert.Error(t, err)
+}
+
+func TestLpReliabilityFields(t *testing.T) {
+	frame := &defn.FwLpPacket{
+		Acks:       []uint64{1, 2, 0xffffffffffff},
+		TxSequence: optional.Some(uint64(42)),
+		Fragment:   enc.Wire{[]byte{0x05, 0x00}},
+	}
+	wire := (&defn.FwPacket{LpPacket: frame}).Encode()
+
+	pkt, err := defn.ParseFwPacket(enc.NewWireView(wire), false)
+	require.NoError(t, err)
+	require.NotNil(t, pkt.LpPacket)
+	assert.Equal(t, []uint64{1, 2, 0xffffffffffff}, pkt.LpPacket.Acks)
+	assert.Equal(t, uint64(42), pkt.LpPacket.TxSequence.Unwrap())
+
+	// IDLE frame with Acks only
+	idle := &defn.FwLpPacket{Acks: []uint64{7}}
+	pkt, err = defn.ParseFwPacket(enc.NewWireView((&defn.FwPacket{LpPacket: idle}).Encode()), false)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{7}, pkt.LpPacket.Acks)
+	assert.False(t, pkt.LpPacket.TxSequence.IsSet())
+	assert.Nil(t, pkt.LpPacket.Fragment)
+}
@@ -21,7 +21,13 @@ type Pkt struct {
 
 	PitToken       []byte
 	CongestionMark optional.Optional[uint64]
+	NackReason     optional.Optional[uint64]
 
 	IncomingFaceID uint64
 	NextHopFaceID  optional.Optional[uint64]
+
+	// Self-learning: the Interest must not be flooded if there is no route
+	NonDiscovery bool
+	// Self-learning: signed Prefix Announcement attached to Data
+	PrefixAnnouncement enc.Wire
 }
@@ -32,6 +32,7 @@ var ErrNotCanonical = errors.New("URI could not be canonized")
 const (
 	unknownURI URIType = iota
 	devURI
+	etherURI
 	fdURI
 	internalURI
 	nullURI
@@ -62,6 +63,16 @@ func MakeDevFaceURI(ifname string) *URI {
 	return uri
 }
 
+// MakeEthernetFaceURI constructs a URI for an Ethernet MAC address.
+func MakeEthernetFaceURI(mac net.HardwareAddr) *URI {
+	uri := new(URI)
+	uri.uriType = etherURI
+	uri.scheme = "ether"
+	uri.path = mac.String()
+	uri.port = 0
+	return uri
+}
+
 // MakeFDFaceURI constructs a file descriptor URI.
 func MakeFDFaceURI(fd int) *URI {
 	uri := new(URI)
@@ -167,6 +178,15 @@ func DecodeURIString(str string) *URI {
 		scheme:  "unknown",
 	}
 
+	// MAC addresses in brackets are rejected by the URL parser
+	if mac, ok := strings.CutPrefix(str, "ether://"); ok {
+		ret.uriType = etherURI
+		ret.scheme = "ether"
+		ret.path = strings.Trim(mac, "[]")
+		ret.Canonize()
+		return ret
+	}
+
 	// extract zone if present first, since this is non-standard
 	var zone string = ""
 	zoneMatch := zoneRegex.FindStringSubmatch(str)
@@ -285,6 +305,9 @@ func (u *URI) IsCanonical() bool {
 	switch u.uriType {
 	case devURI:
 		return u.scheme == "dev" && u.path != "" && u.port == 0
+	case etherURI:
+		mac, err := net.ParseMAC(u.path)
+		return u.scheme == "ether" && err == nil && len(mac) == 6 && mac.String() == u.path && u.port == 0
 	case fdURI:
 		fd, err := strconv.Atoi(u.path)
 		return u.scheme == "fd" && err == nil && fd >= 0 && u.port == 0
@@ -322,6 +345,12 @@ func (u *URI) Canonize() error {
 	switch u.uriType {
 	case devURI, fdURI:
 		// Nothing to do to canonize these
+	case etherURI:
+		mac, err := net.ParseMAC(u.path)
+		if err != nil || len(mac) != 6 {
+			return ErrNotCanonical
+		}
+		u.path = mac.String()
 	case udpURI, tcpURI:
 		path := u.path
 		zone := ""
@@ -389,7 +418,7 @@ func (u *URI) Scope() Scope {
 	}
 
 	switch u.uriType {
-	case devURI:
+	case devURI, etherURI:
 		return NonLocal
 	case fdURI:
 		return Local
@@ -413,6 +442,8 @@ func (u *URI) String() string {
 	switch u.uriType {
 	case devURI:
 		return "dev://" + u.path
+	case etherURI:
+		return "ether://[" + u.path + "]"
 	case fdURI:
 		return "fd://" + u.path
 	case internalURI:
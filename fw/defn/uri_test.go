@@ -1,6 +1,7 @@
 package defn_test
 
 import (
+	"net"
 	"strings"
 	"testing"
 
@@ -121,3 +122,22 @@ func TestDecodeUri(t *testing.T) {
 	assert.Equal(t, "udp6", uri.Scheme())
 	assert.Equal(t, uint16(5000), uri.Port())
 }
+
+func TestDecodeEtherUri(t *testing.T) {
+	uri := defn.DecodeURIString("ether://[01:00:5E:00:17:AA]")
+	assert.True(t, uri.IsCanonical())
+	assert.Equal(t, "ether", uri.Scheme())
+	assert.Equal(t, "01:00:5e:00:17:aa", uri.Path())
+	assert.Equal(t, uint16(0), uri.Port())
+	assert.Equal(t, "ether://[01:00:5e:00:17:aa]", uri.String())
+	assert.Equal(t, defn.NonLocal, uri.Scope())
+
+	mac, _ := net.ParseMAC("02:00:00:00:00:01")
+	uri = defn.MakeEthernetFaceURI(mac)
+	assert.True(t, uri.IsCanonical())
+	assert.Equal(t, "ether://[02:00:00:00:00:01]", uri.String())
+
+	// Invalid MAC address
+	uri = defn.DecodeURIString("ether://[01:00:5e]")
+	assert.False(t, uri.IsCanonical())
+}
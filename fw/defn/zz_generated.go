@@ -1477,7 +1477,11 @@ type FwLpPacketEncoder struct {
 
 	CachePolicy_encoder FwCachePolicyEncoder
 
-	Fragment_length uint
+	Acks_subencoder []struct {
+	}
+
+	PrefixAnnouncement_length uint
+	Fragment_length           uint
 }
 
 type FwLpPacketParsingContext struct {
@@ -1496,6 +1500,33 @@ func (encoder *FwLpPacketEncoder) Init(value *FwLpPacket) {
 		encoder.CachePolicy_encoder.Init(value.CachePolicy)
 	}
 
+	{
+		Acks_l := len(value.Acks)
+		encoder.Acks_subencoder = make([]struct {
+		}, Acks_l)
+		for i := 0; i < Acks_l; i++ {
+			pseudoEncoder := &encoder.Acks_subencoder[i]
+			pseudoValue := struct {
+				Acks uint64
+			}{
+				Acks: value.Acks[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	if value.PrefixAnnouncement != nil {
+		encoder.PrefixAnnouncement_length = 0
+		for _, c := range value.PrefixAnnouncement {
+			encoder.PrefixAnnouncement_length += uint(len(c))
+		}
+	}
 	if value.Fragment != nil {
 		encoder.Fragment_length = 0
 		for _, c := range value.Fragment {
@@ -1543,6 +1574,37 @@ func (encoder *FwLpPacketEncoder) Init(value *FwLpPacket) {
 		l += 3
 		l += uint(1 + enc.Nat(optval).EncodingLength())
 	}
+	if value.Acks != nil {
+		for seq_i, seq_v := range value.Acks {
+			pseudoEncoder := &encoder.Acks_subencoder[seq_i]
+			pseudoValue := struct {
+				Acks uint64
+			}{
+				Acks: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				l += 3
+				l += 1 + 8
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.TxSequence.IsSet() {
+		l += 3
+		l += 1 + 8
+	}
+	if value.NonDiscovery {
+		l += 3
+		l += 1
+	}
+	if value.PrefixAnnouncement != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.PrefixAnnouncement_length).EncodingLength())
+		l += encoder.PrefixAnnouncement_length
+	}
 	if value.Fragment != nil {
 		l += 1
 		l += uint(enc.TLNum(encoder.Fragment_length).EncodingLength())
@@ -1591,6 +1653,42 @@ func (encoder *FwLpPacketEncoder) Init(value *FwLpPacket) {
 		l += 3
 		l += uint(1 + enc.Nat(optval).EncodingLength())
 	}
+	if value.Acks != nil {
+		for seq_i, seq_v := range value.Acks {
+			pseudoEncoder := &encoder.Acks_subencoder[seq_i]
+			pseudoValue := struct {
+				Acks uint64
+			}{
+				Acks: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				l += 3
+				l += 1 + 8
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if value.TxSequence.IsSet() {
+		l += 3
+		l += 1 + 8
+	}
+	if value.NonDiscovery {
+		l += 3
+		l += 1
+	}
+	if value.PrefixAnnouncement != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.PrefixAnnouncement_length).EncodingLength())
+		wirePlan = append(wirePlan, l)
+		l = 0
+		for range value.PrefixAnnouncement {
+			wirePlan = append(wirePlan, l)
+			l = 0
+		}
+	}
 	if value.Fragment != nil {
 		l += 1
 		l += uint(enc.TLNum(encoder.Fragment_length).EncodingLength())
@@ -1699,6 +1797,66 @@ func (encoder *FwLpPacketEncoder) EncodeInto(value *FwLpPacket, wire enc.Wire) {
 		pos += uint(1 + buf[pos])
 
 	}
+	if value.Acks != nil {
+		for seq_i, seq_v := range value.Acks {
+			pseudoEncoder := &encoder.Acks_subencoder[seq_i]
+			pseudoValue := struct {
+				Acks uint64
+			}{
+				Acks: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				buf[pos] = 253
+				binary.BigEndian.PutUint16(buf[pos+1:], uint16(836))
+				pos += 3
+				buf[pos] = 8
+				binary.BigEndian.PutUint64(buf[pos+1:], uint64(value.Acks))
+				pos += 9
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	if optval, ok := value.TxSequence.Get(); ok {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(840))
+		pos += 3
+		buf[pos] = 8
+		binary.BigEndian.PutUint64(buf[pos+1:], uint64(optval))
+		pos += 9
+	}
+	if value.NonDiscovery {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(844))
+		pos += 3
+		buf[pos] = byte(0)
+		pos += 1
+	}
+	if value.PrefixAnnouncement != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(848))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.PrefixAnnouncement_length).EncodeInto(buf[pos:]))
+		wireIdx++
+		pos = 0
+		if wireIdx < len(wire) {
+			buf = wire[wireIdx]
+		} else {
+			buf = nil
+		}
+		for _, w := range value.PrefixAnnouncement {
+			wire[wireIdx] = w
+			wireIdx++
+			pos = 0
+			if wireIdx < len(wire) {
+				buf = wire[wireIdx]
+			} else {
+				buf = nil
+			}
+		}
+	}
 	if value.Fragment != nil {
 		buf[pos] = byte(80)
 		pos += 1
@@ -1753,6 +1911,10 @@ func (context *FwLpPacketParsingContext) Parse(reader enc.WireView, ignoreCritic
 	var handled_NextHopFaceId bool = false
 	var handled_CachePolicy bool = false
 	var handled_CongestionMark bool = false
+	var handled_Acks bool = false
+	var handled_TxSequence bool = false
+	var handled_NonDiscovery bool = false
+	var handled_PrefixAnnouncement bool = false
 	var handled_Fragment bool = false
 
 	progress := -1
@@ -1937,6 +2099,75 @@ func (context *FwLpPacketParsingContext) Parse(reader enc.WireView, ignoreCritic
 						value.CongestionMark.Set(optval)
 					}
 				}
+			case 836:
+				if true {
+					handled = true
+					handled_Acks = true
+					if value.Acks == nil {
+						value.Acks = make([]uint64, 0)
+					}
+					{
+						pseudoValue := struct {
+							Acks uint64
+						}{}
+						{
+							value := &pseudoValue
+							value.Acks = uint64(0)
+							{
+								for i := 0; i < int(l); i++ {
+									x := byte(0)
+									x, err = reader.ReadByte()
+									if err != nil {
+										if err == io.EOF {
+											err = io.ErrUnexpectedEOF
+										}
+										break
+									}
+									value.Acks = uint64(value.Acks<<8) | uint64(x)
+								}
+							}
+							_ = value
+						}
+						value.Acks = append(value.Acks, pseudoValue.Acks)
+					}
+					progress--
+				}
+			case 840:
+				if true {
+					handled = true
+					handled_TxSequence = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.TxSequence.Set(optval)
+					}
+				}
+			case 844:
+				if true {
+					handled = true
+					handled_NonDiscovery = true
+					value.NonDiscovery = true
+					err = reader.Skip(int(l))
+				}
+			case 848:
+				if true {
+					handled = true
+					handled_PrefixAnnouncement = true
+					value.PrefixAnnouncement, err = reader.ReadWire(int(l))
+				}
 			case 80:
 				if true {
 					handled = true
@@ -1988,6 +2219,18 @@ func (context *FwLpPacketParsingContext) Parse(reader enc.WireView, ignoreCritic
 	if !handled_CongestionMark && err == nil {
 		value.CongestionMark.Unset()
 	}
+	if !handled_Acks && err == nil {
+		// sequence - skip
+	}
+	if !handled_TxSequence && err == nil {
+		value.TxSequence.Unset()
+	}
+	if !handled_NonDiscovery && err == nil {
+		value.NonDiscovery = false
+	}
+	if !handled_PrefixAnnouncement && err == nil {
+		value.PrefixAnnouncement = nil
+	}
 	if !handled_Fragment && err == nil {
 		value.Fragment = nil
 	}
@@ -8,9 +8,8 @@
 package dispatch
 
 import (
-	"sync"
-
 	"github.com/named-data/ndnd/fw/defn"
+	enc "github.com/named-data/ndnd/std/encoding"
 )
 
 // Face provides an interface that faces can satisfy (to avoid circular dependency between faces and forwarding)
@@ -34,19 +33,20 @@ type OutPkt struct {
 	Pkt      *defn.Pkt
 	PitToken []byte
 	InFace   uint64
-}
 
-// FaceDispatch is used to allow forwarding to interact with faces without a circular dependency issue.
-var FaceDispatch sync.Map
+	// Self-learning fields, which are set for each hop
+	NonDiscovery       bool
+	PrefixAnnouncement enc.Wire
+}
 
 // AddFace adds the specified face to the dispatch list.
-func AddFace(id uint64, face Face) {
-	FaceDispatch.Store(id, face)
+func (d *Forwarder) AddFace(id uint64, face Face) {
+	d.faces.Store(id, face)
 }
 
 // GetFace returns the specified face or nil if it does not exist.
-func GetFace(id uint64) Face {
-	face, ok := FaceDispatch.Load(id)
+func (d *Forwarder) GetFace(id uint64) Face {
+	face, ok := d.faces.Load(id)
 	if !ok {
 		return nil
 	}
@@ -54,6 +54,13 @@ func GetFace(id uint64) Face {
 }
 
 // RemoveFace removes the specified face from the dispatch map.
-func RemoveFace(id uint64) {
-	FaceDispatch.Delete(id)
+func (d *Forwarder) RemoveFace(id uint64) {
+	d.faces.Delete(id)
+}
+
+// RangeFaces calls f for each face, until f returns false.
+func (d *Forwarder) RangeFaces(f func(face Face) bool) {
+	d.faces.Range(func(_, value any) bool {
+		return f(value.(Face))
+	})
 }
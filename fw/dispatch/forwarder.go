@@ -0,0 +1,26 @@
+package dispatch
+
+import (
+	"sync"
+
+	"github.com/named-data/ndnd/fw/table"
+)
+
+// Forwarder holds the faces, forwarding threads and tables of a forwarder,
+// so that they can interact without a circular dependency. The forwarding
+// daemon has a single instance, but several forwarders may run in the same
+// process, e.g. in a network emulation.
+type Forwarder struct {
+	// FIB-Strategy table of the forwarder
+	Fib table.FibStrategy
+	// Routing Information Base of the forwarder, which updates the FIB
+	Rib *table.RibTable
+
+	faces   sync.Map
+	threads []FWThread
+}
+
+// NewForwarder creates a forwarder with its tables, and without faces or forwarding threads.
+func NewForwarder(fib table.FibStrategy, rib *table.RibTable) *Forwarder {
+	return &Forwarder{Fib: fib, Rib: rib}
+}
@@ -18,23 +18,26 @@ type FWThread interface {
 
 	QueueData(packet *defn.Pkt)
 	QueueInterest(packet *defn.Pkt)
+	QueueNack(packet *defn.Pkt)
 
 	Counters() defn.FWThreadCounters
 }
 
-// FWDispatch is used to allow faces to interact with forwarding without a circular dependency issue.
-var FWDispatch []FWThread
-
 // InitializeFWThreads sets up the forwarding thread dispatch slice.
-func InitializeFWThreads(faces []FWThread) {
-	FWDispatch = make([]FWThread, len(faces))
-	copy(FWDispatch, faces)
+func (d *Forwarder) InitializeFWThreads(threads []FWThread) {
+	d.threads = make([]FWThread, len(threads))
+	copy(d.threads, threads)
 }
 
 // GetFWThread returns the specified forwarding thread or nil if it does not exist.
-func GetFWThread(id int) FWThread {
-	if id < 0 || id > len(FWDispatch) {
+func (d *Forwarder) GetFWThread(id int) FWThread {
+	if id < 0 || id >= len(d.threads) {
 		return nil
 	}
-	return FWDispatch[id]
+	return d.threads[id]
+}
+
+// NumFWThreads returns the number of forwarding threads.
+func (d *Forwarder) NumFWThreads() int {
+	return len(d.threads)
 }
@@ -0,0 +1,213 @@
+package face
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path"
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/utils/pcapng"
+)
+
+// CaptureListener serves packet captures to local subscribers over a Unix socket.
+//
+// A subscriber sends a CaptureFilter as a line of JSON, and then receives the
+// selected frames of all faces as a pcapng stream until it disconnects. Each face
+// is an Ethernet interface, and each frame is encapsulated in an Ethernet header
+// with the NDN EtherType, which the Wireshark NDN dissector understands.
+type CaptureListener struct {
+	path     string
+	listener net.Listener
+	stopped  chan bool
+}
+
+// MakeCaptureListener constructs a CaptureListener on the given socket path.
+func MakeCaptureListener(path string) *CaptureListener {
+	return &CaptureListener{
+		path:    path,
+		stopped: make(chan bool, 1),
+	}
+}
+
+// String returns the log identifier of the listener.
+func (l *CaptureListener) String() string {
+	return fmt.Sprintf("capture-listener (%s)", l.path)
+}
+
+// Listen creates the Unix socket, which is only accessible by the owner
+// since captured traffic may be sensitive. The socket is created in a private
+// directory and then moved to its path, so that it is never accessible by others.
+func (l *CaptureListener) Listen() error {
+	os.Remove(l.path)
+	os.MkdirAll(path.Dir(l.path), os.ModePerm)
+
+	dir, err := os.MkdirTemp(path.Dir(l.path), ".capture-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	tmpPath := path.Join(dir, "sock")
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: tmpPath, Net: "unix"})
+	if err != nil {
+		return err
+	}
+	listener.SetUnlinkOnClose(false) // the socket is removed from its final path on close
+
+	if err := os.Chmod(tmpPath, 0600); err != nil {
+		listener.Close()
+		return err
+	}
+	if err := os.Rename(tmpPath, l.path); err != nil {
+		listener.Close()
+		return err
+	}
+	l.listener = listener
+	return nil
+}
+
+// Run accepts subscribers until the listener is closed.
+func (l *CaptureListener) Run() {
+	defer func() { l.stopped <- true }()
+
+	core.Log.Info(l, "Listening for capture subscribers")
+	for !core.ShouldQuit {
+		conn, err := l.listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				core.Log.Warn(l, "Unable to accept connection", "err", err)
+			}
+			return
+		}
+		go l.serve(conn)
+	}
+}
+
+// Close stops accepting subscribers. Current subscribers are not disconnected.
+func (l *CaptureListener) Close() {
+	if l.listener != nil {
+		l.listener.Close()
+		<-l.stopped
+		l.listener = nil
+		os.Remove(l.path)
+	}
+}
+
+// serve streams the frames selected by the filter of a subscriber.
+func (l *CaptureListener) serve(conn net.Conn) {
+	defer conn.Close()
+
+	// Read the filter
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	reader := bufio.NewReader(conn)
+	line, err := reader.ReadBytes('\n')
+	if err != nil {
+		core.Log.Warn(l, "Unable to read capture filter", "err", err)
+		return
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	filter := CaptureFilter{}
+	if err := json.Unmarshal(line, &filter); err != nil {
+		core.Log.Warn(l, "Invalid capture filter", "err", err)
+		return
+	}
+
+	tap := &captureTap{
+		faces:  filter.Faces,
+		frames: make(chan CapturedFrame, CfgFaceQueueSize()),
+	}
+	for _, prefix := range filter.Prefixes {
+		name, err := enc.NameFromStr(prefix)
+		if err != nil {
+			core.Log.Warn(l, "Invalid prefix in capture filter", "prefix", prefix, "err", err)
+			return
+		}
+		tap.prefixes = append(tap.prefixes, name)
+	}
+
+	writer := bufio.NewWriter(conn)
+	pcap, err := pcapng.NewWriter(writer)
+	if err != nil {
+		return
+	}
+
+	addCaptureTap(tap)
+	defer removeCaptureTap(tap)
+	core.Log.Info(l, "Started capture", "faces", filter.Faces, "prefixes", filter.Prefixes)
+
+	// The subscriber sends nothing more, so a read returns when it disconnects
+	closed := make(chan struct{})
+	go func() {
+		io.Copy(io.Discard, reader)
+		close(closed)
+	}()
+
+	ifaces := make(map[uint64]uint32)
+	for {
+		var frame CapturedFrame
+		select {
+		case frame = <-tap.frames:
+		case <-closed:
+			core.Log.Info(l, "Stopped capture", "dropped", tap.nDropped.Load())
+			return
+		}
+
+		if err := l.writeFrame(pcap, ifaces, frame); err != nil {
+			core.Log.Info(l, "Stopped capture", "dropped", tap.nDropped.Load(), "err", err)
+			return
+		}
+
+		// Flush when there are no more frames waiting
+		if len(tap.frames) == 0 {
+			if err := writer.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// writeFrame writes a frame, and the description of its face the first time it is seen.
+func (l *CaptureListener) writeFrame(pcap *pcapng.Writer, ifaces map[uint64]uint32, frame CapturedFrame) error {
+	id, ok := ifaces[frame.FaceID]
+	if !ok {
+		iface := pcapng.Interface{
+			LinkType: pcapng.LinkTypeEthernet,
+			Name:     fmt.Sprintf("face %d", frame.FaceID),
+		}
+		if face := FaceTable.Get(frame.FaceID); face != nil {
+			iface.Description = face.RemoteURI().String()
+		}
+
+		var err error
+		if id, err = pcap.AddInterface(iface); err != nil {
+			return err
+		}
+		ifaces[frame.FaceID] = id
+	}
+
+	// Ethernet header with zero addresses and the NDN EtherType
+	data := make([]byte, 14, 14+len(frame.Frame))
+	binary.BigEndian.PutUint16(data[12:], pcapng.EtherTypeNDN)
+	data = append(data, frame.Frame...)
+
+	direction := pcapng.DirectionOutbound
+	if frame.Inbound {
+		direction = pcapng.DirectionInbound
+	}
+
+	return pcap.WritePacket(pcapng.Packet{
+		Interface: id,
+		Time:      frame.Time,
+		Direction: direction,
+		Data:      data,
+	})
+}
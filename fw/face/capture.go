@@ -0,0 +1,192 @@
+package face
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+)
+
+// CaptureFilter selects the frames mirrored to a capture subscriber.
+// Empty lists select all faces or all names.
+type CaptureFilter struct {
+	// IDs of the captured faces
+	Faces []uint64 `json:"faces"`
+	// Name prefixes of the captured packets. Only the first fragment of
+	// a fragmented packet can be matched, and frames without a packet
+	// (e.g. LP IDLE frames with Acks) are not captured.
+	Prefixes []string `json:"prefixes"`
+}
+
+// CapturedFrame is a frame mirrored to a capture subscriber.
+type CapturedFrame struct {
+	FaceID  uint64
+	Time    time.Time
+	Inbound bool
+	Frame   []byte
+}
+
+// captureTap is a capture subscriber.
+type captureTap struct {
+	faces    []uint64
+	prefixes []enc.Name
+	frames   chan CapturedFrame
+	nDropped atomic.Uint64
+}
+
+var (
+	// captureTaps are the current capture subscribers, read without locking by faces
+	captureTaps atomic.Pointer[[]*captureTap]
+	// captureMutex serializes changes to the capture subscribers
+	captureMutex sync.Mutex
+)
+
+// addCaptureTap registers a capture subscriber.
+func addCaptureTap(tap *captureTap) {
+	captureMutex.Lock()
+	defer captureMutex.Unlock()
+
+	var taps []*captureTap
+	if cur := captureTaps.Load(); cur != nil {
+		taps = slices.Clone(*cur)
+	}
+	taps = append(taps, tap)
+	captureTaps.Store(&taps)
+}
+
+// removeCaptureTap unregisters a capture subscriber.
+func removeCaptureTap(tap *captureTap) {
+	captureMutex.Lock()
+	defer captureMutex.Unlock()
+
+	cur := captureTaps.Load()
+	if cur == nil {
+		return
+	}
+	taps := slices.DeleteFunc(slices.Clone(*cur), func(t *captureTap) bool { return t == tap })
+	if len(taps) == 0 {
+		captureTaps.Store(nil)
+	} else {
+		captureTaps.Store(&taps)
+	}
+}
+
+// captureFrame mirrors a frame sent or received on a face to the matching subscribers.
+// The frame is copied, so the caller may reuse its buffer.
+func captureFrame(faceID uint64, frame []byte, inbound bool) {
+	taps := captureTaps.Load()
+	if taps == nil {
+		return
+	}
+
+	var name enc.Name
+	var captured *CapturedFrame
+	nameRead := false
+
+	for _, tap := range *taps {
+		if len(tap.faces) > 0 && !slices.Contains(tap.faces, faceID) {
+			continue
+		}
+		if len(tap.prefixes) > 0 {
+			if !nameRead {
+				name, nameRead = frameName(frame), true
+			}
+			if name == nil || !slices.ContainsFunc(tap.prefixes, func(prefix enc.Name) bool {
+				return prefix.IsPrefix(name)
+			}) {
+				continue
+			}
+		}
+
+		if captured == nil {
+			captured = &CapturedFrame{
+				FaceID:  faceID,
+				Time:    time.Now(),
+				Inbound: inbound,
+				Frame:   slices.Clone(frame),
+			}
+		}
+
+		select {
+		case tap.frames <- *captured:
+		default:
+			tap.nDropped.Add(1)
+		}
+	}
+}
+
+// frameName returns the name of the packet in a frame, or nil if it cannot be read.
+// The name of a fragmented packet is read from its first fragment, and is nil
+// for the other fragments.
+func frameName(frame []byte) enc.Name {
+	r := enc.NewBufferView(frame)
+	typ, err := r.ReadTLNum()
+	if err != nil {
+		return nil
+	}
+	if _, err := r.ReadTLNum(); err != nil {
+		return nil
+	}
+
+	// Find the fragment in an LpPacket
+	if typ == 0x64 {
+		found := false
+		for !found && !r.IsEOF() {
+			fieldTyp, err1 := r.ReadTLNum()
+			fieldLen, err2 := r.ReadTLNum()
+			if err1 != nil || err2 != nil {
+				return nil
+			}
+
+			switch fieldTyp {
+			case 0x52: // FragIndex
+				buf, err := r.ReadBuf(int(fieldLen))
+				if err != nil {
+					return nil
+				}
+				if index, _, err := enc.ParseNat(buf); err != nil || index != 0 {
+					return nil
+				}
+			case 0x50: // Fragment
+				if typ, err = r.ReadTLNum(); err != nil {
+					return nil
+				}
+				if _, err = r.ReadTLNum(); err != nil {
+					return nil
+				}
+				found = true
+			default:
+				if r.Skip(int(fieldLen)) != nil {
+					return nil
+				}
+			}
+		}
+		if !found {
+			return nil
+		}
+	}
+
+	// The name is the first element of an Interest or Data
+	if typ != 0x05 && typ != 0x06 {
+		return nil
+	}
+	if nameTyp, err := r.ReadTLNum(); err != nil || nameTyp != enc.TypeName {
+		return nil
+	}
+	nameLen, err := r.ReadTLNum()
+	if err != nil {
+		return nil
+	}
+	buf, err := r.ReadBuf(int(nameLen))
+	if err != nil {
+		return nil
+	}
+	nameView := enc.NewBufferView(buf)
+	name, err := nameView.ReadName()
+	if err != nil {
+		return nil
+	}
+	return name
+}
@@ -0,0 +1,96 @@
+package face
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/fw/defn"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	sig "github.com/named-data/ndnd/std/security/signer"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lpFrame encodes an LP frame.
+func lpFrame(lp defn.FwLpPacket) []byte {
+	return (&defn.FwPacket{LpPacket: &lp}).Encode().Join()
+}
+
+func TestFrameName(t *testing.T) {
+	name, _ := enc.NameFromStr("/ndn/test/frame")
+
+	interest, err := spec.Spec{}.MakeInterest(name, &ndn.InterestConfig{
+		Nonce:    optional.Some(uint32(1)),
+		Lifetime: optional.Some(time.Second),
+	}, nil, nil)
+	require.NoError(t, err)
+	data, err := spec.Spec{}.MakeData(name, &ndn.DataConfig{}, enc.Wire{[]byte("content")}, sig.NewSha256Signer())
+	require.NoError(t, err)
+
+	interestWire := interest.Wire.Join()
+	dataWire := data.Wire.Join()
+
+	tests := []struct {
+		desc  string
+		frame []byte
+		name  enc.Name
+	}{
+		{"Interest", interestWire, name},
+		{"Data", dataWire, name},
+		{"LpPacket", lpFrame(defn.FwLpPacket{
+			PitToken: []byte{1, 2, 3, 4, 5, 6},
+			Fragment: enc.Wire{dataWire},
+		}), name},
+		// The first fragment holds the name of a fragmented packet
+		{"first fragment", lpFrame(defn.FwLpPacket{
+			Sequence:  optional.Some(uint64(10)),
+			FragIndex: optional.Some(uint64(0)),
+			FragCount: optional.Some(uint64(2)),
+			Fragment:  enc.Wire{dataWire[:32]},
+		}), name},
+		{"other fragment", lpFrame(defn.FwLpPacket{
+			Sequence:  optional.Some(uint64(11)),
+			FragIndex: optional.Some(uint64(1)),
+			FragCount: optional.Some(uint64(2)),
+			Fragment:  enc.Wire{dataWire[32:]},
+		}), nil},
+		{"IDLE frame", lpFrame(defn.FwLpPacket{
+			Acks: []uint64{10},
+		}), nil},
+		{"not NDN", []byte{0x45, 0x00, 0x00, 0x1c, 0x00, 0x01}, nil},
+		{"truncated name", interestWire[:8], nil},
+		{"empty", []byte{}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			assert.True(t, test.name.Equal(frameName(test.frame)), "got %s", frameName(test.frame))
+		})
+	}
+}
+
+func TestCaptureListenerPermissions(t *testing.T) {
+	sockPath := path.Join(t.TempDir(), "capture.sock")
+	l := MakeCaptureListener(sockPath)
+	require.NoError(t, l.Listen())
+	go l.Run()
+
+	info, err := os.Stat(sockPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.ModeSocket, info.Mode().Type())
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// The private directory of the socket is removed
+	entries, err := os.ReadDir(path.Dir(sockPath))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	l.Close()
+	_, err = os.Stat(sockPath)
+	assert.True(t, os.IsNotExist(err))
+}
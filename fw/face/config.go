@@ -12,11 +12,13 @@ import (
 	"time"
 
 	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/dispatch"
 )
 
-// Initialize initializes the face module.
-func Initialize() {
-	FaceTable.nextFaceID.Store(1)
+// Initialize initializes the face module, with the face table of the forwarder.
+func Initialize(fwd *dispatch.Forwarder) {
+	FaceTable = NewTable(fwd)
+	loadShapingClasses()
 	go FaceTable.expirationHandler()
 }
 
@@ -71,7 +73,22 @@ func CfgTCPLifetime() time.Duration {
 	return time.Duration(core.C.Faces.Tcp.Lifetime) * time.Second
 }
 
+// CfgEtherMulticastAddress returns the configured multicast Ethernet group address.
+func CfgEtherMulticastAddress() string {
+	return core.C.Faces.Ether.MulticastAddress
+}
+
+// CfgEtherLifetime returns the lifetime of on-demand Ethernet faces after they become idle.
+func CfgEtherLifetime() time.Duration {
+	return time.Duration(core.C.Faces.Ether.Lifetime) * time.Second
+}
+
 // CfgUnixSocketPath returns the configured Unix socket file path.
 func CfgUnixSocketPath() string {
 	return os.ExpandEnv(core.C.Faces.Unix.SocketPath)
 }
+
+// CfgCaptureSocketPath returns the Unix socket path of packet captures.
+func CfgCaptureSocketPath() string {
+	return os.ExpandEnv(core.C.Faces.Capture.SocketPath)
+}
@@ -0,0 +1,95 @@
+/* YaNFD - Yet another NDN Forwarding Daemon
+ *
+ * Copyright (C) 2020-2021 Eric Newberry.
+ *
+ * This file is licensed under the terms of the MIT License, as found in LICENSE.md.
+ */
+
+package face
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/named-data/ndnd/fw/core"
+	defn "github.com/named-data/ndnd/fw/defn"
+	spec_mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+)
+
+// EthernetListener creates on-demand unicast Ethernet faces for
+// frames received on a network interface from unknown peers.
+type EthernetListener struct {
+	socket   *etherSocket
+	localURI *defn.URI
+	stop     chan bool
+	stopped  chan struct{}
+}
+
+// MakeEthernetListener constructs an EthernetListener on the interface of the local dev:// URI.
+func MakeEthernetListener(localURI *defn.URI) (*EthernetListener, error) {
+	if !localURI.IsCanonical() || localURI.Scheme() != "dev" {
+		return nil, defn.ErrNotCanonical
+	}
+
+	socket, err := acquireEtherSocket(localURI.Path())
+	if err != nil {
+		return nil, err
+	}
+
+	l := new(EthernetListener)
+	l.socket = socket
+	l.localURI = localURI
+	l.stop = make(chan bool, 1)
+	l.stopped = make(chan struct{})
+	return l, nil
+}
+
+// String returns a string identifying the listener.
+func (l *EthernetListener) String() string {
+	return fmt.Sprintf("ether-listener (%s)", l.localURI)
+}
+
+// Run starts the Ethernet listener, and returns when the listener is closed
+// or the socket of the interface fails.
+func (l *EthernetListener) Run() {
+	defer close(l.stopped)
+	defer l.socket.release()
+
+	l.socket.setListener(l.accept)
+	defer l.socket.setListener(nil)
+
+	select {
+	case <-l.stop:
+	case <-l.socket.closed:
+		if !core.ShouldQuit {
+			core.Log.Warn(l, "Socket of interface closed")
+		}
+	}
+}
+
+// accept creates a face for a frame received from a new remote endpoint.
+// It is called by the socket for frames from addresses without a face.
+func (l *EthernetListener) accept(src net.HardwareAddr, frame []byte) {
+	remoteURI := defn.MakeEthernetFaceURI(src)
+	newTransport, err := MakeUnicastEthernetTransport(remoteURI, l.localURI, spec_mgmt.PersistencyOnDemand)
+	if err != nil {
+		core.Log.Error(l, "Failed to create new unicast Ethernet transport", "err", err)
+		return
+	}
+
+	core.Log.Info(l, "Accepting new Ethernet face", "uri", newTransport.RemoteURI())
+	MakeNDNLPLinkService(newTransport, MakeNDNLPLinkServiceOptions()).Run(frame)
+
+	// The link service is ready, so frames that arrive before the face
+	// starts receiving are not dropped (this is the receiving goroutine)
+	newTransport.ready.Store(true)
+}
+
+// Close stops the listener and waits for the listener goroutine to terminate.
+func (l *EthernetListener) Close() {
+	select {
+	case l.stop <- true:
+	default: // already told to stop
+	}
+	<-l.stopped
+}
@@ -0,0 +1,201 @@
+/* YaNFD - Yet another NDN Forwarding Daemon
+ *
+ * Copyright (C) 2020-2021 Eric Newberry.
+ *
+ * This file is licensed under the terms of the MIT License, as found in LICENSE.md.
+ */
+
+package face
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/named-data/ndnd/fw/core"
+	defn "github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/face/impl"
+)
+
+// etherSockets contains the open packet sockets, by interface name.
+var etherSockets = struct {
+	mutex   sync.Mutex
+	sockets map[string]*etherSocket
+}{sockets: make(map[string]*etherSocket)}
+
+// etherSocket is a packet socket shared by all Ethernet faces of a network interface.
+// Received frames are demultiplexed to the faces by source MAC address. Frames from
+// unknown unicast sources are passed to the listener of the interface, if any.
+type etherSocket struct {
+	conn  *impl.EtherConn
+	iface *net.Interface
+	nRefs int // protected by etherSockets.mutex
+	// closed is closed when the socket stops receiving
+	closed chan struct{}
+
+	mutex     sync.RWMutex
+	unicast   map[string]*EthernetTransport // by remote MAC address
+	multicast *EthernetTransport
+	listener  func(src net.HardwareAddr, frame []byte)
+}
+
+// acquireEtherSocket returns the packet socket of an interface, opening it if needed.
+// The socket must be released with release when it is no longer used.
+func acquireEtherSocket(ifname string) (*etherSocket, error) {
+	etherSockets.mutex.Lock()
+	defer etherSockets.mutex.Unlock()
+
+	if s := etherSockets.sockets[ifname]; s != nil {
+		s.nRefs++
+		return s, nil
+	}
+
+	iface, err := net.InterfaceByName(ifname)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find interface %s: %w", ifname, err)
+	}
+
+	conn, err := impl.ListenEther(iface, EtherType)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &etherSocket{
+		conn:    conn,
+		iface:   iface,
+		nRefs:   1,
+		closed:  make(chan struct{}),
+		unicast: make(map[string]*EthernetTransport),
+	}
+	etherSockets.sockets[ifname] = s
+	go s.runReceive()
+
+	return s, nil
+}
+
+// release closes the socket once it is no longer used by any face or listener.
+func (s *etherSocket) release() {
+	etherSockets.mutex.Lock()
+	defer etherSockets.mutex.Unlock()
+
+	s.nRefs--
+	if s.nRefs == 0 {
+		if etherSockets.sockets[s.iface.Name] == s {
+			delete(etherSockets.sockets, s.iface.Name)
+		}
+		s.conn.Close()
+	}
+}
+
+// String returns a string identifying the socket.
+func (s *etherSocket) String() string {
+	return fmt.Sprintf("ether-socket (%s)", s.iface.Name)
+}
+
+// addTransport registers a face to receive frames from its remote address.
+// Returns false if the interface already has a face for the address.
+func (s *etherSocket) addTransport(t *EthernetTransport) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if t.linkType == defn.MultiAccess {
+		if s.multicast != nil {
+			return false
+		}
+		s.multicast = t
+		return true
+	}
+
+	key := string(t.remoteAddr)
+	if s.unicast[key] != nil {
+		return false
+	}
+	s.unicast[key] = t
+	return true
+}
+
+// removeTransport unregisters a face.
+func (s *etherSocket) removeTransport(t *EthernetTransport) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.multicast == t {
+		s.multicast = nil
+	} else if key := string(t.remoteAddr); s.unicast[key] == t {
+		delete(s.unicast, key)
+	}
+}
+
+// setListener sets the handler of frames from unknown unicast sources.
+func (s *etherSocket) setListener(listener func(src net.HardwareAddr, frame []byte)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.listener = listener
+}
+
+// runReceive receives frames and passes them to the face of their source address,
+// until the socket is closed. If the socket fails, all faces on it are closed.
+func (s *etherSocket) runReceive() {
+	defer close(s.closed)
+
+	recvBuf := make([]byte, defn.MaxNDNPacketSize)
+	for {
+		readSize, src, multicast, err := s.conn.ReadFrom(recvBuf)
+		if err != nil {
+			s.closeAll(err)
+			return
+		}
+
+		frame := trimEtherPadding(recvBuf[:readSize])
+		if frame == nil {
+			core.Log.Debug(s, "Received malformed frame - DROP", "src", src)
+			continue
+		}
+
+		// The multicast face accepts all frames sent to a group
+		s.mutex.RLock()
+		var t *EthernetTransport
+		if multicast {
+			t = s.multicast
+		} else {
+			t = s.unicast[string(src)]
+		}
+		listener := s.listener
+		s.mutex.RUnlock()
+
+		switch {
+		case t != nil:
+			t.receiveFrame(frame, readSize)
+		case !multicast && listener != nil:
+			listener(src, frame)
+		}
+	}
+}
+
+// closeAll closes all faces on the socket after a socket error.
+func (s *etherSocket) closeAll(err error) {
+	// Faces created from now on will open a new socket
+	etherSockets.mutex.Lock()
+	if etherSockets.sockets[s.iface.Name] == s {
+		delete(etherSockets.sockets, s.iface.Name)
+	}
+	etherSockets.mutex.Unlock()
+
+	s.mutex.RLock()
+	transports := make([]*EthernetTransport, 0, len(s.unicast)+1)
+	for _, t := range s.unicast {
+		transports = append(transports, t)
+	}
+	if s.multicast != nil {
+		transports = append(transports, s.multicast)
+	}
+	s.mutex.RUnlock()
+
+	// Closing the socket after the last face was removed is not an error
+	if len(transports) > 0 {
+		core.Log.Warn(s, "Unable to read from socket - Faces DOWN", "err", err)
+	}
+	for _, t := range transports {
+		t.Close()
+	}
+}
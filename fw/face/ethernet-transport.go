@@ -0,0 +1,216 @@
+/* YaNFD - Yet another NDN Forwarding Daemon
+ *
+ * Copyright (C) 2020-2021 Eric Newberry.
+ *
+ * This file is licensed under the terms of the MIT License, as found in LICENSE.md.
+ */
+
+package face
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	defn "github.com/named-data/ndnd/fw/defn"
+	enc "github.com/named-data/ndnd/std/encoding"
+	spec_mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+)
+
+// EtherType is the Ethernet type of NDN frames.
+const EtherType = 0x8624
+
+// EthernetTransport is a unicast or multicast Ethernet transport over a raw packet socket.
+// All transports of a network interface share the same socket.
+type EthernetTransport struct {
+	socket     *etherSocket
+	remoteAddr net.HardwareAddr
+	// ready is set once the link service receives frames
+	ready  atomic.Bool
+	closed chan struct{}
+	transportBase
+}
+
+// MakeUnicastEthernetTransport creates a new unicast Ethernet transport to the remote
+// MAC address, on the network interface specified by the local dev:// URI.
+func MakeUnicastEthernetTransport(
+	remoteURI *defn.URI,
+	localURI *defn.URI,
+	persistency spec_mgmt.Persistency,
+) (*EthernetTransport, error) {
+	// Validate remote URI
+	if remoteURI == nil || !remoteURI.IsCanonical() || remoteURI.Scheme() != "ether" {
+		return nil, defn.ErrNotCanonical
+	}
+
+	remoteAddr, _ := net.ParseMAC(remoteURI.Path())
+	if remoteAddr[0]&0x01 != 0 {
+		return nil, errors.New("remote address must be unicast")
+	}
+
+	t, err := makeEthernetTransport(remoteURI, localURI, persistency, defn.PointToPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	t.expirationTime = new(time.Time)
+	*t.expirationTime = time.Now().Add(CfgEtherLifetime())
+
+	return t, nil
+}
+
+// MakeMulticastEthernetTransport creates a new multicast Ethernet transport on
+// the network interface specified by the local dev:// URI.
+func MakeMulticastEthernetTransport(localURI *defn.URI) (*EthernetTransport, error) {
+	group, err := net.ParseMAC(CfgEtherMulticastAddress())
+	if err != nil || group[0]&0x01 == 0 {
+		return nil, fmt.Errorf("invalid multicast address %s", CfgEtherMulticastAddress())
+	}
+
+	t, err := makeEthernetTransport(
+		defn.MakeEthernetFaceURI(group), localURI,
+		spec_mgmt.PersistencyPermanent, defn.MultiAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = t.socket.conn.JoinGroup(group); err != nil {
+		t.Close()
+		return nil, fmt.Errorf("unable to join group %s: %w", group, err)
+	}
+
+	return t, nil
+}
+
+// makeEthernetTransport attaches a transport to the packet socket on the interface of the local URI.
+func makeEthernetTransport(
+	remoteURI *defn.URI,
+	localURI *defn.URI,
+	persistency spec_mgmt.Persistency,
+	linkType defn.LinkType,
+) (*EthernetTransport, error) {
+	// Validate local URI
+	if localURI == nil || !localURI.IsCanonical() || localURI.Scheme() != "dev" {
+		return nil, defn.ErrNotCanonical
+	}
+
+	socket, err := acquireEtherSocket(localURI.Path())
+	if err != nil {
+		return nil, err
+	}
+
+	t := &EthernetTransport{socket: socket, closed: make(chan struct{})}
+	t.remoteAddr, _ = net.ParseMAC(remoteURI.Path())
+	t.makeTransportBase(
+		remoteURI, localURI, persistency,
+		defn.NonLocal, linkType,
+		min(socket.iface.MTU, defn.MaxNDNPacketSize))
+
+	if !socket.addTransport(t) {
+		socket.release()
+		return nil, fmt.Errorf("face to %s already exists on %s", remoteURI, localURI)
+	}
+	t.running.Store(true)
+
+	return t, nil
+}
+
+// String returns a string identifying the transport.
+func (t *EthernetTransport) String() string {
+	if t.linkType == defn.MultiAccess {
+		return fmt.Sprintf("multicast-ether-transport (faceid=%d remote=%s local=%s)", t.faceID, t.remoteURI, t.localURI)
+	}
+	return fmt.Sprintf("unicast-ether-transport (faceid=%d remote=%s local=%s)", t.faceID, t.remoteURI, t.localURI)
+}
+
+// SetPersistency changes the persistency of the face.
+// Multicast faces can only be permanent.
+func (t *EthernetTransport) SetPersistency(persistency spec_mgmt.Persistency) bool {
+	if t.linkType == defn.MultiAccess && persistency != spec_mgmt.PersistencyPermanent {
+		return false
+	}
+
+	t.persistency = persistency
+	return true
+}
+
+// GetSendQueueSize returns 0, since packet sockets do not expose their send queue.
+func (t *EthernetTransport) GetSendQueueSize() uint64 {
+	return 0
+}
+
+// sendFrame sends a frame to the remote address of the transport.
+func (t *EthernetTransport) sendFrame(frame []byte) {
+	if !t.running.Load() {
+		return
+	}
+
+	if len(frame) > t.MTU() {
+		core.Log.Error(t, "Attempted to send frame larger than MTU",
+			"size", len(frame), "MTU", t.MTU())
+		return
+	}
+
+	// Errors such as full device queues are transient, so keep the face up
+	if err := t.socket.conn.WriteTo(frame, t.remoteAddr); err != nil {
+		core.Log.Warn(t, "Unable to send on socket", "err", err)
+		return
+	}
+
+	t.nOutBytes += uint64(len(frame))
+	if t.expirationTime != nil {
+		*t.expirationTime = time.Now().Add(CfgEtherLifetime())
+	}
+}
+
+// runReceive waits until the transport is closed.
+// Frames are received by the socket of the interface.
+func (t *EthernetTransport) runReceive() {
+	t.ready.Store(true)
+	<-t.closed
+}
+
+// receiveFrame passes a frame received from the remote address to the link service.
+func (t *EthernetTransport) receiveFrame(frame []byte, size int) {
+	// Frames received before the face is started are dropped
+	if !t.ready.Load() || !t.running.Load() {
+		return
+	}
+
+	t.nInBytes += uint64(size)
+	if t.expirationTime != nil {
+		*t.expirationTime = time.Now().Add(CfgEtherLifetime())
+	}
+	t.linkService.handleIncomingFrame(frame)
+}
+
+// Close detaches the transport from the packet socket.
+func (t *EthernetTransport) Close() {
+	if t.setRunning(false) {
+		t.socket.removeTransport(t)
+		t.socket.release()
+		close(t.closed)
+	}
+}
+
+// trimEtherPadding removes padding added to reach the minimum Ethernet frame size.
+// Returns nil if the frame does not contain a complete TLV element.
+func trimEtherPadding(frame []byte) []byte {
+	r := enc.NewBufferView(frame)
+	if _, err := r.ReadTLNum(); err != nil {
+		return nil
+	}
+	length, err := r.ReadTLNum()
+	if err != nil {
+		return nil
+	}
+
+	size := r.Pos() + int(length)
+	if size > len(frame) {
+		return nil
+	}
+	return frame[:size]
+}
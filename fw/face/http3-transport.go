@@ -84,6 +84,6 @@ func (t *HTTP3Transport) runReceive() {
 
 // (AI GENERATED DESCRIPTION): Closes the HTTP/3 transport by marking it stopped and shutting down the underlying connection without error.
 func (t *HTTP3Transport) Close() {
-	t.running.Store(false)
+	t.setRunning(false)
 	t.c.CloseWithError(0, "")
 }
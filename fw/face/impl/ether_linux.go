@@ -0,0 +1,133 @@
+//go:build linux
+
+/* YaNFD - Yet another NDN Forwarding Daemon
+ *
+ * Copyright (C) 2020-2021 Eric Newberry.
+ *
+ * This file is licensed under the terms of the MIT License, as found in LICENSE.md.
+ */
+
+package impl
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// EtherConn is a raw AF_PACKET socket bound to a network interface and EtherType.
+// The kernel adds and removes the Ethernet header of frames.
+type EtherConn struct {
+	file    *os.File
+	rawConn syscall.RawConn
+	ifindex int
+	proto   uint16
+}
+
+// ListenEther opens a packet socket receiving frames of the EtherType on the interface.
+func ListenEther(iface *net.Interface, etherType uint16) (*EtherConn, error) {
+	proto := htons(etherType)
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC|unix.SOCK_NONBLOCK, int(proto))
+	if err != nil {
+		return nil, fmt.Errorf("unable to open packet socket: %w", err)
+	}
+
+	err = unix.Bind(fd, &unix.SockaddrLinklayer{Protocol: proto, Ifindex: iface.Index})
+	if err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("unable to bind packet socket to %s: %w", iface.Name, err)
+	}
+
+	// Use the runtime poller so that Close unblocks pending reads
+	c := &EtherConn{
+		file:    os.NewFile(uintptr(fd), "packet:"+iface.Name),
+		ifindex: iface.Index,
+		proto:   proto,
+	}
+	if c.rawConn, err = c.file.SyscallConn(); err != nil {
+		c.file.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// JoinGroup subscribes the socket to a multicast group address.
+func (c *EtherConn) JoinGroup(group net.HardwareAddr) error {
+	mreq := &unix.PacketMreq{
+		Ifindex: int32(c.ifindex),
+		Type:    unix.PACKET_MR_MULTICAST,
+		Alen:    uint16(len(group)),
+	}
+	copy(mreq.Address[:], group)
+
+	var err error
+	cerr := c.rawConn.Control(func(fd uintptr) {
+		err = unix.SetsockoptPacketMreq(int(fd), unix.SOL_PACKET, unix.PACKET_ADD_MEMBERSHIP, mreq)
+	})
+	if cerr != nil {
+		return cerr
+	}
+	return err
+}
+
+// ReadFrom reads a frame into b and returns its size and source address,
+// and whether it was sent to a multicast or broadcast address.
+// Frames sent by this host are skipped.
+func (c *EtherConn) ReadFrom(b []byte) (n int, src net.HardwareAddr, multicast bool, err error) {
+	for {
+		var from unix.Sockaddr
+		var rerr error
+		err = c.rawConn.Read(func(fd uintptr) bool {
+			n, from, rerr = unix.Recvfrom(int(fd), b, 0)
+			return rerr != unix.EAGAIN
+		})
+		if err == nil {
+			err = rerr
+		}
+		if err != nil {
+			return 0, nil, false, err
+		}
+
+		sa, ok := from.(*unix.SockaddrLinklayer)
+		if !ok || sa.Pkttype == unix.PACKET_OUTGOING || sa.Pkttype == unix.PACKET_OTHERHOST {
+			continue
+		}
+
+		src = net.HardwareAddr(append([]byte{}, sa.Addr[:sa.Halen]...))
+		multicast = sa.Pkttype == unix.PACKET_MULTICAST || sa.Pkttype == unix.PACKET_BROADCAST
+		return n, src, multicast, nil
+	}
+}
+
+// WriteTo sends a frame to the destination address.
+func (c *EtherConn) WriteTo(b []byte, dst net.HardwareAddr) error {
+	sa := &unix.SockaddrLinklayer{
+		Protocol: c.proto,
+		Ifindex:  c.ifindex,
+		Halen:    uint8(len(dst)),
+	}
+	copy(sa.Addr[:], dst)
+
+	var werr error
+	err := c.rawConn.Write(func(fd uintptr) bool {
+		werr = unix.Sendto(int(fd), b, 0, sa)
+		return werr != unix.EAGAIN
+	})
+	if err != nil {
+		return err
+	}
+	return werr
+}
+
+// Close closes the socket.
+func (c *EtherConn) Close() error {
+	return c.file.Close()
+}
+
+// htons converts a 16-bit value to network byte order.
+func htons(v uint16) uint16 {
+	return v<<8 | v>>8
+}
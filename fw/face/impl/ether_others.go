@@ -0,0 +1,46 @@
+//go:build !linux
+
+/* YaNFD - Yet another NDN Forwarding Daemon
+ *
+ * Copyright (C) 2020-2021 Eric Newberry.
+ *
+ * This file is licensed under the terms of the MIT License, as found in LICENSE.md.
+ */
+
+package impl
+
+import (
+	"errors"
+	"net"
+)
+
+// ErrEtherNotSupported is returned when raw Ethernet sockets are not available on the platform.
+var ErrEtherNotSupported = errors.New("Ethernet faces are only supported on Linux")
+
+// EtherConn is a raw Ethernet socket (not supported on this platform).
+type EtherConn struct{}
+
+// ListenEther is not supported on this platform.
+func ListenEther(iface *net.Interface, etherType uint16) (*EtherConn, error) {
+	return nil, ErrEtherNotSupported
+}
+
+// JoinGroup is not supported on this platform.
+func (c *EtherConn) JoinGroup(group net.HardwareAddr) error {
+	return ErrEtherNotSupported
+}
+
+// ReadFrom is not supported on this platform.
+func (c *EtherConn) ReadFrom(b []byte) (n int, src net.HardwareAddr, multicast bool, err error) {
+	return 0, nil, false, ErrEtherNotSupported
+}
+
+// WriteTo is not supported on this platform.
+func (c *EtherConn) WriteTo(b []byte, dst net.HardwareAddr) error {
+	return ErrEtherNotSupported
+}
+
+// Close is not supported on this platform.
+func (c *EtherConn) Close() error {
+	return ErrEtherNotSupported
+}
@@ -21,6 +21,7 @@ import (
 type InternalTransport struct {
 	recvQueue chan []byte // Contains pending packets sent to internal component
 	sendQueue chan []byte // Contains pending packets sent by the internal component
+	closed    chan struct{}
 	transportBase
 }
 
@@ -36,18 +37,20 @@ func MakeInternalTransport() *InternalTransport {
 		defn.MaxNDNPacketSize)
 	t.recvQueue = make(chan []byte, CfgFaceQueueSize())
 	t.sendQueue = make(chan []byte, CfgFaceQueueSize())
+	t.closed = make(chan struct{})
 	t.running.Store(true)
 	return t
 }
 
-// RegisterInternalTransport creates, registers, and starts an InternalTransport.
-func RegisterInternalTransport() (LinkService, *InternalTransport) {
+// RegisterInternalTransport creates, registers in the face table, and starts an InternalTransport.
+func RegisterInternalTransport(faces *Table) (LinkService, *InternalTransport) {
 	transport := MakeInternalTransport()
 
 	options := MakeNDNLPLinkServiceOptions()
 	options.IsIncomingFaceIndicationEnabled = true
 	options.IsConsumerControlledForwardingEnabled = true
 	link := MakeNDNLPLinkService(transport, options)
+	link.SetFaceTable(faces)
 	link.Run(nil)
 
 	return link, transport
@@ -131,7 +134,14 @@ func (t *InternalTransport) sendFrame(frame []byte) {
 
 // (AI GENERATED DESCRIPTION): Processes frames queued for sending, verifies they are within the maximum packet size, updates the inbound byte counter, and forwards each frame to the link service for handling.
 func (t *InternalTransport) runReceive() {
-	for frame := range t.sendQueue {
+	for {
+		var frame []byte
+		select {
+		case frame = <-t.sendQueue:
+		case <-t.closed:
+			return
+		}
+
 		if len(frame) > defn.MaxNDNPacketSize {
 			core.Log.Warn(t, "Caller trying to send too much data")
 			continue
@@ -144,8 +154,9 @@ func (t *InternalTransport) runReceive() {
 
 // (AI GENERATED DESCRIPTION): Closes the transport by atomically marking it inactive and closing its receive‑queue channel, leaving the send queue to be garbage‑collected.
 func (t *InternalTransport) Close() {
-	if t.running.Swap(false) {
+	if t.setRunning(false) {
 		// do not close the send queue, let it be garbage collected
 		close(t.recvQueue)
+		close(t.closed)
 	}
 }
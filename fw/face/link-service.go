@@ -10,6 +10,7 @@ package face
 import (
 	"encoding/binary"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/named-data/ndnd/fw/core"
@@ -24,6 +25,7 @@ type LinkService interface {
 	String() string
 	Transport() transport
 	SetFaceID(faceID uint64)
+	faceTable() *Table
 
 	FaceID() uint64
 	LocalURI() *defn.URI
@@ -53,9 +55,11 @@ type LinkService interface {
 	// Counters
 	NInInterests() uint64
 	NInData() uint64
+	NInNacks() uint64
 	NInBytes() uint64
 	NOutInterests() uint64
 	NOutData() uint64
+	NOutNacks() uint64
 	NOutBytes() uint64
 }
 
@@ -63,14 +67,21 @@ type LinkService interface {
 type linkServiceBase struct {
 	faceID    uint64
 	transport transport
+	faces     *Table
 	stopped   chan bool
 	sendQueue chan dispatch.OutPkt
+	shaper    *shaper
+	// Whether a packet was dropped because the send queue was full,
+	// since the last packet marked with congestion
+	overflowed atomic.Bool
 
 	// Counters
 	nInInterests  uint64
 	nInData       uint64
+	nInNacks      uint64
 	nOutInterests uint64
 	nOutData      uint64
+	nOutNacks     uint64
 }
 
 // (AI GENERATED DESCRIPTION): Returns a human‑readable string that describes the link service, displaying its transport type if present, otherwise its face ID.
@@ -98,6 +109,13 @@ func (l *linkServiceBase) SetFaceID(faceID uint64) {
 func (l *linkServiceBase) makeLinkServiceBase() {
 	l.stopped = make(chan bool)
 	l.sendQueue = make(chan dispatch.OutPkt, CfgFaceQueueSize())
+	l.faces = FaceTable
+}
+
+// SetFaceTable sets the face table to which the face is added when it runs,
+// instead of the global face table. It must be called before Run.
+func (l *linkServiceBase) SetFaceTable(faces *Table) {
+	l.faces = faces
 }
 
 //
@@ -109,6 +127,11 @@ func (l *linkServiceBase) Transport() transport {
 	return l.transport
 }
 
+// faceTable returns the face table of the face
+func (l *linkServiceBase) faceTable() *Table {
+	return l.faces
+}
+
 // FaceID returns the ID of the face
 func (l *linkServiceBase) FaceID() uint64 {
 	return l.faceID
@@ -181,6 +204,11 @@ func (l *linkServiceBase) NInData() uint64 {
 	return l.nInData
 }
 
+// NInNacks returns the number of Nacks received on this face.
+func (l *linkServiceBase) NInNacks() uint64 {
+	return l.nInNacks
+}
+
 // NInBytes returns the number of link-layer bytes received on this face.
 func (l *linkServiceBase) NInBytes() uint64 {
 	return l.transport.NInBytes()
@@ -196,6 +224,11 @@ func (l *linkServiceBase) NOutData() uint64 {
 	return l.nOutData
 }
 
+// NOutNacks returns the number of Nacks sent on this face.
+func (l *linkServiceBase) NOutNacks() uint64 {
+	return l.nOutNacks
+}
+
 // NOutBytes returns the number of link-layer bytes sent on this face.
 func (l *linkServiceBase) NOutBytes() uint64 {
 	return l.transport.NOutBytes()
@@ -212,15 +245,23 @@ func (l *linkServiceBase) Close() {
 
 // SendPacket adds a packet to the send queue for this link service
 func (l *linkServiceBase) SendPacket(out dispatch.OutPkt) {
+	if l.shaper != nil {
+		if !l.shaper.enqueue(out) {
+			core.Log.Debug(l, "Dropped packet due to congestion")
+			l.overflowed.Store(true)
+		}
+		return
+	}
+
 	select {
 	case l.sendQueue <- out:
 		// Packet queued successfully
 		core.Log.Trace(l, "Queued packet for link service")
 	default:
-		// Drop packet due to congestion
+		// Drop packet due to congestion, which is signaled by
+		// marking the next packets sent on the face
 		core.Log.Debug(l, "Dropped packet due to congestion")
-
-		// TODO: Signal congestion
+		l.overflowed.Store(true)
 	}
 }
 
@@ -234,9 +275,10 @@ func (l *linkServiceBase) dispatchInterest(pkt *defn.Pkt) {
 	pkt.Name = pkt.L3.Interest.NameV
 
 	// Hash name to thread
-	thread := fw.HashNameToFwThread(pkt.Name)
+	fwd := l.faces.Forwarder()
+	thread := fw.HashNameToFwThread(pkt.Name, fwd.NumFWThreads())
 	core.Log.Trace(l, "Dispatched Interest", "thread", thread)
-	dispatch.GetFWThread(thread).QueueInterest(pkt)
+	fwd.GetFWThread(thread).QueueInterest(pkt)
 }
 
 // (AI GENERATED DESCRIPTION): Routes an incoming Data packet to the appropriate forwarding thread(s) by examining its PIT token or name prefix, handling local producer packets that lack tokens, and logging the dispatch.
@@ -247,11 +289,12 @@ func (l *linkServiceBase) dispatchData(pkt *defn.Pkt) {
 
 	// Store name for easy access
 	pkt.Name = pkt.L3.Data.NameV
+	fwd := l.faces.Forwarder()
 
 	// Decode PitToken. If it's for us, it's a uint16 + uint32.
 	if len(pkt.PitToken) == 6 {
 		thread := binary.BigEndian.Uint16(pkt.PitToken)
-		fwThread := dispatch.GetFWThread(int(thread))
+		fwThread := fwd.GetFWThread(int(thread))
 		if fwThread == nil {
 			core.Log.Error(l, "Invalid PIT token attached to Data packet")
 			return
@@ -266,17 +309,43 @@ func (l *linkServiceBase) dispatchData(pkt *defn.Pkt) {
 	// threads matching every prefix. We need to do this because producers do
 	// not attach PIT tokens to their data packets.
 	if l.Scope() == defn.Local {
-		for i, match := range fw.HashNameToAllPrefixFwThreads(pkt.Name) {
+		for i, match := range fw.HashNameToAllPrefixFwThreads(pkt.Name, fwd.NumFWThreads()) {
 			if match {
 				core.Log.Trace(l, "Prefix dispatched local-origin Data", "thread", i)
-				dispatch.GetFWThread(i).QueueData(pkt)
+				fwd.GetFWThread(i).QueueData(pkt)
 			}
 		}
 		return
 	}
 
 	// Only exact-match for now (no CanBePrefix)
-	thread := fw.HashNameToFwThread(pkt.Name)
+	thread := fw.HashNameToFwThread(pkt.Name, fwd.NumFWThreads())
 	core.Log.Trace(l, "Dispatched Data", "thread", thread)
-	dispatch.GetFWThread(thread).QueueData(pkt)
+	fwd.GetFWThread(thread).QueueData(pkt)
+}
+
+// dispatchNack routes an incoming Nack to the forwarding thread of the Interest
+// it carries, using the PIT token if present or the Interest name otherwise.
+func (l *linkServiceBase) dispatchNack(pkt *defn.Pkt) {
+	if pkt.L3.Interest == nil || !pkt.NackReason.IsSet() {
+		panic("dispatchNack called with packet that is not Nack")
+	}
+
+	// Store name for easy access
+	pkt.Name = pkt.L3.Interest.NameV
+
+	fwd := l.faces.Forwarder()
+	thread := fw.HashNameToFwThread(pkt.Name, fwd.NumFWThreads())
+	if len(pkt.PitToken) == 6 {
+		thread = int(binary.BigEndian.Uint16(pkt.PitToken))
+	}
+
+	fwThread := fwd.GetFWThread(thread)
+	if fwThread == nil {
+		core.Log.Error(l, "Invalid PIT token attached to Nack packet")
+		return
+	}
+
+	core.Log.Trace(l, "Dispatched Nack", "thread", thread)
+	fwThread.QueueNack(pkt)
 }
@@ -0,0 +1,246 @@
+/* YaNFD - Yet another NDN Forwarding Daemon
+ *
+ * Copyright (C) 2020-2022 Eric Newberry.
+ *
+ * This file is licensed under the terms of the MIT License, as found in LICENSE.md.
+ */
+
+package face
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	defn "github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/table"
+	"github.com/named-data/ndnd/std/types/optional"
+)
+
+const txSequenceOverhead = 3 + 1 + 8
+const ackOverhead = 3 + 1 + 8
+
+// lpRecvWindowSize is the number of recently received TxSequences kept to detect
+// duplicate frames, which are received when an Ack is lost.
+const lpRecvWindowSize = 1024
+
+// lpReliability implements the NDNLPv2 link-layer reliability protocol (BEFS).
+// Every outgoing frame carries a TxSequence, which the receiver acknowledges
+// with an Ack field, either piggybacked on outgoing frames or in IDLE frames.
+// Unacknowledged frames are retransmitted up to a maximum number of times,
+// and retransmitted frames that were already received are dropped.
+type lpReliability struct {
+	link   *NDNLPLinkService
+	mutex  sync.Mutex
+	ticker *time.Ticker
+
+	// Sender state, key is the TxSequence of the latest transmission
+	unacked map[uint64]*lpUnackedFrag
+	rtt     table.RttEstimator
+
+	// Receiver state
+	pendingAcks     []uint64
+	firstPendingAck time.Time
+	recvSeqs        map[uint64]struct{}
+	recvOrder       []uint64 // ring of the TxSequences in recvSeqs
+	recvIndex       int
+
+	// Counters
+	nAcknowledged  atomic.Uint64
+	nRetransmitted atomic.Uint64
+	nRetxExhausted atomic.Uint64
+}
+
+// lpUnackedFrag is a frame that was sent but not acknowledged yet.
+type lpUnackedFrag struct {
+	frag     *defn.FwLpPacket
+	netPkt   *lpUnackedNetPkt
+	txSeq    uint64
+	sendTime time.Time
+	deadline time.Time
+	nRetx    int
+	nGreater int // number of Acks received for later TxSequences
+}
+
+// lpUnackedNetPkt groups the fragments of a network-layer packet, which
+// is dropped entirely when any of its fragments exceeds the retransmission limit.
+type lpUnackedNetPkt struct {
+	frags []*lpUnackedFrag
+}
+
+// newLpReliability creates the reliability state of a link service.
+// The ticker is stopped until reliability is enabled.
+func newLpReliability(link *NDNLPLinkService) *lpReliability {
+	r := &lpReliability{
+		link:      link,
+		ticker:    time.NewTicker(time.Hour),
+		unacked:   make(map[uint64]*lpUnackedFrag),
+		rtt:       table.NewRttEstimator(),
+		recvSeqs:  make(map[uint64]struct{}, lpRecvWindowSize),
+		recvOrder: make([]uint64, 0, lpRecvWindowSize),
+	}
+	r.ticker.Stop()
+	return r
+}
+
+// setEnabled starts or stops the reliability timers.
+func (r *lpReliability) setEnabled(enabled bool, idleAckPeriod time.Duration) {
+	if enabled {
+		r.ticker.Reset(idleAckPeriod)
+		return
+	}
+
+	r.ticker.Stop()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	clear(r.unacked)
+	r.pendingAcks = r.pendingAcks[:0]
+	clear(r.recvSeqs)
+	r.recvOrder = r.recvOrder[:0]
+	r.recvIndex = 0
+}
+
+// onSend assigns a TxSequence to an outgoing fragment, piggybacks pending Acks
+// within the room left by the MTU, and records the fragment for retransmission.
+// Must be called on the send goroutine.
+func (r *lpReliability) onSend(frag *defn.FwLpPacket, netPkt *lpUnackedNetPkt, room int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	unacked := &lpUnackedFrag{frag: frag, netPkt: netPkt}
+	netPkt.frags = append(netPkt.frags, unacked)
+	r.transmit(unacked, time.Now())
+	r.attachAcks(frag, room)
+}
+
+// transmit assigns a new TxSequence to the fragment and restarts its retransmission timer.
+func (r *lpReliability) transmit(unacked *lpUnackedFrag, now time.Time) {
+	r.link.nextTxSequence++
+	unacked.txSeq = r.link.nextTxSequence
+	unacked.sendTime = now
+	unacked.deadline = now.Add(r.rtt.Rto())
+	unacked.nGreater = 0
+	unacked.frag.TxSequence = optional.Some(unacked.txSeq)
+	r.unacked[unacked.txSeq] = unacked
+}
+
+// attachAcks moves as many pending Acks to the frame as fit in room bytes.
+func (r *lpReliability) attachAcks(frag *defn.FwLpPacket, room int) {
+	n := min(len(r.pendingAcks), max(room, 0)/ackOverhead)
+	frag.Acks = append(frag.Acks[:0], r.pendingAcks[:n]...)
+	r.pendingAcks = append(r.pendingAcks[:0], r.pendingAcks[n:]...)
+	if len(r.pendingAcks) == 0 {
+		r.firstPendingAck = time.Time{}
+	}
+}
+
+// onReceive processes the reliability fields of an incoming frame, and returns
+// false if the frame is a duplicate to drop. Duplicates are acknowledged again,
+// since the Ack of the first copy was probably lost.
+// Must be called on the receive goroutine.
+func (r *lpReliability) onReceive(frame *defn.FwLpPacket) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	for _, ack := range frame.Acks {
+		unacked := r.unacked[ack]
+		if unacked == nil {
+			continue // duplicate or unknown
+		}
+
+		// Karn's algorithm: only sample RTT from frames that were not retransmitted
+		if unacked.nRetx == 0 {
+			r.rtt.AddMeasurement(now.Sub(unacked.sendTime))
+		}
+		r.nAcknowledged.Add(1)
+		delete(r.unacked, ack)
+
+		// Frames sent before the acknowledged frame are considered lost
+		// once enough later frames are acknowledged
+		for seq, other := range r.unacked {
+			if seq < ack {
+				other.nGreater++
+				if other.nGreater >= r.link.options.ReliabilitySeqNumLossThreshold {
+					other.deadline = now
+				}
+			}
+		}
+	}
+
+	txSeq, ok := frame.TxSequence.Get()
+	if !ok {
+		return true
+	}
+
+	if len(r.pendingAcks) == 0 {
+		r.firstPendingAck = now
+	}
+	r.pendingAcks = append(r.pendingAcks, txSeq)
+	return r.recordReceived(txSeq)
+}
+
+// recordReceived adds a TxSequence to the window of received frames, and
+// returns false if it is already there.
+func (r *lpReliability) recordReceived(txSeq uint64) bool {
+	if _, ok := r.recvSeqs[txSeq]; ok {
+		return false
+	}
+
+	// Forget the oldest TxSequence once the window is full
+	if len(r.recvOrder) < lpRecvWindowSize {
+		r.recvOrder = append(r.recvOrder, txSeq)
+	} else {
+		delete(r.recvSeqs, r.recvOrder[r.recvIndex])
+		r.recvOrder[r.recvIndex] = txSeq
+		r.recvIndex = (r.recvIndex + 1) % lpRecvWindowSize
+	}
+	r.recvSeqs[txSeq] = struct{}{}
+	return true
+}
+
+// onTick retransmits lost fragments and sends pending Acks that could not be piggybacked.
+// Must be called on the send goroutine.
+func (r *lpReliability) onTick() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	for _, unacked := range r.unacked {
+		if unacked.deadline.After(now) {
+			continue
+		}
+
+		delete(r.unacked, unacked.txSeq)
+		if unacked.nRetx >= r.link.options.ReliabilityMaxRetx {
+			// Give up on the whole network-layer packet
+			r.nRetxExhausted.Add(1)
+			for _, frag := range unacked.netPkt.frags {
+				delete(r.unacked, frag.txSeq)
+			}
+			core.Log.Debug(r.link, "Retransmission limit exceeded - DROP", "txseq", unacked.txSeq)
+			continue
+		}
+
+		unacked.nRetx++
+		r.nRetransmitted.Add(1)
+		r.rtt.Backoff()
+		r.transmit(unacked, now)
+		r.attachAcks(unacked.frag, r.link.transport.MTU()-r.link.headerOverhead-int(unacked.frag.Fragment.Length()))
+		r.link.sendLpFrame(unacked.frag)
+	}
+
+	// Send IDLE frames with Acks that could not be piggybacked in time
+	idleAckPeriod := r.link.options.ReliabilityIdleAckPeriod
+	if len(r.pendingAcks) > 0 && now.Sub(r.firstPendingAck) >= idleAckPeriod {
+		for len(r.pendingAcks) > 0 {
+			idle := &defn.FwLpPacket{}
+			r.attachAcks(idle, r.link.transport.MTU()-lpPacketOverhead)
+			if len(idle.Acks) == 0 {
+				break // MTU too small
+			}
+			r.link.sendLpFrame(idle)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package face
+
+import (
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/dispatch"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lpRecorder is a forwarding thread recording the names of the packets dispatched to it.
+type lpRecorder struct {
+	names []string
+}
+
+func (r *lpRecorder) String() string                  { return "lp-recorder" }
+func (r *lpRecorder) QueueData(pkt *defn.Pkt)         { r.names = append(r.names, pkt.Name.String()) }
+func (r *lpRecorder) QueueInterest(pkt *defn.Pkt)     { r.names = append(r.names, pkt.Name.String()) }
+func (r *lpRecorder) QueueNack(pkt *defn.Pkt)         { r.names = append(r.names, pkt.Name.String()) }
+func (r *lpRecorder) Counters() defn.FWThreadCounters { return defn.FWThreadCounters{} }
+
+// lpPeer is an endpoint of a reliable link. The link service does not run:
+// frames are passed between peers and timers are fired by the tests.
+type lpPeer struct {
+	link *NDNLPLinkService
+	recv *lpRecorder
+	// Frames sent and not taken yet
+	sent [][]byte
+}
+
+// newLpPeer creates an endpoint of a reliable link with its own forwarder.
+func newLpPeer(t *testing.T, maxRetx int) *lpPeer {
+	p := &lpPeer{recv: &lpRecorder{}}
+	fwd := dispatch.NewForwarder(table.FibStrategyTable, table.Rib)
+	fwd.InitializeFWThreads([]dispatch.FWThread{p.recv})
+
+	transport := MakeMemoryTransport(
+		defn.MakeUDPFaceURI(4, "10.0.0.2", 6363),
+		defn.MakeUDPFaceURI(4, "10.0.0.1", 6363),
+		defn.NonLocal,
+		func(frame []byte) { p.sent = append(p.sent, frame) })
+	t.Cleanup(transport.Close)
+
+	options := MakeNDNLPLinkServiceOptions()
+	options.IsReliabilityEnabled = true
+	options.ReliabilityMaxRetx = maxRetx
+	options.ReliabilityIdleAckPeriod = time.Nanosecond
+	p.link = MakeNDNLPLinkService(transport, options)
+	p.link.SetFaceTable(NewTable(fwd))
+	p.link.reliability.ticker.Stop()
+	return p
+}
+
+// sendInterest sends an Interest on the link.
+func (p *lpPeer) sendInterest(t *testing.T, name string) {
+	n, _ := enc.NameFromStr(name)
+	interest, err := spec.Spec{}.MakeInterest(n, &ndn.InterestConfig{}, nil, nil)
+	require.NoError(t, err)
+	sendPacket(p.link, dispatch.OutPkt{Pkt: &defn.Pkt{
+		Name: n,
+		Raw:  interest.Wire,
+		L3:   &defn.FwPacket{Interest: &defn.FwInterest{NameV: n}},
+	}})
+}
+
+// take returns the frames sent since the last call.
+func (p *lpPeer) take() [][]byte {
+	sent := p.sent
+	p.sent = nil
+	return sent
+}
+
+// deliver passes frames to the peer, as if received on the link.
+func (p *lpPeer) deliver(frames ...[]byte) {
+	for _, frame := range frames {
+		p.link.handleIncomingFrame(frame)
+	}
+}
+
+// expire makes all unacknowledged frames time out.
+func (p *lpPeer) expire() {
+	for _, unacked := range p.link.reliability.unacked {
+		unacked.deadline = time.Now().Add(-time.Millisecond)
+	}
+}
+
+// decodeLpFrame decodes an LP frame.
+func decodeLpFrame(t *testing.T, frame []byte) *defn.FwLpPacket {
+	pkt, err := defn.ParseFwPacket(enc.NewWireView(enc.Wire{frame}), false)
+	require.NoError(t, err)
+	require.NotNil(t, pkt.LpPacket)
+	return pkt.LpPacket
+}
+
+func TestLpReliabilityRetransmitAfterLoss(t *testing.T) {
+	a, b := newLpPeer(t, 3), newLpPeer(t, 3)
+	for _, name := range []string{"/a/1", "/a/2", "/a/3", "/a/4"} {
+		a.sendInterest(t, name)
+	}
+	frames := a.take()
+	require.Len(t, frames, 4)
+	lostSeq := decodeLpFrame(t, frames[0]).TxSequence.Unwrap()
+
+	// The first frame is lost, and considered so once the later frames are acknowledged
+	b.deliver(frames[1:]...)
+	assert.Equal(t, []string{"/a/2", "/a/3", "/a/4"}, b.recv.names)
+	b.link.reliability.onTick()
+	a.deliver(b.take()...)
+	assert.EqualValues(t, 3, a.link.NAcknowledged())
+
+	// It is retransmitted with a new TxSequence
+	a.link.reliability.onTick()
+	frames = a.take()
+	require.Len(t, frames, 1)
+	assert.NotEqual(t, lostSeq, decodeLpFrame(t, frames[0]).TxSequence.Unwrap())
+	assert.EqualValues(t, 1, a.link.NRetransmitted())
+
+	b.deliver(frames...)
+	assert.Equal(t, []string{"/a/2", "/a/3", "/a/4", "/a/1"}, b.recv.names)
+	b.link.reliability.onTick()
+	a.deliver(b.take()...)
+	assert.EqualValues(t, 4, a.link.NAcknowledged())
+	assert.Empty(t, a.link.reliability.unacked)
+}
+
+func TestLpReliabilityMaxRetx(t *testing.T) {
+	a := newLpPeer(t, 1)
+	a.sendInterest(t, "/a/1")
+	assert.Len(t, a.take(), 1)
+
+	// The frame is retransmitted once, and then dropped
+	a.expire()
+	a.link.reliability.onTick()
+	assert.Len(t, a.take(), 1)
+	assert.EqualValues(t, 1, a.link.NRetransmitted())
+
+	a.expire()
+	a.link.reliability.onTick()
+	assert.Empty(t, a.take())
+	assert.EqualValues(t, 1, a.link.NRetransmitted())
+	assert.EqualValues(t, 1, a.link.NRetxExhausted())
+	assert.Empty(t, a.link.reliability.unacked)
+}
+
+func TestLpReliabilityPiggybackedAcks(t *testing.T) {
+	a, b := newLpPeer(t, 3), newLpPeer(t, 3)
+	a.sendInterest(t, "/a/1")
+	frames := a.take()
+	b.deliver(frames...)
+
+	// The Ack is carried by the next frame in the other direction
+	b.sendInterest(t, "/b/1")
+	frames2 := b.take()
+	require.Len(t, frames2, 1)
+	frame := decodeLpFrame(t, frames2[0])
+	assert.Equal(t, []uint64{decodeLpFrame(t, frames[0]).TxSequence.Unwrap()}, frame.Acks)
+	assert.NotEmpty(t, frame.Fragment)
+
+	a.deliver(frames2...)
+	assert.Equal(t, []string{"/b/1"}, a.recv.names)
+	assert.EqualValues(t, 1, a.link.NAcknowledged())
+	assert.Empty(t, a.link.reliability.unacked)
+
+	// No IDLE frame is needed
+	b.link.reliability.onTick()
+	assert.Empty(t, b.take())
+}
+
+func TestLpReliabilityIdleAcks(t *testing.T) {
+	a, b := newLpPeer(t, 3), newLpPeer(t, 3)
+	a.sendInterest(t, "/a/1")
+	a.sendInterest(t, "/a/2")
+	frames := a.take()
+	b.deliver(frames...)
+
+	// Without traffic in the other direction, the Acks are sent in an IDLE frame
+	b.link.reliability.onTick()
+	idle := b.take()
+	require.Len(t, idle, 1)
+	frame := decodeLpFrame(t, idle[0])
+	assert.Empty(t, frame.Fragment)
+	assert.Equal(t, []uint64{
+		decodeLpFrame(t, frames[0]).TxSequence.Unwrap(),
+		decodeLpFrame(t, frames[1]).TxSequence.Unwrap(),
+	}, frame.Acks)
+
+	a.deliver(idle...)
+	assert.Empty(t, a.recv.names)
+	assert.EqualValues(t, 2, a.link.NAcknowledged())
+	assert.Empty(t, a.link.reliability.unacked)
+}
+
+func TestLpReliabilityDuplicates(t *testing.T) {
+	a, b := newLpPeer(t, 3), newLpPeer(t, 3)
+	a.sendInterest(t, "/a/1")
+	frames := a.take()
+	txSeq := decodeLpFrame(t, frames[0]).TxSequence.Unwrap()
+
+	// A frame received twice is dispatched once, but acknowledged each time
+	b.deliver(frames[0], frames[0])
+	assert.Equal(t, []string{"/a/1"}, b.recv.names)
+	b.link.reliability.onTick()
+	idle := b.take()
+	require.Len(t, idle, 1)
+	assert.Equal(t, []uint64{txSeq, txSeq}, decodeLpFrame(t, idle[0]).Acks)
+
+	// The window of received TxSequences is bounded
+	r := b.link.reliability
+	for seq := range uint64(lpRecvWindowSize) {
+		assert.True(t, r.recordReceived(txSeq+1+seq))
+	}
+	assert.Len(t, r.recvSeqs, lpRecvWindowSize)
+	assert.False(t, r.recordReceived(txSeq+lpRecvWindowSize))
+	assert.True(t, r.recordReceived(txSeq))
+}
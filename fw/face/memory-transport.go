@@ -0,0 +1,120 @@
+package face
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/named-data/ndnd/fw/core"
+	defn "github.com/named-data/ndnd/fw/defn"
+	spec_mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+)
+
+// MemoryTransport is a transport exchanging frames in memory, to run several
+// forwarders in the same process, e.g. in a network emulation. Sent frames are
+// passed to a callback, and received frames are passed with Receive.
+type MemoryTransport struct {
+	transportBase
+	send      func(frame []byte)
+	recvQueue chan []byte
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// MakeMemoryTransport makes a MemoryTransport, which passes the frames it sends to the callback.
+// The callback must not block.
+func MakeMemoryTransport(remoteURI *defn.URI, localURI *defn.URI, scope defn.Scope, send func(frame []byte)) *MemoryTransport {
+	t := &MemoryTransport{
+		send:      send,
+		recvQueue: make(chan []byte, CfgFaceQueueSize()),
+		closed:    make(chan struct{}),
+	}
+	t.makeTransportBase(remoteURI, localURI, spec_mgmt.PersistencyPermanent, scope, defn.PointToPoint, defn.MaxNDNPacketSize)
+	t.running.Store(true)
+	return t
+}
+
+// String returns the log identifier of the transport.
+func (t *MemoryTransport) String() string {
+	return fmt.Sprintf("memory-transport (faceid=%d remote=%s local=%s)", t.faceID, t.remoteURI, t.localURI)
+}
+
+// SetPersistency changes the persistency of the face.
+func (t *MemoryTransport) SetPersistency(persistency spec_mgmt.Persistency) bool {
+	if persistency == t.persistency {
+		return true
+	}
+
+	if persistency == spec_mgmt.PersistencyPersistent || persistency == spec_mgmt.PersistencyPermanent {
+		t.persistency = persistency
+		return true
+	}
+
+	return false
+}
+
+// GetSendQueueSize returns the current size of the send queue.
+func (t *MemoryTransport) GetSendQueueSize() uint64 {
+	return 0
+}
+
+// SetUp brings the transport up or down, like a link whose carrier comes and goes.
+// Frames are dropped while the transport is down, but the face is kept.
+func (t *MemoryTransport) SetUp(up bool) {
+	select {
+	case <-t.closed:
+		return
+	default:
+		t.setRunning(up)
+	}
+}
+
+// Receive passes a frame received from the other end of the transport to the face.
+func (t *MemoryTransport) Receive(frame []byte) {
+	if !t.running.Load() {
+		return
+	}
+
+	select {
+	case t.recvQueue <- frame:
+	case <-t.closed:
+	}
+}
+
+// sendFrame passes a frame to the other end of the transport.
+func (t *MemoryTransport) sendFrame(frame []byte) {
+	if len(frame) > t.MTU() {
+		core.Log.Warn(t, "Attempted to send frame larger than MTU")
+		return
+	}
+
+	if !t.running.Load() {
+		return
+	}
+
+	t.nOutBytes += uint64(len(frame))
+
+	frameCopy := make([]byte, len(frame))
+	copy(frameCopy, frame)
+	t.send(frameCopy)
+}
+
+// runReceive passes the received frames to the link service until the transport is closed.
+func (t *MemoryTransport) runReceive() {
+	for {
+		select {
+		case frame := <-t.recvQueue:
+			t.nInBytes += uint64(len(frame))
+			t.linkService.handleIncomingFrame(frame)
+		case <-t.closed:
+			return
+		}
+	}
+}
+
+// Close closes the transport, which cannot be brought up again.
+func (t *MemoryTransport) Close() {
+	t.closeOnce.Do(func() {
+		t.setRunning(false)
+		close(t.closed)
+	})
+}
@@ -0,0 +1,46 @@
+package face
+
+import (
+	"testing"
+
+	"github.com/named-data/ndnd/fw/defn"
+	spec_mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryTransportUpDown(t *testing.T) {
+	var link LinkService
+	events := faceEvents(&link)
+
+	sent := 0
+	transport := MakeMemoryTransport(
+		defn.MakeUDPFaceURI(4, "10.0.0.2", 6363),
+		defn.MakeUDPFaceURI(4, "10.0.0.1", 6363),
+		defn.NonLocal,
+		func(frame []byte) { sent++ })
+	link = MakeNDNLPLinkService(transport, MakeNDNLPLinkServiceOptions())
+	link.Run(nil)
+	assert.Equal(t, spec_mgmt.FaceEventCreated, nextFaceEvent(events))
+
+	transport.sendFrame([]byte{0x05, 0x00})
+	assert.Equal(t, 1, sent)
+
+	// Frames are dropped while the transport is down, but the face is kept
+	transport.SetUp(false)
+	assert.Equal(t, spec_mgmt.FaceEventDown, nextFaceEvent(events))
+	transport.sendFrame([]byte{0x05, 0x00})
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, link, FaceTable.Get(link.FaceID()))
+
+	transport.SetUp(true)
+	assert.Equal(t, spec_mgmt.FaceEventUp, nextFaceEvent(events))
+
+	// Closed transports cannot be brought up again
+	transport.Close()
+	assert.Equal(t, spec_mgmt.FaceEventDown, nextFaceEvent(events))
+	assert.Equal(t, spec_mgmt.FaceEventDestroyed, nextFaceEvent(events))
+	assert.Nil(t, FaceTable.Get(link.FaceID()))
+	transport.SetUp(true)
+	assert.False(t, transport.IsRunning())
+	assert.Empty(t, events)
+}
@@ -189,7 +189,7 @@ func (t *MulticastUDPTransport) runReceive() {
 
 // (AI GENERATED DESCRIPTION): Gracefully shuts down the MulticastUDPTransport, closing its send and receive UDP connections if the transport was running.
 func (t *MulticastUDPTransport) Close() {
-	if t.running.Swap(false) {
+	if t.setRunning(false) {
 		if t.sendConn != nil {
 			t.sendConn.Close()
 		}
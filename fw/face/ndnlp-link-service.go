@@ -22,6 +22,9 @@ import (
 const lpPacketOverhead = 1 + 3 + 1 + 3 // LpPacket+Fragment
 const pitTokenOverhead = 1 + 1 + 6
 const congestionMarkOverhead = 3 + 1 + 8
+const nackOverhead = 3 + 1 + 3 + 1 + 8
+const nonDiscoveryOverhead = 3 + 1
+const prefixAnnOverhead = 3 + 3 // plus the announcement
 
 const (
 	FaceFlagLocalFields = 1 << iota
@@ -44,6 +47,11 @@ type NDNLPLinkServiceOptions struct {
 
 	BaseCongestionMarkingInterval   time.Duration
 	DefaultCongestionThresholdBytes uint64
+
+	IsReliabilityEnabled           bool
+	ReliabilityMaxRetx             int
+	ReliabilitySeqNumLossThreshold int
+	ReliabilityIdleAckPeriod       time.Duration
 }
 
 // (AI GENERATED DESCRIPTION): Creates and returns the default NDN link‑service options: a 100 ms congestion‑marking interval, a 64 KiB congestion threshold, and both reassembly and fragmentation enabled.
@@ -53,6 +61,9 @@ func MakeNDNLPLinkServiceOptions() NDNLPLinkServiceOptions {
 		DefaultCongestionThresholdBytes: uint64(math.Pow(2, 16)),
 		IsReassemblyEnabled:             true,
 		IsFragmentationEnabled:          true,
+		ReliabilityMaxRetx:              3,
+		ReliabilitySeqNumLossThreshold:  3,
+		ReliabilityIdleAckPeriod:        5 * time.Millisecond,
 	}
 }
 
@@ -61,6 +72,7 @@ type NDNLPLinkService struct {
 	linkServiceBase
 	options        NDNLPLinkServiceOptions
 	headerOverhead int
+	reliability    *lpReliability
 
 	// Fragment reassembly ring buffer
 	reassemblyIndex   int
@@ -85,6 +97,8 @@ func MakeNDNLPLinkService(transport transport, options NDNLPLinkServiceOptions)
 	l.transport.setLinkService(l)
 	l.options = options
 	l.computeHeaderOverhead()
+	l.reliability = newLpReliability(l)
+	l.reliability.setEnabled(options.IsReliabilityEnabled, options.ReliabilityIdleAckPeriod)
 
 	// Initialize outgoing packet state
 	l.nextSequence = 0
@@ -102,6 +116,10 @@ func (l *NDNLPLinkService) Options() NDNLPLinkServiceOptions {
 
 // SetOptions changes the settings of the NDNLPLinkService.
 func (l *NDNLPLinkService) SetOptions(options NDNLPLinkServiceOptions) {
+	if options.IsReliabilityEnabled != l.options.IsReliabilityEnabled ||
+		options.ReliabilityIdleAckPeriod != l.options.ReliabilityIdleAckPeriod {
+		l.reliability.setEnabled(options.IsReliabilityEnabled, options.ReliabilityIdleAckPeriod)
+	}
 	l.options = options
 	l.computeHeaderOverhead()
 }
@@ -119,6 +137,10 @@ func (l *NDNLPLinkService) computeHeaderOverhead() {
 	if l.options.IsIncomingFaceIndicationEnabled {
 		l.headerOverhead += 3 + 1 + 8 // IncomingFaceId
 	}
+
+	if l.options.IsReliabilityEnabled {
+		l.headerOverhead += txSequenceOverhead
+	}
 }
 
 // Run starts the face and associated goroutines
@@ -128,8 +150,11 @@ func (l *NDNLPLinkService) Run(initial []byte) {
 		return
 	}
 
+	// Shape outgoing traffic, if configured for this face
+	l.shaper = newShaper(l.RemoteURI(), l.Scope())
+
 	// Add self to face table. Removed in runSend.
-	FaceTable.Add(l)
+	l.faces.Add(l)
 
 	// Process initial incoming frame
 	if initial != nil {
@@ -157,14 +182,63 @@ func (l *NDNLPLinkService) runSend() {
 		runtime.LockOSThread()
 	}
 
+	if l.shaper != nil {
+		l.runShapedSend()
+		return
+	}
+
 	for {
 		select {
 		case pkt := <-l.sendQueue:
 			sendPacket(l, pkt)
+		case <-l.reliability.ticker.C:
+			l.reliability.onTick()
+		case <-l.stopped:
+			l.reliability.ticker.Stop()
+			l.faces.Remove(l.transport.FaceID())
+			return
+		}
+	}
+}
+
+// runShapedSend is the send loop of a shaped face. Packets are taken from the
+// priority classes in turn, and held back while the rate limit is exceeded.
+func (l *NDNLPLinkService) runShapedSend() {
+	s := l.shaper
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+
+	for {
+		// Send until the queues are empty or the rate limit is reached
+		wait := s.wait()
+		for wait == 0 {
+			out, ok := s.dequeue()
+			if !ok {
+				break
+			}
+			s.consume(out.Pkt.Raw.Length())
+			sendPacket(l, out)
+			wait = s.wait()
+		}
+
+		var resume <-chan time.Time
+		if wait > 0 {
+			timer.Reset(wait)
+			resume = timer.C
+		}
+
+		select {
+		case <-s.ready:
+		case <-resume:
+		case <-l.reliability.ticker.C:
+			l.reliability.onTick()
 		case <-l.stopped:
-			FaceTable.Remove(l.transport.FaceID())
+			timer.Stop()
+			l.reliability.ticker.Stop()
+			l.faces.Remove(l.transport.FaceID())
 			return
 		}
+		timer.Stop()
 	}
 }
 
@@ -174,7 +248,9 @@ func sendPacket(l *NDNLPLinkService, out dispatch.OutPkt) {
 	wire := pkt.Raw
 
 	// Counters
-	if pkt.L3.Interest != nil {
+	if pkt.NackReason.IsSet() {
+		l.nOutNacks++
+	} else if pkt.L3.Interest != nil {
 		l.nOutInterests++
 	} else if pkt.L3.Data != nil {
 		l.nOutData++
@@ -198,6 +274,15 @@ func sendPacket(l *NDNLPLinkService, out dispatch.OutPkt) {
 	if congestionMark.IsSet() {
 		effectiveMtu -= congestionMarkOverhead
 	}
+	if pkt.NackReason.IsSet() {
+		effectiveMtu -= nackOverhead
+	}
+	if out.NonDiscovery {
+		effectiveMtu -= nonDiscoveryOverhead
+	}
+	if pa := out.PrefixAnnouncement; pa != nil {
+		effectiveMtu -= prefixAnnOverhead + int(pa.Length())
+	}
 
 	// Fragment packet if necessary
 	var fragments []*defn.FwLpPacket
@@ -239,6 +324,12 @@ func sendPacket(l *NDNLPLinkService, out dispatch.OutPkt) {
 		fragments = []*defn.FwLpPacket{{Fragment: wire}}
 	}
 
+	// Reliability state shared by all fragments
+	var netPkt *lpUnackedNetPkt
+	if l.options.IsReliabilityEnabled {
+		netPkt = &lpUnackedNetPkt{}
+	}
+
 	// Send fragment(s)
 	for _, fragment := range fragments {
 		// PIT tokens
@@ -256,25 +347,50 @@ func sendPacket(l *NDNLPLinkService, out dispatch.OutPkt) {
 			fragment.CongestionMark = congestionMark
 		}
 
-		// Encode final LP frame
-		pkt := defn.FwPacket{LpPacket: fragment}
-		frameWire := pkt.Encode()
-		if frameWire == nil {
-			core.Log.Error(l, "Unable to encode fragment - DROP")
-			break
+		// Network Nack
+		if reason, ok := pkt.NackReason.Get(); ok {
+			fragment.Nack = &defn.FwNetworkNack{Reason: reason}
+		}
+
+		// Self-learning
+		fragment.NonDiscovery = out.NonDiscovery
+		fragment.PrefixAnnouncement = out.PrefixAnnouncement
+
+		// Reliability (TxSequence and piggybacked Acks)
+		if netPkt != nil {
+			l.reliability.onSend(fragment, netPkt, effectiveMtu-int(fragment.Fragment.Length()))
 		}
 
-		// Use preallocated buffer for outgoing frame
-		l.outFrame = l.outFrame[:0]
-		for _, b := range frameWire {
-			l.outFrame = append(l.outFrame, b...)
+		if !l.sendLpFrame(fragment) {
+			break
 		}
-		l.transport.sendFrame(l.outFrame)
 	}
 }
 
+// sendLpFrame encodes an LP frame and sends it on the transport.
+// Returns false if the frame could not be encoded.
+func (l *NDNLPLinkService) sendLpFrame(frame *defn.FwLpPacket) bool {
+	pkt := defn.FwPacket{LpPacket: frame}
+	frameWire := pkt.Encode()
+	if frameWire == nil {
+		core.Log.Error(l, "Unable to encode fragment - DROP")
+		return false
+	}
+
+	// Use preallocated buffer for outgoing frame
+	l.outFrame = l.outFrame[:0]
+	for _, b := range frameWire {
+		l.outFrame = append(l.outFrame, b...)
+	}
+	captureFrame(l.faceID, l.outFrame, false)
+	l.transport.sendFrame(l.outFrame)
+	return true
+}
+
 // (AI GENERATED DESCRIPTION): Processes an incoming link‑layer frame: it decodes the L2 packet, optionally reassembles fragmented frames, extracts the encapsulated L3 Interest or Data, updates counters, and dispatches the packet to the appropriate handler.
 func (l *NDNLPLinkService) handleIncomingFrame(frame []byte) {
+	captureFrame(l.faceID, frame, true)
+
 	// We have to copy so receive transport buffer can be reused
 	frameCopy := make([]byte, len(frame))
 	copy(frameCopy, frame)
@@ -301,6 +417,12 @@ func (l *NDNLPLinkService) handleIncomingFrame(frame []byte) {
 		LP := L2.LpPacket
 		fragment := LP.Fragment
 
+		// Reliability (Acks and TxSequence)
+		if l.options.IsReliabilityEnabled && !l.reliability.onReceive(LP) {
+			core.Log.Trace(l, "Duplicate frame - DROP", "txseq", LP.TxSequence.Unwrap())
+			return
+		}
+
 		// If there is no fragment, then IDLE packet, drop.
 		if len(fragment) == 0 {
 			core.Log.Trace(l, "IDLE frame - DROP")
@@ -337,11 +459,20 @@ func (l *NDNLPLinkService) handleIncomingFrame(frame []byte) {
 		// Congestion mark
 		pkt.CongestionMark = LP.CongestionMark
 
+		// Network Nack
+		if LP.Nack != nil {
+			pkt.NackReason = optional.Some(LP.Nack.Reason)
+		}
+
 		// Consumer-controlled forwarding (NextHopFaceId)
 		if l.options.IsConsumerControlledForwardingEnabled {
 			pkt.NextHopFaceID = LP.NextHopFaceId
 		}
 
+		// Self-learning
+		pkt.NonDiscovery = LP.NonDiscovery
+		pkt.PrefixAnnouncement = LP.PrefixAnnouncement
+
 		// No need to copy the pit token since it's already in its own buffer
 		// See the generated code for defn.FwLpPacket
 		pkt.PitToken = LP.PitToken
@@ -356,7 +487,14 @@ func (l *NDNLPLinkService) handleIncomingFrame(frame []byte) {
 	}
 
 	// Dispatch and update counters
-	if pkt.L3.Interest != nil {
+	if pkt.NackReason.IsSet() {
+		if pkt.L3.Interest == nil {
+			core.Log.Warn(l, "Received Nack for non-Interest packet - DROP")
+			return
+		}
+		l.nInNacks++
+		l.dispatchNack(pkt)
+	} else if pkt.L3.Interest != nil {
 		l.nInInterests++
 		l.dispatchInterest(pkt)
 	} else if pkt.L3.Data != nil {
@@ -432,6 +570,16 @@ func (l *NDNLPLinkService) checkCongestion(wire enc.Wire) bool {
 		return false
 	}
 
+	// Packets dropped by a full send queue, or a filling queue of a shaped face
+	if l.overflowed.Load() || (l.shaper != nil && l.shaper.congested()) {
+		now := time.Now()
+		if now.After(l.lastTimeCongestionMarked.Add(l.options.BaseCongestionMarkingInterval)) {
+			l.overflowed.Store(false)
+			l.lastTimeCongestionMarked = now
+			return true
+		}
+	}
+
 	// GetSendQueueSize is expensive, so only check every 1/2 of the threshold
 	// and only if we can mark congestion for this particular packet
 	if l.congestionCheck > l.options.DefaultCongestionThresholdBytes {
@@ -454,8 +602,26 @@ func (op *NDNLPLinkServiceOptions) Flags() (ret uint64) {
 	if op.IsConsumerControlledForwardingEnabled {
 		ret |= FaceFlagLocalFields
 	}
+	if op.IsReliabilityEnabled {
+		ret |= FaceFlagLpReliabilityEnabled
+	}
 	if op.IsCongestionMarkingEnabled {
 		ret |= FaceFlagCongestionMarking
 	}
 	return
 }
+
+// NAcknowledged returns the number of frames acknowledged by the remote endpoint.
+func (l *NDNLPLinkService) NAcknowledged() uint64 {
+	return l.reliability.nAcknowledged.Load()
+}
+
+// NRetransmitted returns the number of frames retransmitted on this face.
+func (l *NDNLPLinkService) NRetransmitted() uint64 {
+	return l.reliability.nRetransmitted.Load()
+}
+
+// NRetxExhausted returns the number of packets dropped after exceeding the retransmission limit.
+func (l *NDNLPLinkService) NRetxExhausted() uint64 {
+	return l.reliability.nRetxExhausted.Load()
+}
@@ -27,10 +27,10 @@ func MakeNullLinkService(transport transport) *NullLinkService {
 
 // Run runs the NullLinkService.
 func (l *NullLinkService) Run(initial []byte) {
-	FaceTable.Add(l)
+	l.faces.Add(l)
 	go func() {
 		l.transport.runReceive()
-		FaceTable.Remove(l.transport.FaceID())
+		l.faces.Remove(l.transport.FaceID())
 	}()
 }
 
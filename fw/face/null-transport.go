@@ -72,7 +72,7 @@ func (t *NullTransport) runReceive() {
 
 // (AI GENERATED DESCRIPTION): Atomically marks the transport as inactive and, if it was previously running, sends a signal on the close channel to trigger shutdown.
 func (t *NullTransport) Close() {
-	if t.running.Swap(false) {
+	if t.setRunning(false) {
 		t.close <- true
 	}
 }
@@ -0,0 +1,262 @@
+package face
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/dispatch"
+	enc "github.com/named-data/ndnd/std/encoding"
+)
+
+// shapingClass is a parsed priority class of the configuration.
+type shapingClass struct {
+	name             string
+	weight           int
+	prefixes         []enc.Name
+	types            []string
+	congestionMarked bool
+}
+
+// shapingClasses are the configured priority classes, in the order they are matched
+// and served in each scheduling round.
+var shapingClasses []shapingClass
+
+// loadShapingClasses parses the configured priority classes.
+// Invalid classes are logged and ignored.
+func loadShapingClasses() {
+	shapingClasses = nil
+	for _, c := range core.C.Faces.Shaping.Classes {
+		class := shapingClass{
+			name:             c.Name,
+			weight:           max(c.Weight, 1),
+			types:            c.Types,
+			congestionMarked: c.CongestionMarked,
+		}
+
+		valid := true
+		for _, prefix := range c.Prefixes {
+			name, err := enc.NameFromStr(prefix)
+			if err != nil {
+				core.Log.Error(nil, "Invalid prefix of shaping class", "class", c.Name, "prefix", prefix, "err", err)
+				valid = false
+				break
+			}
+			class.prefixes = append(class.prefixes, name)
+		}
+		for _, typ := range c.Types {
+			if typ != "interest" && typ != "data" && typ != "nack" {
+				core.Log.Error(nil, "Invalid packet type of shaping class", "class", c.Name, "type", typ)
+				valid = false
+			}
+		}
+
+		if valid {
+			shapingClasses = append(shapingClasses, class)
+		}
+	}
+}
+
+// matches returns whether a packet of the given type and name belongs to the class.
+// All the criteria set in the class must match.
+func (c *shapingClass) matches(pkt *defn.Pkt, typ string, name enc.Name) bool {
+	if len(c.types) > 0 && !slices.Contains(c.types, typ) {
+		return false
+	}
+	if c.congestionMarked && !pkt.CongestionMark.IsSet() {
+		return false
+	}
+	if len(c.prefixes) > 0 && !slices.ContainsFunc(c.prefixes, func(prefix enc.Name) bool {
+		return prefix.IsPrefix(name)
+	}) {
+		return false
+	}
+	return true
+}
+
+// shaper holds the packets to be sent on a face in weighted priority classes,
+// and limits the rate of the face with a token bucket.
+//
+// Each scheduling round sends up to the weight of each class in packets, in the
+// order of the classes, so that a busy class cannot starve the others.
+type shaper struct {
+	classes []*shaperQueue
+	ready   chan struct{}
+	next    int
+
+	// Token bucket, unlimited if rate is zero
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// shaperQueue is the queue of a priority class of a face.
+type shaperQueue struct {
+	class  *shapingClass
+	queue  chan dispatch.OutPkt
+	credit int
+}
+
+// defaultShapingClass holds the packets that match no configured class.
+var defaultShapingClass = shapingClass{name: "default", weight: 1}
+
+// newShaper creates the shaper of a face, or returns nil if the face is not shaped.
+// Local faces are only shaped by a rate limit that matches their remote URI.
+func newShaper(remoteURI *defn.URI, scope defn.Scope) *shaper {
+	cfg := &core.C.Faces.Shaping
+	rate, burst := uint64(0), cfg.Burst
+	if scope == defn.NonLocal {
+		rate = cfg.Rate
+	}
+
+	uri := remoteURI.String()
+	for _, rule := range cfg.Faces {
+		if rule.Uri != "" && strings.HasPrefix(uri, rule.Uri) {
+			rate = rule.Rate
+			if rule.Burst > 0 {
+				burst = rule.Burst
+			}
+			break
+		}
+	}
+
+	classes := shapingClasses
+	if scope != defn.NonLocal {
+		classes = nil
+	}
+	if rate == 0 && len(classes) == 0 {
+		return nil
+	}
+
+	s := &shaper{
+		ready:  make(chan struct{}, 1),
+		rate:   float64(rate),
+		burst:  float64(max(burst, 1)),
+		tokens: float64(max(burst, 1)),
+		last:   time.Now(),
+	}
+	for i := range classes {
+		s.classes = append(s.classes, &shaperQueue{
+			class: &classes[i],
+			queue: make(chan dispatch.OutPkt, CfgFaceQueueSize()),
+		})
+	}
+	s.classes = append(s.classes, &shaperQueue{
+		class: &defaultShapingClass,
+		queue: make(chan dispatch.OutPkt, CfgFaceQueueSize()),
+	})
+	return s
+}
+
+// classify returns the queue of the first class that matches the packet.
+func (s *shaper) classify(pkt *defn.Pkt) *shaperQueue {
+	last := len(s.classes) - 1
+	if last == 0 {
+		return s.classes[0]
+	}
+
+	typ, name := "", pkt.Name
+	switch {
+	case pkt.NackReason.IsSet():
+		typ = "nack"
+		if name == nil && pkt.L3.Interest != nil {
+			name = pkt.L3.Interest.NameV
+		}
+	case pkt.L3.Interest != nil:
+		typ = "interest"
+		if name == nil {
+			name = pkt.L3.Interest.NameV
+		}
+	case pkt.L3.Data != nil:
+		typ = "data"
+		if name == nil {
+			name = pkt.L3.Data.NameV
+		}
+	}
+
+	for _, q := range s.classes[:last] {
+		if q.class.matches(pkt, typ, name) {
+			return q
+		}
+	}
+	return s.classes[last]
+}
+
+// enqueue adds a packet to the queue of its class, and returns false if the queue is full.
+func (s *shaper) enqueue(out dispatch.OutPkt) bool {
+	select {
+	case s.classify(out.Pkt).queue <- out:
+	default:
+		return false
+	}
+
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// congested returns whether the queue of any class is more than half full.
+func (s *shaper) congested() bool {
+	for _, q := range s.classes {
+		if len(q.queue) > cap(q.queue)/2 {
+			return true
+		}
+	}
+	return false
+}
+
+// dequeue returns the next packet to send in the current scheduling round,
+// starting a new round if needed. It returns false if all queues are empty.
+func (s *shaper) dequeue() (dispatch.OutPkt, bool) {
+	// At most the rest of the current round, and a full new round
+	for range 2 {
+		for ; s.next < len(s.classes); s.next++ {
+			q := s.classes[s.next]
+			if q.credit == 0 {
+				continue
+			}
+			select {
+			case out := <-q.queue:
+				q.credit--
+				return out, true
+			default:
+				// An empty class gives up its turn in this round
+				q.credit = 0
+			}
+		}
+
+		s.next = 0
+		for _, q := range s.classes {
+			q.credit = q.class.weight
+		}
+	}
+	return dispatch.OutPkt{}, false
+}
+
+// wait refills the token bucket, and returns how long to wait until a packet may be sent.
+func (s *shaper) wait() time.Duration {
+	if s.rate == 0 {
+		return 0
+	}
+
+	now := time.Now()
+	s.tokens = min(s.burst, s.tokens+now.Sub(s.last).Seconds()*s.rate)
+	s.last = now
+	if s.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-s.tokens / s.rate * float64(time.Second))
+}
+
+// consume takes the size of a sent packet from the token bucket.
+// The bucket may go into debt, so packets larger than the burst can be sent.
+func (s *shaper) consume(size uint64) {
+	if s.rate > 0 {
+		s.tokens -= float64(size)
+	}
+}
@@ -0,0 +1,167 @@
+package face
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/dispatch"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// shapedPkt returns an outgoing Interest or Data packet with the given name.
+func shapedPkt(typ string, name string) dispatch.OutPkt {
+	n, _ := enc.NameFromStr(name)
+	pkt := &defn.Pkt{Name: n, L3: &defn.FwPacket{}}
+	if typ == "interest" {
+		pkt.L3.Interest = &defn.FwInterest{NameV: n}
+	} else {
+		pkt.L3.Data = &defn.FwData{NameV: n}
+	}
+	return dispatch.OutPkt{Pkt: pkt}
+}
+
+// dequeueNames returns the names of all packets in the shaper, in the order they are sent.
+func dequeueNames(s *shaper) []string {
+	names := []string{}
+	for {
+		out, ok := s.dequeue()
+		if !ok {
+			return names
+		}
+		names = append(names, out.Pkt.Name.String())
+	}
+}
+
+// withShapingClasses sets the shaping classes for a test.
+func withShapingClasses(t *testing.T, classes []core.ShapingClass) {
+	prev := core.C.Faces.Shaping.Classes
+	core.C.Faces.Shaping.Classes = classes
+	loadShapingClasses()
+	t.Cleanup(func() {
+		core.C.Faces.Shaping.Classes = prev
+		loadShapingClasses()
+	})
+}
+
+func TestShaperClasses(t *testing.T) {
+	withShapingClasses(t, []core.ShapingClass{
+		{Name: "sync", Weight: 2, Prefixes: []string{"/sync"}},
+		{Name: "data", Weight: 1, Types: []string{"data"}},
+		{Name: "invalid", Prefixes: []string{"/a"}, Types: []string{"unknown"}},
+	})
+	require.Len(t, shapingClasses, 2)
+
+	// Local faces without a rate limit are not shaped
+	assert.Nil(t, newShaper(defn.MakeNullFaceURI(), defn.Local))
+
+	s := newShaper(defn.MakeNullFaceURI(), defn.NonLocal)
+	require.NotNil(t, s)
+	require.Len(t, s.classes, 3)
+
+	// The first matching class is used
+	assert.Equal(t, "sync", s.classify(shapedPkt("data", "/sync/1").Pkt).class.name)
+	assert.Equal(t, "data", s.classify(shapedPkt("data", "/bulk/1").Pkt).class.name)
+	assert.Equal(t, "default", s.classify(shapedPkt("interest", "/bulk/1").Pkt).class.name)
+}
+
+func TestShaperDequeue(t *testing.T) {
+	withShapingClasses(t, []core.ShapingClass{
+		{Name: "sync", Weight: 3, Prefixes: []string{"/sync"}},
+		{Name: "data", Weight: 1, Types: []string{"data"}},
+	})
+	s := newShaper(defn.MakeNullFaceURI(), defn.NonLocal)
+	require.NotNil(t, s)
+
+	_, ok := s.dequeue()
+	assert.False(t, ok)
+
+	for i := range 5 {
+		require.True(t, s.enqueue(shapedPkt("interest", fmt.Sprintf("/sync/%c", 'a'+i))))
+		require.True(t, s.enqueue(shapedPkt("data", fmt.Sprintf("/data/%c", 'a'+i))))
+		require.True(t, s.enqueue(shapedPkt("interest", fmt.Sprintf("/other/%c", 'a'+i))))
+	}
+
+	// Each round sends up to the weight of each class in order,
+	// and empty classes give up their turn to the others
+	assert.Equal(t, []string{
+		"/sync/a", "/sync/b", "/sync/c", "/data/a", "/other/a",
+		"/sync/d", "/sync/e", "/data/b", "/other/b",
+		"/data/c", "/other/c",
+		"/data/d", "/other/d",
+		"/data/e", "/other/e",
+	}, dequeueNames(s))
+}
+
+func TestShaperOverflow(t *testing.T) {
+	withShapingClasses(t, []core.ShapingClass{
+		{Name: "sync", Weight: 1, Prefixes: []string{"/sync"}},
+	})
+	s := newShaper(defn.MakeNullFaceURI(), defn.NonLocal)
+	require.NotNil(t, s)
+
+	size := CfgFaceQueueSize()
+	for range size / 2 {
+		require.True(t, s.enqueue(shapedPkt("interest", "/sync/a")))
+	}
+	assert.False(t, s.congested())
+
+	// A class queue more than half full is congested, even if the others are empty
+	for range size - size/2 {
+		require.True(t, s.enqueue(shapedPkt("interest", "/sync/a")))
+	}
+	assert.True(t, s.congested())
+	assert.False(t, s.enqueue(shapedPkt("interest", "/sync/a")))
+	assert.True(t, s.enqueue(shapedPkt("interest", "/other/a")))
+
+	dequeueNames(s)
+	assert.False(t, s.congested())
+}
+
+func TestShaperTokenBucket(t *testing.T) {
+	now := time.Now()
+	s := &shaper{rate: 1000, burst: 100, tokens: 100, last: now}
+
+	// Packets are sent within the burst without waiting
+	assert.Zero(t, s.wait())
+	s.consume(60)
+	assert.Zero(t, s.wait())
+
+	// Packets larger than the burst put the bucket in debt, until it is refilled at the rate
+	s.last = now
+	s.tokens = 100
+	s.consume(300)
+	wait := s.wait()
+	assert.InDelta(t, 200*time.Millisecond, wait, float64(10*time.Millisecond))
+
+	// The bucket is refilled up to the burst
+	s.last = time.Now().Add(-time.Second)
+	assert.Zero(t, s.wait())
+	assert.Equal(t, 100.0, s.tokens)
+
+	// No rate limit
+	s = &shaper{}
+	s.consume(1 << 20)
+	assert.Zero(t, s.wait())
+}
+
+func TestSendPacketOverflow(t *testing.T) {
+	prev := core.C.Faces.QueueSize
+	core.C.Faces.QueueSize = 2
+	defer func() { core.C.Faces.QueueSize = prev }()
+
+	l := MakeNDNLPLinkService(MakeNullTransport(), MakeNDNLPLinkServiceOptions())
+	wire := enc.Wire{make([]byte, 10)}
+	assert.False(t, l.checkCongestion(wire))
+
+	// A packet dropped by a full queue marks the next packet sent on the face
+	for range 3 {
+		l.SendPacket(shapedPkt("interest", "/a"))
+	}
+	assert.True(t, l.checkCongestion(wire))
+	assert.False(t, l.checkCongestion(wire))
+}
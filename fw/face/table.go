@@ -15,16 +15,36 @@ import (
 	"github.com/named-data/ndnd/fw/core"
 	defn "github.com/named-data/ndnd/fw/defn"
 	"github.com/named-data/ndnd/fw/dispatch"
-	"github.com/named-data/ndnd/fw/table"
+	spec_mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
 )
 
-// FaceTable is the global face table for this forwarder
-var FaceTable Table
+// FaceTable is the global face table for this forwarder, created by Initialize
+var FaceTable *Table
+
+// FaceEventHandler is called when a face is created, destroyed, goes up or down,
+// or has its properties updated. The kind is one of the FaceEvent* constants of mgmt.
+type FaceEventHandler func(kind uint64, face LinkService)
 
 // Table hold all faces used by the forwarder.
 type Table struct {
+	fwd        *dispatch.Forwarder
 	faces      sync.Map
 	nextFaceID atomic.Uint64 // starts at 1
+
+	handlers      []FaceEventHandler
+	handlersMutex sync.RWMutex
+}
+
+// NewTable creates a face table, whose faces are added to the forwarder.
+func NewTable(fwd *dispatch.Forwarder) *Table {
+	t := &Table{fwd: fwd}
+	t.nextFaceID.Store(1)
+	return t
+}
+
+// Forwarder returns the forwarder of the faces of the table.
+func (t *Table) Forwarder() *dispatch.Forwarder {
+	return t.fwd
 }
 
 // (AI GENERATED DESCRIPTION): Implements the fmt.Stringer interface for Table, returning the literal string “face-table” as its textual identifier.
@@ -37,8 +57,9 @@ func (t *Table) Add(face LinkService) {
 	faceID := t.nextFaceID.Add(1) - 1
 	face.SetFaceID(faceID)
 	t.faces.Store(faceID, face)
-	dispatch.AddFace(faceID, face)
+	t.fwd.AddFace(faceID, face)
 	core.Log.Debug(t, "Registered face", "faceid", faceID)
+	t.NotifyEvent(spec_mgmt.FaceEventCreated, face)
 }
 
 // Get gets the face with the specified ID (if any) from the face table.
@@ -64,6 +85,21 @@ func (t *Table) GetByURI(remoteURI *defn.URI) LinkService {
 	return found
 }
 
+// GetByURIs gets the face with the specified remote and local URIs (if any) from the face table.
+// Faces on different network interfaces may have the same remote URI, e.g., Ethernet faces.
+func (t *Table) GetByURIs(remoteURI *defn.URI, localURI *defn.URI) LinkService {
+	var found LinkService
+	t.faces.Range(func(_, face interface{}) bool {
+		if face.(LinkService).RemoteURI().String() == remoteURI.String() &&
+			face.(LinkService).LocalURI().String() == localURI.String() {
+			found = face.(LinkService)
+			return false
+		}
+		return true
+	})
+	return found
+}
+
 // GetAll returns points to all faces.
 func (t *Table) GetAll() []LinkService {
 	faces := make([]LinkService, 0)
@@ -76,10 +112,30 @@ func (t *Table) GetAll() []LinkService {
 
 // Remove removes a face from the face table.
 func (t *Table) Remove(id uint64) {
-	t.faces.Delete(id)
-	dispatch.RemoveFace(id)
-	table.Rib.CleanUpFace(id)
+	face, ok := t.faces.LoadAndDelete(id)
+	t.fwd.RemoveFace(id)
+	t.fwd.Rib.CleanUpFace(id)
 	core.Log.Info(t, "Unregistered face", "faceid", id)
+	if ok {
+		t.NotifyEvent(spec_mgmt.FaceEventDestroyed, face.(LinkService))
+	}
+}
+
+// AddEventHandler registers a handler for face events.
+// Handlers are called from the goroutine causing the event and must not block.
+func (t *Table) AddEventHandler(handler FaceEventHandler) {
+	t.handlersMutex.Lock()
+	defer t.handlersMutex.Unlock()
+	t.handlers = append(t.handlers, handler)
+}
+
+// NotifyEvent notifies all handlers of a face event.
+func (t *Table) NotifyEvent(kind uint64, face LinkService) {
+	t.handlersMutex.RLock()
+	defer t.handlersMutex.RUnlock()
+	for _, handler := range t.handlers {
+		handler(kind, face)
+	}
 }
 
 // expirationHandler stops the faces that have expired
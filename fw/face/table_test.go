@@ -0,0 +1,79 @@
+package face
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/fw/dispatch"
+	"github.com/named-data/ndnd/fw/table"
+	spec_mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMain creates the face table used by the faces of the tests.
+func TestMain(m *testing.M) {
+	table.Initialize()
+	FaceTable = NewTable(dispatch.NewForwarder(table.FibStrategyTable, table.Rib))
+	os.Exit(m.Run())
+}
+
+// faceEvents returns a channel receiving the kinds of the events of a face.
+func faceEvents(face *LinkService) chan uint64 {
+	events := make(chan uint64, 16)
+	FaceTable.AddEventHandler(func(kind uint64, link LinkService) {
+		if *face != nil && link == *face {
+			events <- kind
+		}
+	})
+	return events
+}
+
+// nextFaceEvent returns the next event, or zero if there is none.
+func nextFaceEvent(events chan uint64) uint64 {
+	select {
+	case kind := <-events:
+		return kind
+	case <-time.After(time.Second):
+		return 0
+	}
+}
+
+func TestFaceTableEvents(t *testing.T) {
+	var link LinkService
+	events := faceEvents(&link)
+
+	transport := MakeNullTransport()
+	link = MakeNullLinkService(transport)
+	link.Run(nil)
+	assert.Equal(t, spec_mgmt.FaceEventCreated, nextFaceEvent(events))
+	assert.Equal(t, link, FaceTable.Get(link.FaceID()))
+	require.Eventually(t, transport.IsRunning, time.Second, time.Millisecond)
+
+	// State changes of the transport are face events
+	transport.setRunning(false)
+	assert.Equal(t, spec_mgmt.FaceEventDown, nextFaceEvent(events))
+	transport.setRunning(true)
+	assert.Equal(t, spec_mgmt.FaceEventUp, nextFaceEvent(events))
+	assert.False(t, transport.setRunning(true))
+
+	// Closing the face brings it down before it is removed
+	transport.Close()
+	assert.Equal(t, spec_mgmt.FaceEventDown, nextFaceEvent(events))
+	assert.Equal(t, spec_mgmt.FaceEventDestroyed, nextFaceEvent(events))
+	assert.Nil(t, FaceTable.Get(link.FaceID()))
+	assert.Empty(t, events)
+}
+
+func TestTransportStateBeforeRegistration(t *testing.T) {
+	var link LinkService
+	events := faceEvents(&link)
+
+	// Transports started before the face is in the face table do not notify
+	transport := MakeNullTransport()
+	link = MakeNullLinkService(transport)
+	assert.True(t, transport.setRunning(true))
+	assert.True(t, transport.setRunning(false))
+	assert.Zero(t, nextFaceEvent(events))
+}
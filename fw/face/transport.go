@@ -99,6 +99,28 @@ func (t *transportBase) setLinkService(linkService LinkService) {
 	t.linkService = linkService
 }
 
+// setRunning changes whether the transport is running (up), and notifies the
+// face event handlers when a face in the face table goes up or down.
+// Returns whether the state was changed.
+func (t *transportBase) setRunning(running bool) bool {
+	if t.running.Swap(running) == running {
+		return false
+	}
+
+	// Faces are added to the face table after their transport is started
+	if t.linkService == nil {
+		return true
+	}
+	if faces := t.linkService.faceTable(); faces.Get(t.faceID) == t.linkService {
+		if running {
+			faces.NotifyEvent(spec_mgmt.FaceEventUp, t.linkService)
+		} else {
+			faces.NotifyEvent(spec_mgmt.FaceEventDown, t.linkService)
+		}
+	}
+	return true
+}
+
 //
 // Getters
 //
@@ -256,6 +256,7 @@ func (t *UnicastTCPTransport) runReceive() {
 			}
 
 			core.Log.Warn(t, "Unable to read from socket - Face DOWN", "err", err)
+			t.setRunning(false)
 		}
 
 		// Persistent faces will reconnect, otherwise close
@@ -265,13 +266,13 @@ func (t *UnicastTCPTransport) runReceive() {
 		}
 
 		core.Log.Info(t, "Connected socket - Face UP")
-		t.running.Store(true)
+		t.setRunning(true)
 	}
 }
 
 // Close the inner connection if running without closing the transport.
 func (t *UnicastTCPTransport) CloseConn() {
-	if t.running.Swap(false) {
+	if t.setRunning(false) {
 		t.conn.Close()
 	}
 }
@@ -106,7 +106,7 @@ func (t *UnixStreamTransport) runReceive() {
 
 // (AI GENERATED DESCRIPTION): Marks the Unix stream transport as stopped and closes its underlying connection if it was running.
 func (t *UnixStreamTransport) Close() {
-	if t.running.Swap(false) {
+	if t.setRunning(false) {
 		t.conn.Close()
 	}
 }
@@ -105,6 +105,6 @@ func (t *WebSocketTransport) runReceive() {
 
 // (AI GENERATED DESCRIPTION): Stops the WebSocketTransport from running and closes the underlying WebSocket connection.
 func (t *WebSocketTransport) Close() {
-	t.running.Store(false)
+	t.setRunning(false)
 	t.c.Close()
 }
@@ -0,0 +1,304 @@
+/* YaNFD - Yet another NDN Forwarding Daemon
+ *
+ * Copyright (C) 2020-2021 Eric Newberry.
+ *
+ * This file is licensed under the terms of the MIT License, as found in LICENSE.md.
+ */
+
+package fw
+
+import (
+	"math/rand/v2"
+	"sort"
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+)
+
+// AsfProbingInterval is the interval between probes of alternative nexthops.
+const AsfProbingInterval = 60 * time.Second
+
+// AsfFirstProbeDelay is the maximum delay before the first probe of a new namespace.
+const AsfFirstProbeDelay = 5 * time.Second
+
+// AsfMaxSilentTimeouts is the number of timeouts that are ignored before a face is considered timed out.
+const AsfMaxSilentTimeouts = 0
+
+// AsfMeasurementLifetime is the time after which unused namespace measurements are discarded.
+const AsfMeasurementLifetime = 5 * time.Minute
+
+// Asf is an adaptive SRTT-based forwarding strategy. It measures the RTT and
+// timeouts of each nexthop, forwards Interests to the best performing nexthop,
+// and periodically probes alternative nexthops to detect better paths.
+type Asf struct {
+	StrategyBase
+}
+
+// asfNamespaceInfo is the ASF strategy information of a Measurements entry.
+type asfNamespaceInfo struct {
+	probingDue time.Time
+}
+
+// Registers the ASF strategy (version 1) in the strategy registry.
+func init() {
+	strategyInit["asf"] = func() Strategy { return &Asf{} }
+	StrategyVersions["asf"] = []uint64{1}
+}
+
+// Instantiate initializes the ASF strategy for the given forwarding thread.
+func (s *Asf) Instantiate(fwThread *Thread) {
+	s.NewStrategyBase(fwThread, "asf", 1)
+}
+
+// AfterContentStoreHit sends the cached Data back to the requesting face.
+func (s *Asf) AfterContentStoreHit(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	core.Log.Trace(s, "AfterContentStoreHit", "name", packet.Name, "faceid", inFace)
+	s.SendData(packet, pitEntry, inFace, 0) // 0 indicates ContentStore is source
+}
+
+// AfterReceiveData records the RTT of the upstream face and forwards the Data
+// to all downstream faces in the PIT entry.
+func (s *Asf) AfterReceiveData(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	core.Log.Trace(s, "AfterReceiveData", "name", packet.Name, "inrecords", len(pitEntry.InRecords()))
+	s.recordData(pitEntry, inFace)
+
+	for faceID := range pitEntry.InRecords() {
+		core.Log.Trace(s, "Forwarding Data", "name", packet.Name, "faceid", faceID)
+		s.SendData(packet, pitEntry, faceID, inFace)
+	}
+}
+
+// AfterReceiveInterest forwards the Interest to the best performing nexthop,
+// and additionally to a probed nexthop if the probing interval has elapsed.
+func (s *Asf) AfterReceiveInterest(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+	nexthops []*table.FibNextHopEntry,
+) {
+	if len(nexthops) == 0 {
+		core.Log.Debug(s, "No nexthop found - NACK", "name", packet.Name)
+		s.SendNack(packet, pitEntry, inFace, spec.NackReasonNoRoute)
+		return
+	}
+
+	now := time.Now()
+	entry := s.Measurements().Get(asfNamespaceOf(packet.Name))
+	s.Measurements().ExtendLifetime(entry, AsfMeasurementLifetime)
+	info := s.namespaceInfo(entry, now)
+
+	// Any out-record that did not bring back Data within the RTO is
+	// considered to have timed out when the Interest is retransmitted.
+	for faceID, outRecord := range pitEntry.OutRecords() {
+		if outRecord.LatestTimestamp.Add(entry.Face(faceID).Rtt.Rto()).Before(now) {
+			s.recordTimeout(entry, faceID, outRecord)
+		}
+	}
+
+	ranked := s.rankNexthops(entry, nexthops)
+
+	// Forward to the best face that does not have a pending out-record
+	var sentTo *table.FibNextHopEntry
+	pending := false
+	for _, nh := range ranked {
+		if oR := pitEntry.OutRecords()[nh.Nexthop]; oR != nil && !oR.NackReason.IsSet() &&
+			oR.LatestTimestamp.Add(entry.Face(nh.Nexthop).Rtt.Rto()).After(now) {
+			pending = true
+			continue
+		}
+
+		core.Log.Trace(s, "Forwarding Interest", "name", packet.Name, "faceid", nh.Nexthop)
+		if s.SendInterest(packet, pitEntry, nh.Nexthop, inFace) {
+			sentTo = nh
+			break
+		}
+	}
+
+	if sentTo == nil && pending {
+		// The pending Interests may still bring back Data
+		core.Log.Debug(s, "Retransmission while all usable nexthops are pending - DROP", "name", packet.Name)
+		return
+	}
+	if sentTo == nil {
+		core.Log.Debug(s, "No usable nexthop for Interest - NACK", "name", packet.Name)
+		s.SendNack(packet, pitEntry, inFace, spec.NackReasonNoRoute)
+		return
+	}
+
+	// Probe an alternative face if due
+	if len(ranked) > 1 && !now.Before(info.probingDue) {
+		info.probingDue = now.Add(AsfProbingInterval)
+		if probe := s.selectProbe(entry, ranked, sentTo); probe != nil {
+			core.Log.Trace(s, "Probing Interest", "name", packet.Name, "faceid", probe.Nexthop)
+			s.SendInterest(packet, pitEntry, probe.Nexthop, inFace)
+		}
+	}
+}
+
+// AfterReceiveNack records the Nack as a timeout of the upstream face, and returns
+// the Nack downstream once all upstreams have replied with a Nack.
+func (s *Asf) AfterReceiveNack(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	core.Log.Trace(s, "AfterReceiveNack", "name", packet.Name, "faceid", inFace, "reason", packet.NackReason.Unwrap())
+	if entry := s.Measurements().FindExactMatch(asfNamespaceOf(pitEntry.EncName())); entry != nil {
+		entry.Face(inFace).RecordTimeout()
+	}
+	s.AggregateNacks(packet, pitEntry)
+}
+
+// BeforeSatisfyInterest records the RTT of the upstream face.
+func (s *Asf) BeforeSatisfyInterest(pitEntry table.PitEntry, inFace uint64) {
+	s.recordData(pitEntry, inFace)
+}
+
+// BeforeExpirePendingInterest records a timeout for every upstream face that
+// neither returned Data nor a Nack before the Interest expired.
+func (s *Asf) BeforeExpirePendingInterest(pitEntry table.PitEntry) {
+	entry := s.Measurements().FindExactMatch(asfNamespaceOf(pitEntry.EncName()))
+	if entry == nil {
+		return
+	}
+	for faceID, outRecord := range pitEntry.OutRecords() {
+		s.recordTimeout(entry, faceID, outRecord)
+	}
+}
+
+// namespaceInfo returns the ASF information of a Measurements entry, creating it if needed.
+func (s *Asf) namespaceInfo(entry *table.MeasurementsEntry, now time.Time) *asfNamespaceInfo {
+	if info, ok := entry.StrategyInfo(s.logName).(*asfNamespaceInfo); ok {
+		return info
+	}
+	info := &asfNamespaceInfo{
+		probingDue: now.Add(rand.N(AsfFirstProbeDelay)),
+	}
+	entry.SetStrategyInfo(s.logName, info)
+	return info
+}
+
+// recordData records a successful retrieval from the upstream face.
+func (s *Asf) recordData(pitEntry table.PitEntry, inFace uint64) {
+	outRecord := pitEntry.OutRecords()[inFace]
+	if outRecord == nil {
+		return
+	}
+
+	entry := s.Measurements().FindExactMatch(asfNamespaceOf(pitEntry.EncName()))
+	if entry == nil {
+		return
+	}
+
+	rtt := time.Since(outRecord.LatestTimestamp)
+	fi := entry.Face(inFace)
+	fi.RecordRtt(rtt)
+	entry.LastWorkingFace = inFace
+
+	core.Log.Trace(s, "RTT measurement", "name", entry.Name(), "faceid", inFace, "rtt", rtt, "srtt", fi.Rtt.Srtt())
+}
+
+// recordTimeout records a timeout of the upstream face of an out-record.
+// Each Interest is counted once, and Nacked out-records were already
+// recorded on reception of the Nack.
+func (s *Asf) recordTimeout(entry *table.MeasurementsEntry, faceID uint64, outRecord *table.PitOutRecord) {
+	if outRecord.NackReason.IsSet() || outRecord.TimedOut {
+		return
+	}
+	outRecord.TimedOut = true
+
+	fi := entry.Face(faceID)
+	fi.RecordTimeout()
+	core.Log.Trace(s, "Timeout", "name", entry.Name(), "faceid", faceID, "timeouts", fi.NConsecutiveTimeouts)
+}
+
+// rankNexthops sorts nexthops from most to least preferred. Faces with RTT
+// measurements come first ordered by SRTT, followed by faces that were not
+// measured yet and faces that timed out, both ordered by cost.
+func (s *Asf) rankNexthops(entry *table.MeasurementsEntry, nexthops []*table.FibNextHopEntry) []*table.FibNextHopEntry {
+	class := func(nh *table.FibNextHopEntry) int {
+		fi := entry.FindFace(nh.Nexthop)
+		switch {
+		case fi != nil && asfIsTimedOut(fi):
+			return 2
+		case fi == nil || !fi.Rtt.HasMeasurement():
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	ranked := make([]*table.FibNextHopEntry, len(nexthops))
+	copy(ranked, nexthops)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		ci, cj := class(ranked[i]), class(ranked[j])
+		if ci != cj {
+			return ci < cj
+		}
+		if ci == 0 {
+			return entry.FindFace(ranked[i].Nexthop).Rtt.Srtt() < entry.FindFace(ranked[j].Nexthop).Rtt.Srtt()
+		}
+		return ranked[i].Cost < ranked[j].Cost
+	})
+	return ranked
+}
+
+// selectProbe selects an alternative nexthop to probe. Faces without
+// measurements are probed first; otherwise a face is chosen at random
+// with a probability that decreases with its rank.
+func (s *Asf) selectProbe(
+	entry *table.MeasurementsEntry,
+	ranked []*table.FibNextHopEntry,
+	exclude *table.FibNextHopEntry,
+) *table.FibNextHopEntry {
+	candidates := make([]*table.FibNextHopEntry, 0, len(ranked)-1)
+	for _, nh := range ranked {
+		if nh == exclude {
+			continue
+		}
+		if fi := entry.FindFace(nh.Nexthop); fi == nil || (!asfIsTimedOut(fi) && !fi.Rtt.HasMeasurement()) {
+			return nh
+		}
+		candidates = append(candidates, nh)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// Rank i (0-based) among n candidates has weight n-i
+	n := len(candidates)
+	pick := rand.IntN(n * (n + 1) / 2)
+	for i, nh := range candidates {
+		pick -= n - i
+		if pick < 0 {
+			return nh
+		}
+	}
+	return candidates[n-1]
+}
+
+// asfIsTimedOut returns whether a face is considered timed out.
+func asfIsTimedOut(fi *table.MeasurementsFaceInfo) bool {
+	return fi.NConsecutiveTimeouts > AsfMaxSilentTimeouts
+}
+
+// asfNamespaceOf returns the namespace used to aggregate measurements for a name.
+// The last component is usually a segment or version and is not significant.
+func asfNamespaceOf(name enc.Name) enc.Name {
+	if len(name) <= 1 {
+		return name
+	}
+	return name.Prefix(-1)
+}
@@ -0,0 +1,184 @@
+package fw
+
+import (
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var asfStrategyName = defn.STRATEGY_PREFIX.
+	Append(enc.NewGenericComponent("asf")).
+	Append(enc.NewVersionComponent(1))
+
+func TestAsfRankNexthops(t *testing.T) {
+	entry := table.NewMeasurementsTable().Get(enc.Name{enc.NewGenericComponent("asf")})
+	entry.Face(1).RecordRtt(50 * time.Millisecond)
+	entry.Face(2).RecordRtt(10 * time.Millisecond)
+	entry.Face(4).RecordTimeout()
+	entry.Face(6).RecordRtt(5 * time.Millisecond)
+	entry.Face(6).RecordTimeout()
+
+	nexthops := []*table.FibNextHopEntry{
+		{Nexthop: 1, Cost: 1},
+		{Nexthop: 2, Cost: 50},
+		{Nexthop: 3, Cost: 20},
+		{Nexthop: 4, Cost: 1},
+		{Nexthop: 5, Cost: 10},
+		{Nexthop: 6, Cost: 30},
+	}
+	ranked := (&Asf{}).rankNexthops(entry, nexthops)
+
+	// Measured faces by SRTT, then unmeasured and timed out faces by cost
+	order := make([]uint64, 0, len(ranked))
+	for _, nh := range ranked {
+		order = append(order, nh.Nexthop)
+	}
+	assert.Equal(t, []uint64{2, 1, 5, 3, 4, 6}, order)
+
+	// A face that returns Data is no longer timed out
+	entry.Face(4).RecordRtt(time.Millisecond)
+	assert.Equal(t, uint64(4), (&Asf{}).rankNexthops(entry, nexthops)[0].Nexthop)
+}
+
+func TestAsfSelectProbe(t *testing.T) {
+	s := &Asf{}
+	entry := table.NewMeasurementsTable().Get(enc.Name{enc.NewGenericComponent("asf")})
+	entry.Face(1).RecordRtt(10 * time.Millisecond)
+	entry.Face(2).RecordRtt(20 * time.Millisecond)
+	entry.Face(3).RecordRtt(30 * time.Millisecond)
+	entry.Face(4).RecordRtt(40 * time.Millisecond)
+	entry.Face(5).RecordTimeout()
+
+	nexthops := []*table.FibNextHopEntry{
+		{Nexthop: 1, Cost: 1},
+		{Nexthop: 2, Cost: 1},
+		{Nexthop: 3, Cost: 1},
+		{Nexthop: 4, Cost: 1},
+	}
+
+	// Only one nexthop, nothing to probe
+	assert.Nil(t, s.selectProbe(entry, nexthops[:1], nexthops[0]))
+
+	// Unmeasured faces are probed first
+	unmeasured := &table.FibNextHopEntry{Nexthop: 9, Cost: 100}
+	ranked := s.rankNexthops(entry, append(nexthops, unmeasured))
+	for range 100 {
+		assert.Equal(t, unmeasured, s.selectProbe(entry, ranked, ranked[0]))
+	}
+
+	// Otherwise, better ranked faces are more likely to be probed,
+	// and the face used to forward the Interest is never probed
+	ranked = s.rankNexthops(entry, append(nexthops, &table.FibNextHopEntry{Nexthop: 5, Cost: 1}))
+	counts := make(map[uint64]int)
+	for range 10000 {
+		probe := s.selectProbe(entry, ranked, ranked[0])
+		require.NotNil(t, probe)
+		counts[probe.Nexthop]++
+	}
+	assert.Zero(t, counts[1])
+	assert.Greater(t, counts[2], counts[3])
+	assert.Greater(t, counts[3], counts[4])
+	assert.Greater(t, counts[4], counts[5])
+	assert.Positive(t, counts[5])
+}
+
+func TestAsfProbing(t *testing.T) {
+	thread := NewThread(0, testFwd)
+	faces := newTestFaces(t, 3, defn.PointToPoint)
+	prefix := testPrefix(t, asfStrategyName)
+	testFwd.Fib.InsertNextHopEnc(prefix, faces[1].id, 1)
+	testFwd.Fib.InsertNextHopEnc(prefix, faces[2].id, 2)
+
+	// Probing is due immediately
+	ns := prefix.Append(enc.NewGenericComponent("obj"))
+	entry := thread.measurements.Get(ns)
+	entry.SetStrategyInfo("asf", &asfNamespaceInfo{probingDue: time.Now()})
+
+	// Interest is sent to the best face, and probed on the other
+	thread.processIncomingInterest(makeInterestPkt(ns.Append(enc.NewSegmentComponent(0)), time.Second, faces[0].id))
+	assert.Equal(t, 1, faces[1].nInterests())
+	assert.Equal(t, 1, faces[2].nInterests())
+
+	// The next probe is due after the probing interval
+	info := entry.StrategyInfo("asf").(*asfNamespaceInfo)
+	assert.WithinDuration(t, time.Now().Add(AsfProbingInterval), info.probingDue, time.Second)
+	thread.processIncomingInterest(makeInterestPkt(ns.Append(enc.NewSegmentComponent(1)), time.Second, faces[0].id))
+	assert.Equal(t, 2, faces[1].nInterests())
+	assert.Equal(t, 1, faces[2].nInterests())
+}
+
+func TestAsfMeasurements(t *testing.T) {
+	thread := NewThread(0, testFwd)
+	faces := newTestFaces(t, 3, defn.PointToPoint)
+	prefix := testPrefix(t, asfStrategyName)
+	testFwd.Fib.InsertNextHopEnc(prefix, faces[1].id, 1)
+	testFwd.Fib.InsertNextHopEnc(prefix, faces[2].id, 2)
+
+	ns := prefix.Append(enc.NewGenericComponent("obj"))
+	entry := thread.measurements.Get(ns)
+	entry.SetStrategyInfo("asf", &asfNamespaceInfo{probingDue: time.Now().Add(time.Hour)})
+
+	// Data records the RTT of the upstream face
+	name := ns.Append(enc.NewSegmentComponent(0))
+	thread.processIncomingInterest(makeInterestPkt(name, 20*time.Millisecond, faces[0].id))
+	require.Equal(t, 1, faces[1].nInterests())
+	thread.processIncomingData(makeDataPkt(name, faces[1].id))
+	assert.Len(t, faces[0].sent, 1)
+	assert.True(t, entry.FindFace(faces[1].id).Rtt.HasMeasurement())
+	assert.Equal(t, faces[1].id, entry.LastWorkingFace)
+
+	// Satisfied Interests are not timeouts when the PIT entry expires
+	time.Sleep(30 * time.Millisecond)
+	thread.pitCS.Update()
+	assert.Zero(t, entry.FindFace(faces[1].id).NTimeouts)
+
+	// Unsatisfied Interests are timeouts when the PIT entry expires,
+	// without waiting for the Interest to be retransmitted
+	name = ns.Append(enc.NewSegmentComponent(1))
+	thread.processIncomingInterest(makeInterestPkt(name, 20*time.Millisecond, faces[0].id))
+	require.Equal(t, 2, faces[1].nInterests())
+	time.Sleep(30 * time.Millisecond)
+	thread.pitCS.Update()
+	assert.Equal(t, uint64(1), entry.FindFace(faces[1].id).NTimeouts)
+	assert.Equal(t, 0, thread.pitCS.PitSize())
+
+	// The timed out face is ranked last, so the next Interest uses the other face
+	thread.processIncomingInterest(makeInterestPkt(ns.Append(enc.NewSegmentComponent(2)), time.Second, faces[0].id))
+	assert.Equal(t, 2, faces[1].nInterests())
+	assert.Equal(t, 1, faces[2].nInterests())
+}
+
+func TestAsfRetransmission(t *testing.T) {
+	thread := NewThread(0, testFwd)
+	faces := newTestFaces(t, 3, defn.PointToPoint)
+	prefix := testPrefix(t, asfStrategyName)
+	testFwd.Fib.InsertNextHopEnc(prefix, faces[1].id, 1)
+	testFwd.Fib.InsertNextHopEnc(prefix, faces[2].id, 2)
+
+	ns := prefix.Append(enc.NewGenericComponent("obj"))
+	entry := thread.measurements.Get(ns)
+	entry.SetStrategyInfo("asf", &asfNamespaceInfo{probingDue: time.Now().Add(time.Hour)})
+
+	// A retransmission is forwarded to the next face without a pending Interest
+	name := ns.Append(enc.NewSegmentComponent(0))
+	thread.processIncomingInterest(makeInterestPkt(name, time.Second, faces[0].id))
+	thread.processIncomingInterest(makeInterestPkt(name, time.Second, faces[0].id))
+	assert.Equal(t, 1, faces[1].nInterests())
+	assert.Equal(t, 1, faces[2].nInterests())
+
+	// Once all faces have a pending Interest, retransmissions are dropped, not Nacked
+	thread.processIncomingInterest(makeInterestPkt(name, time.Second, faces[0].id))
+	assert.Equal(t, 1, faces[1].nInterests())
+	assert.Equal(t, 1, faces[2].nInterests())
+	assert.Empty(t, faces[0].sent)
+
+	// The pending Interests still bring back Data
+	thread.processIncomingData(makeDataPkt(name, faces[2].id))
+	assert.Len(t, faces[0].sent, 1)
+	assert.NotNil(t, faces[0].sent[0].Pkt.L3.Data)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/named-data/ndnd/fw/core"
 	"github.com/named-data/ndnd/fw/defn"
 	"github.com/named-data/ndnd/fw/table"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
 )
 
 // BestRouteSuppressionTime is the time to suppress retransmissions of the same Interest.
@@ -25,9 +26,9 @@ type BestRoute struct {
 	StrategyBase
 }
 
-// (AI GENERATED DESCRIPTION): Registers the BestRoute strategy with version 1 in the strategy registry by appending its constructor to the init list.
+// Registers the best-route strategy (version 1) in the strategy registry.
 func init() {
-	strategyInit = append(strategyInit, func() Strategy { return &BestRoute{} })
+	strategyInit["best-route"] = func() Strategy { return &BestRoute{} }
 	StrategyVersions["best-route"] = []uint64{1}
 }
 
@@ -67,7 +68,8 @@ func (s *BestRoute) AfterReceiveInterest(
 	nexthops []*table.FibNextHopEntry,
 ) {
 	if len(nexthops) == 0 {
-		core.Log.Debug(s, "No nexthop found - DROP", "name", packet.Name)
+		core.Log.Debug(s, "No nexthop found - NACK", "name", packet.Name)
+		s.SendNack(packet, pitEntry, inFace, spec.NackReasonNoRoute)
 		return
 	}
 
@@ -102,10 +104,25 @@ func (s *BestRoute) AfterReceiveInterest(
 		}
 	}
 
-	core.Log.Debug(s, "No usable nexthop for Interest - DROP", "name", packet.Name)
+	core.Log.Debug(s, "No usable nexthop for Interest - NACK", "name", packet.Name)
+	s.SendNack(packet, pitEntry, inFace, spec.NackReasonNoRoute)
+}
+
+// AfterReceiveNack returns the Nack downstream once all upstreams have replied with a Nack.
+func (s *BestRoute) AfterReceiveNack(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	core.Log.Trace(s, "AfterReceiveNack", "name", packet.Name, "faceid", inFace, "reason", packet.NackReason.Unwrap())
+	s.AggregateNacks(packet, pitEntry)
 }
 
 // (AI GENERATED DESCRIPTION): No‑op; the BestRoute strategy performs no action before satisfying an Interest.
 func (s *BestRoute) BeforeSatisfyInterest(pitEntry table.PitEntry, inFace uint64) {
 	// This does nothing in BestRoute
 }
+
+// BeforeExpirePendingInterest does nothing in the best route strategy.
+func (s *BestRoute) BeforeExpirePendingInterest(pitEntry table.PitEntry) {
+}
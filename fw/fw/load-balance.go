@@ -0,0 +1,274 @@
+package fw
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/table"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+)
+
+// LoadBalanceSuppressionTime is the time to suppress retransmissions of the same Interest.
+const LoadBalanceSuppressionTime = 400 * time.Millisecond
+
+// loadBalanceMaxGroups is the maximum number of nexthop groups with round-robin state.
+const loadBalanceMaxGroups = 1024
+
+// LoadBalance is a forwarding strategy that spreads Interests over all nexthops
+// with a smooth weighted round-robin. The weight of a nexthop is inversely
+// proportional to its cost, so equal-cost nexthops receive the same share.
+//
+// Parameters:
+//   - sticky~N: forward all Interests of a flow to the same nexthop, where flows
+//     are identified by the first N components of the name (default 0, disabled).
+type LoadBalance struct {
+	StrategyBase
+	params loadBalanceParams
+	// Round-robin state of each group of nexthops, by face
+	groups map[uint64]map[uint64]float64
+}
+
+// Registers the load-balance strategy (version 1) in the strategy registry.
+func init() {
+	strategyInit["load-balance"] = func() Strategy { return &LoadBalance{} }
+	StrategyVersions["load-balance"] = []uint64{1}
+}
+
+// Instantiate initializes the load-balance strategy for the given forwarding thread.
+func (s *LoadBalance) Instantiate(fwThread *Thread) {
+	s.NewStrategyBase(fwThread, "load-balance", 1)
+	s.groups = make(map[uint64]map[uint64]float64)
+}
+
+// SetParameters configures the load-balance strategy.
+func (s *LoadBalance) SetParameters(params StrategyParameters) error {
+	return s.params.set(params)
+}
+
+// AfterContentStoreHit sends the cached Data back to the requesting face.
+func (s *LoadBalance) AfterContentStoreHit(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	core.Log.Trace(s, "AfterContentStoreHit", "name", packet.Name, "faceid", inFace)
+	s.SendData(packet, pitEntry, inFace, 0) // 0 indicates ContentStore is source
+}
+
+// AfterReceiveData forwards the Data to all downstream faces in the PIT entry.
+func (s *LoadBalance) AfterReceiveData(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	core.Log.Trace(s, "AfterReceiveData", "name", packet.Name, "inrecords", len(pitEntry.InRecords()))
+	for faceID := range pitEntry.InRecords() {
+		core.Log.Trace(s, "Forwarding Data", "name", packet.Name, "faceid", faceID)
+		s.SendData(packet, pitEntry, faceID, inFace)
+	}
+}
+
+// AfterReceiveInterest forwards the Interest to the next nexthop in the weighted
+// round-robin, or to the nexthop of its flow in sticky mode.
+func (s *LoadBalance) AfterReceiveInterest(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+	nexthops []*table.FibNextHopEntry,
+) {
+	var ranked []*table.FibNextHopEntry
+	if s.params.sticky > 0 {
+		ranked = s.params.rankFlow(packet, nexthops)
+	} else {
+		ranked = s.rankRoundRobin(nexthops)
+	}
+	forwardRanked(&s.StrategyBase, packet, pitEntry, inFace, ranked)
+}
+
+// AfterReceiveNack returns the Nack downstream once all upstreams have replied with a Nack.
+func (s *LoadBalance) AfterReceiveNack(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	core.Log.Trace(s, "AfterReceiveNack", "name", packet.Name, "faceid", inFace, "reason", packet.NackReason.Unwrap())
+	s.AggregateNacks(packet, pitEntry)
+}
+
+// BeforeSatisfyInterest does nothing in the load-balance strategy.
+func (s *LoadBalance) BeforeSatisfyInterest(pitEntry table.PitEntry, inFace uint64) {
+	// This does nothing in LoadBalance
+}
+
+// BeforeExpirePendingInterest does nothing in the load-balance strategy.
+func (s *LoadBalance) BeforeExpirePendingInterest(pitEntry table.PitEntry) {
+}
+
+// rankRoundRobin selects the next nexthop of the smooth weighted round-robin of the
+// group of nexthops, followed by the other nexthops by decreasing weight.
+func (s *LoadBalance) rankRoundRobin(nexthops []*table.FibNextHopEntry) []*table.FibNextHopEntry {
+	ranked := rankByWeight(nexthops)
+	if len(ranked) <= 1 {
+		return ranked
+	}
+
+	// The group is identified by its faces, in any order
+	group := uint64(0)
+	for _, nh := range nexthops {
+		group += mix64(nh.Nexthop)
+	}
+	current, ok := s.groups[group]
+	if !ok {
+		if len(s.groups) >= loadBalanceMaxGroups {
+			clear(s.groups)
+		}
+		current = make(map[uint64]float64, len(nexthops))
+		s.groups[group] = current
+	}
+
+	// Each nexthop gains its weight, and the selected one loses the total weight
+	best, total := 0, 0.0
+	for i, nh := range ranked {
+		w := nexthopWeight(nh)
+		current[nh.Nexthop] += w
+		total += w
+		if current[nh.Nexthop] > current[ranked[best].Nexthop] {
+			best = i
+		}
+	}
+	current[ranked[best].Nexthop] -= total
+
+	selected := ranked[best]
+	copy(ranked[1:best+1], ranked[:best])
+	ranked[0] = selected
+	return ranked
+}
+
+// loadBalanceParams are the parameters of the load-balancing strategies.
+type loadBalanceParams struct {
+	// Number of name components identifying a flow, or 0 to balance each Interest
+	sticky int
+}
+
+// set parses the parameters of a load-balancing strategy.
+func (p *loadBalanceParams) set(params StrategyParameters) error {
+	for key, value := range params {
+		switch key {
+		case "sticky":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				return fmt.Errorf("invalid value of sticky: %s", value)
+			}
+			p.sticky = n
+		default:
+			return fmt.Errorf("unknown parameter %s", key)
+		}
+	}
+	return nil
+}
+
+// rankFlow ranks the nexthops for the flow of an Interest with weighted rendezvous
+// hashing, so that the flow keeps its nexthop while the nexthop is available, and
+// flows are spread over the nexthops in proportion to their weights.
+func (p *loadBalanceParams) rankFlow(packet *defn.Pkt, nexthops []*table.FibNextHopEntry) []*table.FibNextHopEntry {
+	flow := packet.Name[:min(p.sticky, len(packet.Name))].Hash()
+	return rankWeightedSample(nexthops, func(nh *table.FibNextHopEntry) float64 {
+		// Uniform in (0, 1) from the flow and the face
+		return (float64(mix64(flow^mix64(nh.Nexthop))>>11) + 0.5) / (1 << 53)
+	})
+}
+
+// forwardRanked sends the Interest to the first usable nexthop of the ranking,
+// preferring nexthops that were not tried yet for a retransmission.
+// A Nack is returned if no nexthop is usable.
+func forwardRanked(
+	s *StrategyBase,
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+	ranked []*table.FibNextHopEntry,
+) {
+	if len(ranked) == 0 {
+		core.Log.Debug(s, "No nexthop found - NACK", "name", packet.Name)
+		s.SendNack(packet, pitEntry, inFace, spec.NackReasonNoRoute)
+		return
+	}
+
+	// Suppress retransmissions of the same Interest within suppression time
+	now := time.Now()
+	for _, oR := range pitEntry.OutRecords() {
+		if oR.LatestTimestamp.Add(LoadBalanceSuppressionTime).After(now) {
+			core.Log.Debug(s, "Suppressed Interest - DROP", "name", packet.Name)
+			return
+		}
+	}
+
+	for pass := range 2 {
+		for _, nh := range ranked {
+			// In the first pass, skip hops that already have a out record,
+			// which are retried in the second pass
+			if hasOutRecord := pitEntry.OutRecords()[nh.Nexthop] != nil; hasOutRecord != (pass == 1) {
+				continue
+			}
+
+			core.Log.Trace(s, "Forwarding Interest", "name", packet.Name, "faceid", nh.Nexthop)
+			if sent := s.SendInterest(packet, pitEntry, nh.Nexthop, inFace); sent {
+				return
+			}
+		}
+	}
+
+	core.Log.Debug(s, "No usable nexthop for Interest - NACK", "name", packet.Name)
+	s.SendNack(packet, pitEntry, inFace, spec.NackReasonNoRoute)
+}
+
+// nexthopWeight returns the weight of a nexthop, which is inversely proportional
+// to its cost. A cost of zero has the same weight as a cost of one.
+func nexthopWeight(nh *table.FibNextHopEntry) float64 {
+	return 1 / float64(max(nh.Cost, 1))
+}
+
+// rankByWeight returns the nexthops by decreasing weight, i.e. increasing cost.
+func rankByWeight(nexthops []*table.FibNextHopEntry) []*table.FibNextHopEntry {
+	ranked := slices.Clone(nexthops)
+	slices.SortStableFunc(ranked, func(a, b *table.FibNextHopEntry) int {
+		return cmp.Compare(a.Cost, b.Cost)
+	})
+	return ranked
+}
+
+// rankWeightedSample orders the nexthops as a weighted sample without replacement,
+// given a uniform value in (0, 1) for each nexthop. The first nexthop is selected
+// with a probability proportional to its weight.
+func rankWeightedSample(
+	nexthops []*table.FibNextHopEntry,
+	uniform func(*table.FibNextHopEntry) float64,
+) []*table.FibNextHopEntry {
+	keys := make(map[uint64]float64, len(nexthops))
+	for _, nh := range nexthops {
+		// Efraimidis-Spirakis key u^(1/w), compared in the log domain
+		keys[nh.Nexthop] = math.Log(uniform(nh)) / nexthopWeight(nh)
+	}
+
+	ranked := slices.Clone(nexthops)
+	slices.SortStableFunc(ranked, func(a, b *table.FibNextHopEntry) int {
+		return cmp.Compare(keys[b.Nexthop], keys[a.Nexthop])
+	})
+	return ranked
+}
+
+// mix64 is the finalizer of SplitMix64, which scrambles the bits of x.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,116 @@
+package fw
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/stretchr/testify/assert"
+)
+
+// flowPkt returns an Interest of a flow of the load-balancing strategies.
+func flowPkt(flow int, seq int) *defn.Pkt {
+	name := enc.Name{
+		enc.NewGenericComponent("flow"),
+		enc.NewNumberComponent(enc.TypeGenericNameComponent, uint64(flow)),
+		enc.NewSegmentComponent(uint64(seq)),
+	}
+	return &defn.Pkt{Name: name}
+}
+
+func TestLoadBalanceWeights(t *testing.T) {
+	tests := []struct {
+		desc   string
+		costs  []uint64
+		shares []int
+	}{
+		{"equal costs", []uint64{10, 10, 10}, []int{100, 100, 100}},
+		{"zero cost", []uint64{0, 1}, []int{150, 150}},
+		{"inverse of cost", []uint64{1, 2, 4}, []int{171, 86, 43}},
+		{"single", []uint64{5}, []int{300}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			s := &LoadBalance{groups: make(map[uint64]map[uint64]float64)}
+			nexthops := make([]*table.FibNextHopEntry, len(test.costs))
+			for i, cost := range test.costs {
+				nexthops[i] = &table.FibNextHopEntry{Nexthop: uint64(i + 1), Cost: cost}
+			}
+
+			// Each nexthop is selected in proportion to its weight
+			counts := make([]int, len(nexthops))
+			for range 300 {
+				ranked := s.rankRoundRobin(nexthops)
+				assert.Len(t, ranked, len(nexthops))
+				counts[ranked[0].Nexthop-1]++
+			}
+			for i, share := range test.shares {
+				assert.InDelta(t, share, counts[i], 1, "nexthop %d", i+1)
+			}
+		})
+	}
+}
+
+func TestLoadBalanceSmoothRoundRobin(t *testing.T) {
+	s := &LoadBalance{groups: make(map[uint64]map[uint64]float64)}
+	nexthops := []*table.FibNextHopEntry{
+		{Nexthop: 1, Cost: 1},
+		{Nexthop: 2, Cost: 2},
+	}
+
+	// The lighter nexthop gets every third Interest, and is never selected twice in a row
+	order := make([]uint64, 0, 30)
+	for range 30 {
+		order = append(order, s.rankRoundRobin(nexthops)[0].Nexthop)
+	}
+	counts := make(map[uint64]int)
+	for _, nexthop := range order {
+		counts[nexthop]++
+	}
+	assert.Equal(t, map[uint64]int{1: 20, 2: 10}, counts)
+	for i := 1; i < len(order); i++ {
+		assert.False(t, order[i] == 2 && order[i-1] == 2, "order %v", order)
+	}
+
+	// Other groups of nexthops have their own state
+	other := []*table.FibNextHopEntry{{Nexthop: 3, Cost: 1}, {Nexthop: 4, Cost: 1}}
+	assert.Equal(t, uint64(3), s.rankRoundRobin(other)[0].Nexthop)
+	assert.Len(t, s.groups, 2)
+}
+
+func TestLoadBalanceSticky(t *testing.T) {
+	p := loadBalanceParams{sticky: 2}
+	nexthops := []*table.FibNextHopEntry{
+		{Nexthop: 1, Cost: 1},
+		{Nexthop: 2, Cost: 1},
+		{Nexthop: 3, Cost: 2},
+	}
+
+	counts := make(map[uint64]int)
+	for flow := range 3000 {
+		// All Interests of a flow use the same nexthop
+		selected := p.rankFlow(flowPkt(flow, 0), nexthops)[0].Nexthop
+		for seq := 1; seq < 3; seq++ {
+			assert.Equal(t, selected, p.rankFlow(flowPkt(flow, seq), nexthops)[0].Nexthop)
+		}
+		counts[selected]++
+
+		// Flows keep their nexthop when another nexthop is removed
+		removed := nexthops[0].Nexthop
+		if removed == selected {
+			removed = nexthops[1].Nexthop
+		}
+		remaining := slices.DeleteFunc(slices.Clone(nexthops), func(nh *table.FibNextHopEntry) bool {
+			return nh.Nexthop == removed
+		})
+		assert.Equal(t, selected, p.rankFlow(flowPkt(flow, 0), remaining)[0].Nexthop)
+	}
+
+	// Flows are spread in proportion to the weights of the nexthops
+	assert.InDelta(t, 1200, counts[1], 150)
+	assert.InDelta(t, 1200, counts[2], 150)
+	assert.InDelta(t, 600, counts[3], 150)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/named-data/ndnd/fw/core"
 	"github.com/named-data/ndnd/fw/defn"
 	"github.com/named-data/ndnd/fw/table"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
 )
 
 // MulticastSuppressionTime is the time to suppress retransmissions of the same Interest.
@@ -23,9 +24,9 @@ type Multicast struct {
 	StrategyBase
 }
 
-// (AI GENERATED DESCRIPTION): Registers the Multicast strategy by appending its constructor to the global strategy initialization list and associating the version “1” with the “multicast” key.
+// Registers the multicast strategy (version 1) in the strategy registry.
 func init() {
-	strategyInit = append(strategyInit, func() Strategy { return &Multicast{} })
+	strategyInit["multicast"] = func() Strategy { return &Multicast{} }
 	StrategyVersions["multicast"] = []uint64{1}
 }
 
@@ -65,7 +66,8 @@ func (s *Multicast) AfterReceiveInterest(
 	nexthops []*table.FibNextHopEntry,
 ) {
 	if len(nexthops) == 0 {
-		core.Log.Debug(s, "No nexthop for Interest", "name", packet.Name)
+		core.Log.Debug(s, "No nexthop for Interest - NACK", "name", packet.Name)
+		s.SendNack(packet, pitEntry, inFace, spec.NackReasonNoRoute)
 		return
 	}
 
@@ -87,7 +89,21 @@ func (s *Multicast) AfterReceiveInterest(
 	}
 }
 
+// AfterReceiveNack returns the Nack downstream once all upstreams have replied with a Nack.
+func (s *Multicast) AfterReceiveNack(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	core.Log.Trace(s, "AfterReceiveNack", "name", packet.Name, "faceid", inFace, "reason", packet.NackReason.Unwrap())
+	s.AggregateNacks(packet, pitEntry)
+}
+
 // (AI GENERATED DESCRIPTION): No‑op hook invoked before satisfying an Interest in the Multicast strategy – it performs no action.
 func (s *Multicast) BeforeSatisfyInterest(pitEntry table.PitEntry, inFace uint64) {
 	// This does nothing in Multicast
 }
+
+// BeforeExpirePendingInterest does nothing in the multicast strategy.
+func (s *Multicast) BeforeExpirePendingInterest(pitEntry table.PitEntry) {
+}
@@ -0,0 +1,98 @@
+package fw
+
+import (
+	"math/rand/v2"
+
+	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/table"
+)
+
+// Random is a forwarding strategy that forwards each Interest to a nexthop chosen
+// at random. The probability of a nexthop is inversely proportional to its cost,
+// so equal-cost nexthops are chosen with the same probability.
+//
+// Parameters:
+//   - sticky~N: forward all Interests of a flow to the same nexthop, where flows
+//     are identified by the first N components of the name (default 0, disabled).
+type Random struct {
+	StrategyBase
+	params loadBalanceParams
+}
+
+// Registers the random strategy (version 1) in the strategy registry.
+func init() {
+	strategyInit["random"] = func() Strategy { return &Random{} }
+	StrategyVersions["random"] = []uint64{1}
+}
+
+// Instantiate initializes the random strategy for the given forwarding thread.
+func (s *Random) Instantiate(fwThread *Thread) {
+	s.NewStrategyBase(fwThread, "random", 1)
+}
+
+// SetParameters configures the random strategy.
+func (s *Random) SetParameters(params StrategyParameters) error {
+	return s.params.set(params)
+}
+
+// AfterContentStoreHit sends the cached Data back to the requesting face.
+func (s *Random) AfterContentStoreHit(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	core.Log.Trace(s, "AfterContentStoreHit", "name", packet.Name, "faceid", inFace)
+	s.SendData(packet, pitEntry, inFace, 0) // 0 indicates ContentStore is source
+}
+
+// AfterReceiveData forwards the Data to all downstream faces in the PIT entry.
+func (s *Random) AfterReceiveData(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	core.Log.Trace(s, "AfterReceiveData", "name", packet.Name, "inrecords", len(pitEntry.InRecords()))
+	for faceID := range pitEntry.InRecords() {
+		core.Log.Trace(s, "Forwarding Data", "name", packet.Name, "faceid", faceID)
+		s.SendData(packet, pitEntry, faceID, inFace)
+	}
+}
+
+// AfterReceiveInterest forwards the Interest to a random nexthop,
+// or to the nexthop of its flow in sticky mode.
+func (s *Random) AfterReceiveInterest(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+	nexthops []*table.FibNextHopEntry,
+) {
+	var ranked []*table.FibNextHopEntry
+	if s.params.sticky > 0 {
+		ranked = s.params.rankFlow(packet, nexthops)
+	} else {
+		ranked = rankWeightedSample(nexthops, func(*table.FibNextHopEntry) float64 {
+			return 1 - rand.Float64() // in (0, 1]
+		})
+	}
+	forwardRanked(&s.StrategyBase, packet, pitEntry, inFace, ranked)
+}
+
+// AfterReceiveNack returns the Nack downstream once all upstreams have replied with a Nack.
+func (s *Random) AfterReceiveNack(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	core.Log.Trace(s, "AfterReceiveNack", "name", packet.Name, "faceid", inFace, "reason", packet.NackReason.Unwrap())
+	s.AggregateNacks(packet, pitEntry)
+}
+
+// BeforeSatisfyInterest does nothing in the random strategy.
+func (s *Random) BeforeSatisfyInterest(pitEntry table.PitEntry, inFace uint64) {
+	// This does nothing in Random
+}
+
+// BeforeExpirePendingInterest does nothing in the random strategy.
+func (s *Random) BeforeExpirePendingInterest(pitEntry table.PitEntry) {
+}
@@ -0,0 +1,212 @@
+package fw
+
+import (
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/dispatch"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+)
+
+// SelfLearningSuppressionTime is the time to suppress retransmissions of the same Interest.
+const SelfLearningSuppressionTime = 500 * time.Millisecond
+
+// SelfLearningRoutes manages the routes learned by the self-learning strategy.
+// It is called from the forwarding threads, so it must not block.
+type SelfLearningRoutes interface {
+	// Learn creates a route to the face from a Prefix Announcement received with Data.
+	Learn(prefixAnn enc.Wire, faceID uint64)
+	// Renew extends the lifetime of the learned route of the name to the face.
+	Renew(name enc.Name, faceID uint64)
+	// Forget removes the learned route of the name to the face.
+	Forget(name enc.Name, faceID uint64)
+}
+
+// SetSelfLearningRoutes sets the handler of the routes learned by the self-learning strategy.
+// It is set by the management thread before the forwarding thread runs.
+func (t *Thread) SetSelfLearningRoutes(r SelfLearningRoutes) {
+	t.selfLearningRoutes = r
+}
+
+// SelfLearning is a forwarding strategy for networks without routing, such as
+// infrastructure-less wireless networks. Interests without a route are flooded
+// to all non-local faces as discovery Interests. The producer region answers with
+// a Prefix Announcement attached to the Data, from which each forwarder learns
+// a route to the face of the Data. Later Interests are sent to the learned routes
+// as non-discovery Interests, which are not flooded again.
+type SelfLearning struct {
+	StrategyBase
+}
+
+// Registers the self-learning strategy (version 1) in the strategy registry.
+func init() {
+	strategyInit["self-learning"] = func() Strategy { return &SelfLearning{} }
+	StrategyVersions["self-learning"] = []uint64{1}
+}
+
+// Instantiate initializes the self-learning strategy for the given forwarding thread.
+func (s *SelfLearning) Instantiate(fwThread *Thread) {
+	s.NewStrategyBase(fwThread, "self-learning", 1)
+}
+
+// AfterContentStoreHit sends the cached Data back to the requesting face.
+func (s *SelfLearning) AfterContentStoreHit(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	core.Log.Trace(s, "AfterContentStoreHit", "name", packet.Name, "faceid", inFace)
+	s.SendData(packet, pitEntry, inFace, 0) // 0 indicates ContentStore is source
+}
+
+// AfterReceiveData learns or renews the route to the upstream face, and forwards
+// the Data to all downstream faces. Downstreams of discovery Interests receive
+// the Prefix Announcement of the Data, so that they can learn the route too.
+func (s *SelfLearning) AfterReceiveData(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	core.Log.Trace(s, "AfterReceiveData", "name", packet.Name, "inrecords", len(pitEntry.InRecords()))
+
+	prefixAnn := packet.PrefixAnnouncement
+	if outRecord := pitEntry.OutRecords()[inFace]; outRecord != nil && s.learnsFrom(inFace) {
+		if !outRecord.NonDiscovery && prefixAnn != nil {
+			s.thread.selfLearningRoutes.Learn(prefixAnn, inFace)
+		} else if outRecord.NonDiscovery {
+			s.thread.selfLearningRoutes.Renew(packet.Name, inFace)
+		}
+	}
+
+	// Without announcement in the Data, use the announcement of the route to the
+	// upstream, which was learned or announced by a local producer
+	if prefixAnn == nil {
+		for _, inRecord := range pitEntry.InRecords() {
+			if !inRecord.NonDiscovery {
+				if _, pa := s.thread.fwd.Rib.FindPrefixAnn(packet.Name, inFace); pa != nil {
+					prefixAnn = enc.Wire{pa}
+				}
+				break
+			}
+		}
+	}
+
+	for faceID, inRecord := range pitEntry.InRecords() {
+		core.Log.Trace(s, "Forwarding Data", "name", packet.Name, "faceid", faceID)
+		if inRecord.NonDiscovery {
+			s.SendData(packet, pitEntry, faceID, inFace)
+		} else {
+			s.SendDataWithAnnouncement(packet, pitEntry, faceID, inFace, prefixAnn)
+		}
+	}
+}
+
+// AfterReceiveInterest forwards the Interest to all nexthops as a non-discovery
+// Interest. Without nexthops, discovery Interests are flooded, and non-discovery
+// Interests are answered with a Nack, since the route of the downstream is stale.
+func (s *SelfLearning) AfterReceiveInterest(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+	nexthops []*table.FibNextHopEntry,
+) {
+	// If there is an out record less than suppression interval ago, drop the
+	// retransmission to suppress it (only if the nonce is different)
+	now := time.Now()
+	for _, outRecord := range pitEntry.OutRecords() {
+		if outRecord.LatestNonce != packet.L3.Interest.NonceV.Unwrap() &&
+			outRecord.LatestTimestamp.Add(SelfLearningSuppressionTime).After(now) {
+			core.Log.Debug(s, "Suppressed Interest", "name", packet.Name)
+			return
+		}
+	}
+
+	sent := false
+	if len(nexthops) > 0 {
+		for _, nexthop := range nexthops {
+			core.Log.Trace(s, "Forwarding Interest", "name", packet.Name, "faceid", nexthop.Nexthop)
+			sent = s.SendDiscoveryInterest(packet, pitEntry, nexthop.Nexthop, inFace, false) || sent
+		}
+	} else if !packet.NonDiscovery {
+		sent = s.flood(packet, pitEntry, inFace)
+	}
+
+	if !sent {
+		core.Log.Debug(s, "No nexthop for Interest - NACK", "name", packet.Name)
+		s.SendNack(packet, pitEntry, inFace, spec.NackReasonNoRoute)
+	}
+}
+
+// flood sends a discovery Interest to all non-local faces, except the incoming
+// face unless it is ad hoc. Returns true if the Interest was sent on any face.
+func (s *SelfLearning) flood(packet *defn.Pkt, pitEntry table.PitEntry, inFace uint64) bool {
+	// Interests for /localhost and /localhop from other forwarders are not flooded
+	name := packet.Name
+	if name.At(0).Equal(enc.LOCALHOST) {
+		return false
+	}
+	if name.At(0).Equal(enc.LOCALHOP) {
+		if face := s.thread.fwd.GetFace(inFace); face == nil || face.Scope() != defn.Local {
+			return false
+		}
+	}
+
+	sent := false
+	s.thread.fwd.RangeFaces(func(face dispatch.Face) bool {
+		if face.Scope() != defn.NonLocal || face.State() != defn.Up || face.RemoteURI().Scheme() == "null" {
+			return true
+		}
+		if face.FaceID() == inFace && face.LinkType() != defn.AdHoc {
+			return true
+		}
+		if face.FaceID() != inFace && pitEntry.InRecords()[face.FaceID()] != nil {
+			return true
+		}
+
+		core.Log.Trace(s, "Flooding discovery Interest", "name", packet.Name, "faceid", face.FaceID())
+		sent = s.SendDiscoveryInterest(packet, pitEntry, face.FaceID(), inFace, true) || sent
+		return true
+	})
+	return sent
+}
+
+// AfterReceiveNack removes the learned route to the face when the upstream has no route
+// anymore, and returns the Nack downstream once all upstreams have replied with a Nack.
+func (s *SelfLearning) AfterReceiveNack(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	inFace uint64,
+) {
+	reason := packet.NackReason.Unwrap()
+	core.Log.Trace(s, "AfterReceiveNack", "name", packet.Name, "faceid", inFace, "reason", reason)
+
+	if reason == spec.NackReasonNoRoute && s.learnsFrom(inFace) {
+		if outRecord := pitEntry.OutRecords()[inFace]; outRecord != nil && outRecord.NonDiscovery {
+			s.thread.selfLearningRoutes.Forget(packet.Name, inFace)
+		}
+	}
+
+	s.AggregateNacks(packet, pitEntry)
+}
+
+// learnsFrom returns whether routes to the face are learned. Routes to local
+// faces are managed by the applications.
+func (s *SelfLearning) learnsFrom(faceID uint64) bool {
+	if s.thread.selfLearningRoutes == nil {
+		return false
+	}
+	face := s.thread.fwd.GetFace(faceID)
+	return face != nil && face.Scope() == defn.NonLocal
+}
+
+// BeforeSatisfyInterest does nothing in the self-learning strategy.
+func (s *SelfLearning) BeforeSatisfyInterest(pitEntry table.PitEntry, inFace uint64) {
+	// This does nothing in SelfLearning
+}
+
+// BeforeExpirePendingInterest does nothing in the self-learning strategy.
+func (s *SelfLearning) BeforeExpirePendingInterest(pitEntry table.PitEntry) {
+}
@@ -0,0 +1,151 @@
+package fw
+
+import (
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var selfLearningStrategyName = defn.STRATEGY_PREFIX.
+	Append(enc.NewGenericComponent("self-learning")).
+	Append(enc.NewVersionComponent(1))
+
+// testSelfLearningRoutes creates a route to the prefix of the test for each
+// announcement, like the management thread does after validating it.
+type testSelfLearningRoutes struct {
+	prefix   enc.Name
+	lifetime time.Duration
+	learned  []uint64
+	renewed  []uint64
+	forgot   []uint64
+}
+
+func (r *testSelfLearningRoutes) Learn(prefixAnn enc.Wire, faceID uint64) {
+	r.learned = append(r.learned, faceID)
+	testFwd.Rib.AddEncRoute(r.prefix, &table.Route{
+		FaceID:             faceID,
+		ExpirationPeriod:   &r.lifetime,
+		PrefixAnnouncement: prefixAnn.Join(),
+	})
+}
+
+func (r *testSelfLearningRoutes) Renew(name enc.Name, faceID uint64) {
+	r.renewed = append(r.renewed, faceID)
+	testFwd.Rib.RenewRoute(r.prefix, faceID, 0, r.lifetime)
+}
+
+func (r *testSelfLearningRoutes) Forget(name enc.Name, faceID uint64) {
+	r.forgot = append(r.forgot, faceID)
+	testFwd.Rib.RemoveRouteEnc(r.prefix, faceID, 0)
+}
+
+// newTestSelfLearningRoutes sets the route handler of the self-learning strategy of a thread for a test.
+func newTestSelfLearningRoutes(t *testing.T, thread *Thread, prefix enc.Name, lifetime time.Duration) *testSelfLearningRoutes {
+	routes := &testSelfLearningRoutes{prefix: prefix, lifetime: lifetime}
+	thread.SetSelfLearningRoutes(routes)
+	t.Cleanup(func() {
+		for _, faceID := range routes.learned {
+			testFwd.Rib.RemoveRouteEnc(prefix, faceID, 0)
+		}
+	})
+	return routes
+}
+
+// lastSent returns the last packet sent on the face.
+func lastSent(t *testing.T, face *testFace) *defn.Pkt {
+	require.NotEmpty(t, face.sent)
+	return face.sent[len(face.sent)-1].Pkt
+}
+
+func TestSelfLearningFloodOnMiss(t *testing.T) {
+	thread := NewThread(0, testFwd)
+	faces := newTestFaces(t, 3, defn.PointToPoint)
+	prefix := testPrefix(t, selfLearningStrategyName)
+	newTestSelfLearningRoutes(t, thread, prefix, time.Minute)
+
+	// Without a route, the Interest is flooded as a discovery Interest,
+	// except on the incoming face
+	thread.processIncomingInterest(makeInterestPkt(prefix.Append(enc.NewGenericComponent("a")), time.Second, faces[0].id))
+	assert.Zero(t, faces[0].nInterests())
+	for _, face := range faces[1:] {
+		require.Equal(t, 1, face.nInterests())
+		assert.False(t, face.sent[0].NonDiscovery)
+	}
+
+	// Non-discovery Interests are not flooded, since the route of the downstream is stale
+	interest := makeInterestPkt(prefix.Append(enc.NewGenericComponent("b")), time.Second, faces[0].id)
+	interest.NonDiscovery = true
+	thread.processIncomingInterest(interest)
+	assert.Equal(t, 1, faces[1].nInterests())
+	assert.Equal(t, 1, faces[2].nInterests())
+	assert.Equal(t, spec.NackReasonNoRoute, lastSent(t, faces[0]).NackReason.Unwrap())
+}
+
+func TestSelfLearningLearnFromData(t *testing.T) {
+	thread := NewThread(0, testFwd)
+	faces := newTestFaces(t, 3, defn.PointToPoint)
+	prefix := testPrefix(t, selfLearningStrategyName)
+	routes := newTestSelfLearningRoutes(t, thread, prefix, time.Minute)
+	prefixAnn := enc.Wire{[]byte{0x06, 0x00}}
+
+	// The route is learned from the announcement of the Data of a discovery Interest
+	name := prefix.Append(enc.NewGenericComponent("a"))
+	thread.processIncomingInterest(makeInterestPkt(name, time.Second, faces[0].id))
+	data := makeDataPkt(name, faces[1].id)
+	data.PrefixAnnouncement = prefixAnn
+	thread.processIncomingData(data)
+	assert.Equal(t, []uint64{faces[1].id}, routes.learned)
+	require.Len(t, faces[0].sent, 1)
+	assert.Equal(t, prefixAnn, faces[0].sent[0].PrefixAnnouncement)
+
+	// Later Interests only use the learned route, as non-discovery Interests
+	name = prefix.Append(enc.NewGenericComponent("b"))
+	thread.processIncomingInterest(makeInterestPkt(name, time.Second, faces[0].id))
+	require.Equal(t, 2, faces[1].nInterests())
+	assert.True(t, faces[1].sent[len(faces[1].sent)-1].NonDiscovery)
+	assert.Equal(t, 1, faces[2].nInterests())
+
+	// Their Data renews the route instead of learning it again
+	thread.processIncomingData(makeDataPkt(name, faces[1].id))
+	assert.Equal(t, []uint64{faces[1].id}, routes.learned)
+	assert.Equal(t, []uint64{faces[1].id}, routes.renewed)
+
+	// A Nack without route from the upstream removes the route
+	name = prefix.Append(enc.NewGenericComponent("c"))
+	thread.processIncomingInterest(makeInterestPkt(name, time.Second, faces[0].id))
+	require.Equal(t, 3, faces[1].nInterests())
+	nack := makeInterestPkt(name, time.Second, faces[1].id)
+	nack.L3.Interest.NonceV = lastSent(t, faces[1]).L3.Interest.NonceV
+	nack.NackReason.Set(spec.NackReasonNoRoute)
+	thread.processIncomingNack(nack)
+	assert.Equal(t, []uint64{faces[1].id}, routes.forgot)
+	assert.Empty(t, testFwd.Fib.FindNextHopsEnc(name))
+}
+
+func TestSelfLearningRouteExpiry(t *testing.T) {
+	thread := NewThread(0, testFwd)
+	faces := newTestFaces(t, 3, defn.PointToPoint)
+	prefix := testPrefix(t, selfLearningStrategyName)
+	newTestSelfLearningRoutes(t, thread, prefix, 100*time.Millisecond)
+
+	name := prefix.Append(enc.NewGenericComponent("a"))
+	thread.processIncomingInterest(makeInterestPkt(name, time.Second, faces[0].id))
+	data := makeDataPkt(name, faces[2].id)
+	data.PrefixAnnouncement = enc.Wire{[]byte{0x06, 0x00}}
+	thread.processIncomingData(data)
+	require.Len(t, testFwd.Fib.FindNextHopsEnc(name), 1)
+
+	// Once the learned route expires, Interests are flooded again
+	require.Eventually(t, func() bool {
+		return len(testFwd.Fib.FindNextHopsEnc(name)) == 0
+	}, time.Second, 10*time.Millisecond)
+	thread.processIncomingInterest(makeInterestPkt(prefix.Append(enc.NewGenericComponent("b")), time.Second, faces[0].id))
+	assert.Equal(t, 2, faces[1].nInterests())
+	assert.Equal(t, 2, faces[2].nInterests())
+}
@@ -8,16 +8,33 @@
 package fw
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/defn"
+	enc "github.com/named-data/ndnd/std/encoding"
 )
 
 // Strategy implementations should register the instatiation function using init().
 // Each thread has a separate instance of each strategy.
-var strategyInit []func() Strategy
+var strategyInit = make(map[string]func() Strategy)
 
 // StrategyVersions contains a list of strategies mapping to a list of their versions
 var StrategyVersions = make(map[string][]uint64)
 
+// StrategyParameters are the parameters of a strategy, which are encoded in
+// the strategy name as key~value components following the version, e.g.
+// /localhost/nfd/strategy/load-balance/v=1/sticky~2
+type StrategyParameters map[string]string
+
+// ParameterizedStrategy is a strategy that accepts parameters in its name.
+type ParameterizedStrategy interface {
+	Strategy
+	// SetParameters configures the strategy. Unknown parameters are an error.
+	SetParameters(params StrategyParameters) error
+}
+
 // InstantiateStrategies instantiates all strategies for a forwarding thread.
 func InstantiateStrategies(fwThread *Thread) map[uint64]Strategy {
 	strategies := make(map[uint64]Strategy, len(strategyInit))
@@ -31,3 +48,70 @@ func InstantiateStrategies(fwThread *Thread) map[uint64]Strategy {
 
 	return strategies
 }
+
+// CheckStrategyParameters returns an error if the strategy of a versioned
+// strategy name does not accept the parameters of the name.
+func CheckStrategyParameters(name enc.Name) error {
+	_, err := configureStrategy(name)
+	return err
+}
+
+// newStrategy instantiates a strategy with the parameters of its name for a forwarding thread.
+func newStrategy(fwThread *Thread, name enc.Name) (Strategy, error) {
+	strategy, err := configureStrategy(name)
+	if err != nil {
+		return nil, err
+	}
+	strategy.Instantiate(fwThread)
+	strategy.(interface{ setName(enc.Name) }).setName(name)
+	return strategy, nil
+}
+
+// configureStrategy creates a strategy, and sets the parameters of its name.
+func configureStrategy(name enc.Name) (Strategy, error) {
+	if len(name) < len(defn.STRATEGY_PREFIX)+2 || !defn.STRATEGY_PREFIX.IsPrefix(name) {
+		return nil, fmt.Errorf("invalid strategy name %s", name)
+	}
+
+	initFun, ok := strategyInit[name[len(defn.STRATEGY_PREFIX)].String()]
+	if !ok {
+		return nil, fmt.Errorf("unknown strategy %s", name)
+	}
+	strategy := initFun()
+
+	params, err := parseStrategyParameters(name[len(defn.STRATEGY_PREFIX)+2:])
+	if err != nil {
+		return nil, err
+	}
+	if len(params) == 0 {
+		return strategy, nil
+	}
+
+	pStrategy, ok := strategy.(ParameterizedStrategy)
+	if !ok {
+		return nil, fmt.Errorf("strategy %s does not accept parameters", name[len(defn.STRATEGY_PREFIX)])
+	}
+	if err := pStrategy.SetParameters(params); err != nil {
+		return nil, err
+	}
+	return strategy, nil
+}
+
+// parseStrategyParameters parses the key~value components following the strategy version.
+func parseStrategyParameters(comps enc.Name) (StrategyParameters, error) {
+	params := make(StrategyParameters, len(comps))
+	for _, comp := range comps {
+		if comp.Typ != enc.TypeGenericNameComponent {
+			return nil, fmt.Errorf("invalid strategy parameter %s", comp)
+		}
+		key, value, ok := strings.Cut(string(comp.Val), "~")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid strategy parameter %s", comp)
+		}
+		if _, dup := params[key]; dup {
+			return nil, fmt.Errorf("duplicate strategy parameter %s", key)
+		}
+		params[key] = value
+	}
+	return params, nil
+}
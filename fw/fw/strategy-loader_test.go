@@ -0,0 +1,127 @@
+package fw
+
+import (
+	"testing"
+
+	"github.com/named-data/ndnd/fw/defn"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// strategyName returns the name of a strategy with parameters.
+func strategyName(strategy string, params ...string) enc.Name {
+	name := defn.STRATEGY_PREFIX.
+		Append(enc.NewGenericComponent(strategy)).
+		Append(enc.NewVersionComponent(1))
+	for _, param := range params {
+		name = name.Append(enc.NewGenericComponent(param))
+	}
+	return name
+}
+
+func TestParseStrategyParameters(t *testing.T) {
+	tests := []struct {
+		desc   string
+		comps  enc.Name
+		params StrategyParameters
+	}{
+		{"none", enc.Name{}, StrategyParameters{}},
+		{"one", enc.Name{enc.NewGenericComponent("sticky~2")}, StrategyParameters{"sticky": "2"}},
+		{"empty value", enc.Name{enc.NewGenericComponent("flag~")}, StrategyParameters{"flag": ""}},
+		{"value with separator", enc.Name{enc.NewGenericComponent("a~b~c")}, StrategyParameters{"a": "b~c"}},
+		{"several", enc.Name{
+			enc.NewGenericComponent("a~1"),
+			enc.NewGenericComponent("b~2"),
+		}, StrategyParameters{"a": "1", "b": "2"}},
+		{"no separator", enc.Name{enc.NewGenericComponent("sticky")}, nil},
+		{"empty key", enc.Name{enc.NewGenericComponent("~2")}, nil},
+		{"duplicate", enc.Name{
+			enc.NewGenericComponent("a~1"),
+			enc.NewGenericComponent("a~2"),
+		}, nil},
+		{"not generic", enc.Name{enc.NewVersionComponent(2)}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			params, err := parseStrategyParameters(test.comps)
+			if test.params == nil {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.params, params)
+			}
+		})
+	}
+}
+
+func TestCheckStrategyParameters(t *testing.T) {
+	tests := []struct {
+		desc  string
+		name  enc.Name
+		valid bool
+	}{
+		{"without parameters", strategyName("best-route"), true},
+		{"load-balance sticky", strategyName("load-balance", "sticky~2"), true},
+		{"random sticky", strategyName("random", "sticky~0"), true},
+		{"negative sticky", strategyName("load-balance", "sticky~-1"), false},
+		{"invalid sticky", strategyName("random", "sticky~x"), false},
+		{"unknown parameter", strategyName("load-balance", "weight~2"), false},
+		{"not parameterized", strategyName("best-route", "sticky~2"), false},
+		{"unknown strategy", strategyName("unknown"), false},
+		{"no version", defn.STRATEGY_PREFIX.Append(enc.NewGenericComponent("best-route")), false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			assert.Equal(t, test.valid, CheckStrategyParameters(test.name) == nil)
+		})
+	}
+}
+
+func TestThreadStrategy(t *testing.T) {
+	thread := NewThread(0, testFwd)
+
+	// Strategies with parameters are instantiated on first use
+	name := strategyName("load-balance", "sticky~3")
+	strategy, counters := thread.strategy(name)
+	require.NotNil(t, counters)
+	assert.Equal(t, name, strategy.GetName())
+	assert.Equal(t, 3, strategy.(*LoadBalance).params.sticky)
+	same, _ := thread.strategy(name)
+	assert.Same(t, strategy, same)
+
+	// Invalid parameters use the strategy without parameters
+	strategy, counters = thread.strategy(strategyName("load-balance", "weight~2"))
+	require.NotNil(t, counters)
+	assert.Equal(t, strategyName("load-balance"), strategy.GetName())
+
+	// Unknown strategies use the default strategy
+	strategy, counters = thread.strategy(strategyName("unknown", "a~1"))
+	require.NotNil(t, counters)
+	assert.Equal(t, defn.DEFAULT_STRATEGY, strategy.GetName())
+}
+
+func TestReleaseStrategies(t *testing.T) {
+	thread := NewThread(0, testFwd)
+	used := strategyName("load-balance", "sticky~1")
+	unused := strategyName("random", "sticky~1")
+	prefix := testPrefix(t, used)
+
+	thread.strategy(used)
+	thread.strategy(unused)
+	thread.ReleaseStrategies()
+
+	// Instances of strategies that are not chosen for any prefix are removed
+	assert.Contains(t, thread.strategies, used.Hash())
+	assert.NotContains(t, thread.strategies, unused.Hash())
+	assert.NotContains(t, thread.strategyCounters, unused.Hash())
+
+	// Strategies without parameters are kept
+	testFwd.Fib.UnSetStrategyEnc(prefix)
+	thread.ReleaseStrategies()
+	assert.NotContains(t, thread.strategies, used.Hash())
+	assert.Contains(t, thread.strategies, strategyName("load-balance").Hash())
+	assert.Len(t, thread.strategies, len(strategyInit))
+}
@@ -9,10 +9,12 @@ package fw
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/named-data/ndnd/fw/defn"
 	"github.com/named-data/ndnd/fw/table"
 	enc "github.com/named-data/ndnd/std/encoding"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
 )
 
 // Strategy represents a forwarding strategy.
@@ -34,9 +36,15 @@ type Strategy interface {
 		pitEntry table.PitEntry,
 		inFace uint64,
 		nexthops []*table.FibNextHopEntry)
+	AfterReceiveNack(
+		packet *defn.Pkt,
+		pitEntry table.PitEntry,
+		inFace uint64)
 	BeforeSatisfyInterest(
 		pitEntry table.PitEntry,
 		inFace uint64)
+	BeforeExpirePendingInterest(
+		pitEntry table.PitEntry)
 }
 
 // StrategyBase provides common helper methods for YaNFD forwarding strategies.
@@ -73,6 +81,17 @@ func (s *StrategyBase) GetName() enc.Name {
 	return s.name
 }
 
+// setName sets the name of the strategy, including its parameters.
+func (s *StrategyBase) setName(name enc.Name) {
+	s.name = name.Clone()
+}
+
+// Measurements returns the Measurements table of the forwarding thread,
+// which strategies can use to keep per-prefix state between Interests.
+func (s *StrategyBase) Measurements() *table.MeasurementsTable {
+	return s.thread.measurements
+}
+
 // SendInterest sends an Interest on the specified face.
 func (s *StrategyBase) SendInterest(
 	packet *defn.Pkt,
@@ -80,7 +99,19 @@ func (s *StrategyBase) SendInterest(
 	nexthop uint64,
 	inFace uint64,
 ) bool {
-	return s.thread.processOutgoingInterest(packet, pitEntry, nexthop, inFace)
+	return s.thread.processOutgoingInterest(packet, pitEntry, nexthop, inFace, packet.NonDiscovery)
+}
+
+// SendDiscoveryInterest sends an Interest on the specified face, marked
+// as discovery or non-discovery for self-learning forwarders downstream.
+func (s *StrategyBase) SendDiscoveryInterest(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	nexthop uint64,
+	inFace uint64,
+	discovery bool,
+) bool {
+	return s.thread.processOutgoingInterest(packet, pitEntry, nexthop, inFace, !discovery)
 }
 
 // SendData sends a Data packet on the specified face.
@@ -89,11 +120,73 @@ func (s *StrategyBase) SendData(
 	pitEntry table.PitEntry,
 	nexthop uint64,
 	inFace uint64,
+) {
+	s.SendDataWithAnnouncement(packet, pitEntry, nexthop, inFace, nil)
+}
+
+// SendDataWithAnnouncement sends a Data packet on the specified face,
+// with a Prefix Announcement of the producer attached for self-learning.
+func (s *StrategyBase) SendDataWithAnnouncement(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	nexthop uint64,
+	inFace uint64,
+	prefixAnn enc.Wire,
 ) {
 	var pitToken []byte
 	if inRecord, ok := pitEntry.InRecords()[nexthop]; ok {
 		pitToken = inRecord.PitToken
 		pitEntry.RemoveInRecord(nexthop)
 	}
-	s.thread.processOutgoingData(packet, nexthop, pitToken, inFace)
+	s.thread.processOutgoingData(packet, nexthop, pitToken, inFace, prefixAnn)
+}
+
+// SendNack sends a Nack with the given reason to the specified downstream face.
+func (s *StrategyBase) SendNack(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	nexthop uint64,
+	reason uint64,
+) {
+	s.thread.processOutgoingNack(packet, pitEntry, nexthop, reason)
+}
+
+// AggregateNacks returns a Nack to all downstream faces once every upstream
+// of the PIT entry has replied with a Nack or timed out. The least severe
+// reason among the received Nacks is used. Returns true if Nacks were sent.
+func (s *StrategyBase) AggregateNacks(packet *defn.Pkt, pitEntry table.PitEntry) bool {
+	now := time.Now()
+	reason, nacked := uint64(spec.NackReasonNone), false
+	for _, outRecord := range pitEntry.OutRecords() {
+		r, ok := outRecord.NackReason.Get()
+		if !ok {
+			if outRecord.ExpirationTime.After(now) {
+				return false // still pending
+			}
+			continue
+		}
+		if !nacked || nackLessSevere(r, reason) {
+			reason, nacked = r, true
+		}
+	}
+	if !nacked {
+		return false
+	}
+
+	for faceID := range pitEntry.InRecords() {
+		s.SendNack(packet, pitEntry, faceID, reason)
+	}
+	return true
+}
+
+// nackLessSevere returns whether Nack reason x is less severe than y.
+// A Nack without a reason is more severe than any Nack with a reason.
+func nackLessSevere(x, y uint64) bool {
+	if x == spec.NackReasonNone {
+		return false
+	}
+	if y == spec.NackReasonNone {
+		return true
+	}
+	return x < y
 }
@@ -9,8 +9,11 @@ package fw
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"runtime"
+	"slices"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -19,17 +22,19 @@ import (
 	"github.com/named-data/ndnd/fw/dispatch"
 	"github.com/named-data/ndnd/fw/table"
 	enc "github.com/named-data/ndnd/std/encoding"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/types/optional"
 	"github.com/named-data/ndnd/std/utils"
 )
 
 // MaxFwThreads Maximum number of forwarding threads
 const MaxFwThreads = 32
 
-// Threads contains all forwarding threads
-var Threads []*Thread
+// ErrThreadStopped is returned when a task is run on a forwarding thread that has stopped.
+var ErrThreadStopped = errors.New("forwarding thread has stopped")
 
-// HashNameToFwThread hashes an NDN name to a forwarding thread.
-func HashNameToFwThread(name enc.Name) int {
+// HashNameToFwThread hashes an NDN name to one of n forwarding threads.
+func HashNameToFwThread(name enc.Name, n int) int {
 	// Dispatch all management requests to thread 0
 	// this is fine, all it does is make sure the pitcs table in thread 0 has the management stuff.
 	// This is not actually touching management.
@@ -37,13 +42,13 @@ func HashNameToFwThread(name enc.Name) int {
 		return 0
 	}
 	// to prevent negative modulos because we converted from uint to int
-	return int(name.Hash() % uint64(len(Threads)))
+	return int(name.Hash() % uint64(n))
 }
 
-// HashNameToAllPrefixFwThreads hashes an NDN name to all forwarding threads for all prefixes of the name.
+// HashNameToAllPrefixFwThreads hashes an NDN name to n forwarding threads for all prefixes of the name.
 // The return value is a boolean map of which threads match the name
-func HashNameToAllPrefixFwThreads(name enc.Name) []bool {
-	threads := make([]bool, len(Threads))
+func HashNameToAllPrefixFwThreads(name enc.Name, n int) []bool {
+	threads := make([]bool, n)
 
 	// Dispatch all management requests to thread 0
 	if len(name) > 0 && name[0].Equal(enc.LOCALHOST) {
@@ -53,7 +58,7 @@ func HashNameToAllPrefixFwThreads(name enc.Name) []bool {
 
 	prefixHash := name.PrefixHash()
 	for i := 1; i < len(prefixHash); i++ {
-		thread := int(prefixHash[i] % uint64(len(Threads)))
+		thread := int(prefixHash[i] % uint64(n))
 		threads[thread] = true
 	}
 	return threads
@@ -62,33 +67,64 @@ func HashNameToAllPrefixFwThreads(name enc.Name) []bool {
 // Thread Represents a forwarding thread
 type Thread struct {
 	threadID      int
+	fwd           *dispatch.Forwarder
 	pending       chan *defn.Pkt
 	pitCS         table.PitCsTable
 	strategies    map[uint64]Strategy
 	deadNonceList *table.DeadNonceList
+	measurements  *table.MeasurementsTable
+	tasks         chan func()
 	shouldQuit    chan interface{}
+	stopped       chan struct{}
 	HasQuit       chan interface{}
 
 	// Counters
 	nInInterests          atomic.Uint64
 	nInData               atomic.Uint64
+	nInNacks              atomic.Uint64
 	nOutInterests         atomic.Uint64
 	nOutData              atomic.Uint64
+	nOutNacks             atomic.Uint64
 	nSatisfiedInterests   atomic.Uint64
 	nUnsatisfiedInterests atomic.Uint64
 	nCsHits               atomic.Uint64
 	nCsMisses             atomic.Uint64
+
+	// Counters of each strategy, by strategy name hash
+	strategyCounters map[uint64]*strategyCounters
+	// strategyMutex protects strategies and strategyCounters, which are only
+	// modified by the forwarding thread when a strategy with parameters is used
+	strategyMutex sync.RWMutex
+
+	// Routes learned by the self-learning strategy, set before the thread runs
+	selfLearningRoutes SelfLearningRoutes
+}
+
+// strategyCounters counts the packets passed to a strategy.
+type strategyCounters struct {
+	nInterests atomic.Uint64
+	nData      atomic.Uint64
+	nNacks     atomic.Uint64
+	nCsHits    atomic.Uint64
 }
 
-// NewThread creates a new forwarding thread
-func NewThread(id int) *Thread {
+// NewThread creates a new forwarding thread of the forwarder
+func NewThread(id int, fwd *dispatch.Forwarder) *Thread {
 	t := new(Thread)
 	t.threadID = id
+	t.fwd = fwd
 	t.pending = make(chan *defn.Pkt, CfgFwQueueSize())
 	t.pitCS = table.NewPitCS(t.finalizeInterest)
-	t.strategies = InstantiateStrategies(t)
 	t.deadNonceList = table.NewDeadNonceList()
+	t.measurements = table.NewMeasurementsTable()
+	t.strategies = InstantiateStrategies(t)
+	t.strategyCounters = make(map[uint64]*strategyCounters, len(t.strategies))
+	for hash := range t.strategies {
+		t.strategyCounters[hash] = &strategyCounters{}
+	}
+	t.tasks = make(chan func())
 	t.shouldQuit = make(chan interface{}, 1)
+	t.stopped = make(chan struct{})
 	t.HasQuit = make(chan interface{})
 	return t
 }
@@ -108,10 +144,13 @@ func (t *Thread) Counters() defn.FWThreadCounters {
 	return defn.FWThreadCounters{
 		NPitEntries:           t.pitCS.PitSize(),
 		NCsEntries:            t.pitCS.CsSize(),
+		NMeasurementsEntries:  t.measurements.Size(),
 		NInInterests:          t.nInInterests.Load(),
 		NInData:               t.nInData.Load(),
+		NInNacks:              t.nInNacks.Load(),
 		NOutInterests:         t.nOutInterests.Load(),
 		NOutData:              t.nOutData.Load(),
+		NOutNacks:             t.nOutNacks.Load(),
 		NSatisfiedInterests:   t.nSatisfiedInterests.Load(),
 		NUnsatisfiedInterests: t.nUnsatisfiedInterests.Load(),
 		NCsHits:               t.nCsHits.Load(),
@@ -119,6 +158,108 @@ func (t *Thread) Counters() defn.FWThreadCounters {
 	}
 }
 
+// StrategyCounters returns the counters of each strategy of this forwarding thread.
+func (t *Thread) StrategyCounters() []defn.StrategyCounters {
+	t.strategyMutex.RLock()
+	defer t.strategyMutex.RUnlock()
+
+	counters := make([]defn.StrategyCounters, 0, len(t.strategies))
+	for hash, strategy := range t.strategies {
+		c := t.strategyCounters[hash]
+		counters = append(counters, defn.StrategyCounters{
+			Strategy:   strategy.GetName(),
+			NInterests: c.nInterests.Load(),
+			NData:      c.nData.Load(),
+			NNacks:     c.nNacks.Load(),
+			NCsHits:    c.nCsHits.Load(),
+		})
+	}
+	return counters
+}
+
+// strategy returns the strategy instance of a strategy name and its counters.
+// Strategies with parameters are instantiated on first use.
+func (t *Thread) strategy(name enc.Name) (Strategy, *strategyCounters) {
+	hash := name.Hash()
+	if strategy, ok := t.strategies[hash]; ok {
+		return strategy, t.strategyCounters[hash]
+	}
+
+	strategy, err := newStrategy(t, name)
+	if err != nil {
+		// Parameters are checked when the strategy is set, so this should not happen
+		hash = name.Prefix(len(defn.STRATEGY_PREFIX) + 2).Hash()
+		if strategy, ok := t.strategies[hash]; ok {
+			core.Log.Error(t, "Unable to instantiate strategy - using it without parameters", "strategy", name, "err", err)
+			return strategy, t.strategyCounters[hash]
+		}
+		core.Log.Error(t, "Unable to instantiate strategy - using default strategy", "strategy", name, "err", err)
+		hash = defn.DEFAULT_STRATEGY.Hash()
+		return t.strategies[hash], t.strategyCounters[hash]
+	}
+	core.Log.Debug(t, "Instantiated Strategy", "strategy", name)
+
+	t.strategyMutex.Lock()
+	defer t.strategyMutex.Unlock()
+	t.strategies[hash] = strategy
+	t.strategyCounters[hash] = &strategyCounters{}
+	return strategy, t.strategyCounters[hash]
+}
+
+// ReleaseStrategies removes the instances of strategies with parameters that are not
+// chosen for any prefix anymore. They are instantiated again if they are used later.
+// This must be called from the forwarding thread (see RunTask).
+func (t *Thread) ReleaseStrategies() {
+	chosen := make(map[uint64]bool)
+	for _, entry := range t.fwd.Fib.GetAllForwardingStrategies() {
+		chosen[entry.GetStrategy().Hash()] = true
+	}
+
+	t.strategyMutex.Lock()
+	defer t.strategyMutex.Unlock()
+	for hash, strategy := range t.strategies {
+		if len(strategy.GetName()) > len(defn.STRATEGY_PREFIX)+2 && !chosen[hash] {
+			core.Log.Debug(t, "Released Strategy", "strategy", strategy.GetName())
+			delete(t.strategies, hash)
+			delete(t.strategyCounters, hash)
+		}
+	}
+}
+
+// Measurements returns the Measurements table of this forwarding thread.
+// The table must only be accessed from the forwarding thread (see RunTask).
+func (t *Thread) Measurements() *table.MeasurementsTable {
+	return t.measurements
+}
+
+// PitCs returns the PIT-CS table of this forwarding thread.
+// The table must only be accessed from the forwarding thread (see RunTask).
+func (t *Thread) PitCs() table.PitCsTable {
+	return t.pitCS
+}
+
+// RunTask runs a function in the forwarding thread and waits for it to complete.
+// This allows other goroutines (e.g. management) to safely access the thread's tables.
+// Returns ErrThreadStopped if the thread stopped before the task could complete.
+func (t *Thread) RunTask(task func()) error {
+	done := make(chan struct{})
+	select {
+	case t.tasks <- func() {
+		defer close(done)
+		task()
+	}:
+	case <-t.stopped:
+		return ErrThreadStopped
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-t.stopped:
+		return ErrThreadStopped
+	}
+}
+
 // TellToQuit tells the forwarding thread to quit
 func (t *Thread) TellToQuit() {
 	core.Log.Info(t, "Told to quit")
@@ -131,10 +272,13 @@ func (t *Thread) Run() {
 		runtime.LockOSThread()
 	}
 
+loop:
 	for !core.ShouldQuit {
 		select {
 		case pkt := <-t.pending:
-			if pkt.L3.Interest != nil {
+			if pkt.NackReason.IsSet() {
+				t.processIncomingNack(pkt)
+			} else if pkt.L3.Interest != nil {
 				t.processIncomingInterest(pkt)
 			} else if pkt.L3.Data != nil {
 				t.processIncomingData(pkt)
@@ -143,12 +287,18 @@ func (t *Thread) Run() {
 			t.deadNonceList.RemoveExpiredEntries()
 		case <-t.pitCS.UpdateTicker():
 			t.pitCS.Update()
+		case <-t.measurements.Ticker.C:
+			t.measurements.Update()
+		case task := <-t.tasks:
+			task()
 		case <-t.shouldQuit:
-			continue
+			break loop
 		}
 	}
 
 	t.deadNonceList.Ticker.Stop()
+	t.measurements.Ticker.Stop()
+	close(t.stopped)
 
 	core.Log.Info(t, "Stopping thread")
 	t.HasQuit <- true
@@ -160,6 +310,7 @@ func (t *Thread) QueueInterest(interest *defn.Pkt) {
 	case t.pending <- interest:
 	default:
 		core.Log.Error(t, "Interest dropped due to full queue")
+		t.sendNack(interest, interest.IncomingFaceID, interest.PitToken, spec.NackReasonCongestion)
 	}
 }
 
@@ -172,6 +323,15 @@ func (t *Thread) QueueData(data *defn.Pkt) {
 	}
 }
 
+// QueueNack queues a Nack for processing by this forwarding thread.
+func (t *Thread) QueueNack(nack *defn.Pkt) {
+	select {
+	case t.pending <- nack:
+	default:
+		core.Log.Error(t, "Nack dropped due to full queue")
+	}
+}
+
 // (AI GENERATED DESCRIPTION): Processes an incoming Interest packet: verifies its validity, enforces hop limits and scope, checks for nonces and dead‑nonce loops, updates the PIT and content store, selects and filters next‑hops via the FIB, and forwards the Interest according to the chosen forwarding strategy.
 func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 	interest := packet.L3.Interest
@@ -181,7 +341,7 @@ func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 
 	// Already asserted that this is an Interest in link service
 	// Get incoming face
-	incomingFace := dispatch.GetFace(packet.IncomingFaceID)
+	incomingFace := t.fwd.GetFace(packet.IncomingFaceID)
 	if incomingFace == nil {
 		core.Log.Error(t, "Interest has non-existent incoming face", "faceid", packet.IncomingFaceID, "name", packet.Name)
 		return
@@ -207,27 +367,22 @@ func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 	// Update counter
 	t.nInInterests.Add(1)
 
-	// Check for forwarding hint and, if present, determine if reaching producer region (and then strip forwarding hint)
-	isReachingProducerRegion := true
-	var fhName enc.Name = nil
-	hint := interest.ForwardingHintV
-	if hint != nil && len(hint.Names) > 0 {
-		isReachingProducerRegion = false
-		for _, fh := range hint.Names {
-			if table.NetworkRegion.IsProducer(fh) {
-				isReachingProducerRegion = true
-				break
-			} else if fhName == nil {
-				fhName = fh
-			}
-		}
-		if isReachingProducerRegion {
-			// TODO: Drop the forwarding hint for now.
-			// No way without re-encoding Interest for now.
-			fhName = nil
+	// Check for forwarding hint and, if present, determine if reaching producer region.
+	// In this case, the Interest is re-encoded without the forwarding hint, so that
+	// the hint is neither used here nor by any downstream forwarder.
+	if hint := interest.ForwardingHintV; hint != nil && len(hint.Names) > 0 &&
+		slices.ContainsFunc(hint.Names, table.NetworkRegion.IsProducer) {
+		if err := stripForwardingHint(packet); err != nil {
+			core.Log.Warn(t, "Unable to strip forwarding hint - DROP", "name", packet.Name, "err", err)
+			return
 		}
+		interest = packet.L3.Interest
+		core.Log.Trace(t, "Reached producer region, stripped forwarding hint", "name", packet.Name)
 	}
 
+	// Interests with different forwarding hints are not aggregated in the PIT
+	fhName := interest.ForwardingHint()
+
 	// Drop packet if no nonce is found
 	if !interest.NonceV.IsSet() {
 		core.Log.Debug(t, "Interest is missing Nonce", "name", packet.Name)
@@ -237,6 +392,7 @@ func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 	// Check if packet is in dead nonce list
 	if exists := t.deadNonceList.Find(interest.NameV, interest.NonceV.Unwrap()); exists {
 		core.Log.Debug(t, "Interest is looping (DNL)", "name", packet.Name, "nonce", interest.NonceV.Unwrap())
+		t.sendNack(packet, incomingFace.FaceID(), packet.PitToken, spec.NackReasonDuplicate)
 		return
 	}
 
@@ -244,19 +400,20 @@ func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 	// read into this, looks like this one will have to be manually changed
 	pitEntry, isDuplicate := t.pitCS.InsertInterest(interest, fhName, incomingFace.FaceID())
 	if isDuplicate {
-		// Interest loop - since we don't use Nacks, just drop
 		core.Log.Debug(t, "Interest is looping (PIT)", "name", packet.Name)
+		t.sendNack(packet, incomingFace.FaceID(), packet.PitToken, spec.NackReasonDuplicate)
 		return
 	}
 
 	// Get strategy for name
-	strategyName := table.FibStrategyTable.FindStrategyEnc(interest.Name())
-	strategy := t.strategies[strategyName.Hash()]
+	strategyName := t.fwd.Fib.FindStrategyEnc(interest.Name())
+	strategy, counters := t.strategy(strategyName)
 
 	// Add in-record and determine if already pending
 	// this looks like custom interest again, but again can be changed without much issue?
 	inRecord, isAlreadyPending, prevNonce := pitEntry.InsertInRecord(
 		interest, incomingFace.FaceID(), packet.PitToken)
+	inRecord.NonDiscovery = packet.NonDiscovery
 
 	if !isAlreadyPending {
 		core.Log.Trace(t, "Interest is not pending", "name", packet.Name)
@@ -281,6 +438,7 @@ func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 					packet.L3.Interest = nil
 					packet.Raw = enc.Wire{csWire}
 					packet.Name = csData.NameV
+					counters.nCsHits.Add(1)
 					strategy.AfterContentStoreHit(packet, pitEntry, incomingFace.FaceID())
 					return
 				} else if err != nil {
@@ -308,9 +466,9 @@ func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 
 	// If NextHopFaceId set, forward to that face (if it exists) or drop
 	if hop, ok := packet.NextHopFaceID.Get(); ok {
-		if face := dispatch.GetFace(hop); face != nil {
+		if face := t.fwd.GetFace(hop); face != nil {
 			core.Log.Trace(t, "NextHopFaceId is set for Interest", "name", packet.Name)
-			t.processOutgoingInterest(packet, pitEntry, hop, incomingFace.FaceID())
+			t.processOutgoingInterest(packet, pitEntry, hop, incomingFace.FaceID(), packet.NonDiscovery)
 		} else {
 			core.Log.Info(t, "Non-existent face specified in NextHopFaceId for Interest",
 				"name", packet.Name, "faceid", hop)
@@ -318,30 +476,29 @@ func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 		return
 	}
 
-	// Use forwarding hint if present
-	lookupName := interest.Name()
-	if fhName != nil {
-		lookupName = fhName
-	}
-
 	// Query the FIB for all possible nexthops
-	nexthops := table.FibStrategyTable.FindNextHopsEnc(lookupName)
+	lookupName, nexthops := t.lookupFib(interest, incomingFace)
 
-	// If the first component is /localhop, we do not forward interests received
-	// on non-local faces to non-local faces
-	localFacesOnly := incomingFace.Scope() != defn.Local && packet.Name.At(0).Equal(enc.LOCALHOP)
+	// If the first component of the name or the used forwarding hint is /localhop,
+	// we do not forward interests received on non-local faces to non-local faces
+	localFacesOnly := incomingFace.Scope() != defn.Local &&
+		(packet.Name.At(0).Equal(enc.LOCALHOP) || lookupName.At(0).Equal(enc.LOCALHOP))
 
 	// Filter the nexthops that are allowed for this Interest
 	allowedNexthops := make([]*table.FibNextHopEntry, 0, len(nexthops))
 	for _, nexthop := range nexthops {
-		// Exclude incoming face
+		// Exclude incoming face, unless it is ad hoc, since other nodes
+		// on the wireless link may not have received the Interest
 		if nexthop.Nexthop == packet.IncomingFaceID {
+			if incomingFace.LinkType() == defn.AdHoc {
+				allowedNexthops = append(allowedNexthops, nexthop)
+			}
 			continue
 		}
 
 		// Exclude non-local faces for localhop enforcement
 		if localFacesOnly {
-			if face := dispatch.GetFace(nexthop.Nexthop); face != nil && face.Scope() != defn.Local {
+			if face := t.fwd.GetFace(nexthop.Nexthop); face != nil && face.Scope() != defn.Local {
 				continue
 			}
 		}
@@ -354,15 +511,57 @@ func (t *Thread) processIncomingInterest(packet *defn.Pkt) {
 	}
 
 	// Pass to strategy AfterReceiveInterest pipeline
+	counters.nInterests.Add(1)
 	strategy.AfterReceiveInterest(packet, pitEntry, incomingFace.FaceID(), allowedNexthops)
 }
 
+// lookupFib returns the name used for the FIB lookup of an Interest and the resulting nexthops.
+// Without forwarding hint, the Interest name is used. Otherwise, the first delegation that
+// has a FIB route is used, which is the default route in the consumer region (see NFD dev guide).
+func (t *Thread) lookupFib(interest *defn.FwInterest, incomingFace dispatch.Face) (enc.Name, []*table.FibNextHopEntry) {
+	hint := interest.ForwardingHintV
+	if hint == nil || len(hint.Names) == 0 {
+		return interest.Name(), t.fwd.Fib.FindNextHopsEnc(interest.Name())
+	}
+
+	for _, delegation := range hint.Names {
+		// Delegations cannot be used to reach /localhost from non-local faces
+		if incomingFace.Scope() == defn.NonLocal && delegation.At(0).Equal(enc.LOCALHOST) {
+			continue
+		}
+		if nexthops := t.fwd.Fib.FindNextHopsEnc(delegation); len(nexthops) > 0 {
+			return delegation, nexthops
+		}
+	}
+	return nil, nil
+}
+
+// stripForwardingHint re-encodes the Interest in the packet without its forwarding hint.
+func stripForwardingHint(packet *defn.Pkt) error {
+	wire, err := defn.StripForwardingHint(packet.Raw)
+	if err != nil {
+		return err
+	}
+	L3, err := defn.ParseFwPacket(enc.NewWireView(wire), false)
+	if err != nil {
+		return err
+	}
+	if L3.Interest == nil {
+		return enc.ErrFormat{Msg: "re-encoded packet is not an Interest"}
+	}
+	packet.Raw = wire
+	packet.L3 = L3
+	packet.Name = L3.Interest.NameV
+	return nil
+}
+
 // (AI GENERATED DESCRIPTION): Forwards an Interest packet to the chosen outgoing face, updating the PIT entry’s out‑record, generating a PIT token, and enforcing HopLimit and anti‑loop rules before transmitting it.
 func (t *Thread) processOutgoingInterest(
 	packet *defn.Pkt,
 	pitEntry table.PitEntry,
 	nexthop uint64,
 	inFace uint64,
+	nonDiscovery bool,
 ) bool {
 	interest := packet.L3.Interest
 	if interest == nil {
@@ -372,7 +571,7 @@ func (t *Thread) processOutgoingInterest(
 	core.Log.Trace(t, "OnOutgoingInterest", "name", packet.Name, "faceid", nexthop)
 
 	// Get outgoing face
-	outgoingFace := dispatch.GetFace(nexthop)
+	outgoingFace := t.fwd.GetFace(nexthop)
 	if outgoingFace == nil {
 		core.Log.Error(t, "Non-existent nexthop", "name", packet.Name, "faceid", nexthop)
 		return false
@@ -390,7 +589,8 @@ func (t *Thread) processOutgoingInterest(
 	}
 
 	// Create or update out-record
-	pitEntry.InsertOutRecord(interest, nexthop)
+	outRecord := pitEntry.InsertOutRecord(interest, nexthop)
+	outRecord.NonDiscovery = nonDiscovery
 
 	// Update counters
 	t.nOutInterests.Add(1)
@@ -402,9 +602,10 @@ func (t *Thread) processOutgoingInterest(
 
 	// Send on outgoing face
 	outgoingFace.SendPacket(dispatch.OutPkt{
-		Pkt:      packet,
-		PitToken: pitToken,
-		InFace:   inFace,
+		Pkt:          packet,
+		PitToken:     pitToken,
+		InFace:       inFace,
+		NonDiscovery: nonDiscovery,
 	})
 
 	return true
@@ -417,8 +618,14 @@ func (t *Thread) finalizeInterest(pitEntry table.PitEntry) {
 		t.deadNonceList.Insert(pitEntry.EncName(), outRecord.LatestNonce)
 	}
 
-	// Update counters
 	if !pitEntry.Satisfied() {
+		// Let the strategy know that the pending upstreams timed out
+		if len(pitEntry.OutRecords()) > 0 {
+			strategy, _ := t.strategy(t.fwd.Fib.FindStrategyEnc(pitEntry.EncName()))
+			strategy.BeforeExpirePendingInterest(pitEntry)
+		}
+
+		// Update counters
 		t.nUnsatisfiedInterests.Add(uint64(len(pitEntry.InRecords())))
 	}
 }
@@ -438,7 +645,7 @@ func (t *Thread) processIncomingData(packet *defn.Pkt) {
 	}
 
 	// Get incoming face
-	incomingFace := dispatch.GetFace(packet.IncomingFaceID)
+	incomingFace := t.fwd.GetFace(packet.IncomingFaceID)
 	if incomingFace == nil {
 		core.Log.Error(t, "Non-existent nexthop for Data", "name", packet.Name, "faceid", packet.IncomingFaceID)
 		return
@@ -467,8 +674,9 @@ func (t *Thread) processIncomingData(packet *defn.Pkt) {
 	}
 
 	// Get strategy for name
-	strategyName := table.FibStrategyTable.FindStrategyEnc(data.NameV)
-	strategy := t.strategies[strategyName.Hash()]
+	strategyName := t.fwd.Fib.FindStrategyEnc(data.NameV)
+	strategy, counters := t.strategy(strategyName)
+	counters.nData.Add(1)
 
 	if len(pitEntries) == 1 {
 		// When a single PIT entry matches, we pass the data to the strategy.
@@ -499,11 +707,11 @@ func (t *Thread) processIncomingData(packet *defn.Pkt) {
 		// like CanBePrefix, or different forwarding hints. In this case, we send to all
 		// downstream faces without consulting strategy (see NFD dev guide)
 		for _, pitEntry := range pitEntries {
-			// Store all pending downstreams (except face Data packet arrived on) and PIT tokens
+			// Store all pending downstreams and PIT tokens, except the face the Data packet
+			// arrived on, unless it is ad hoc since other nodes on the link may be waiting
 			downstreams := make(map[uint64][]byte)
 			for face, record := range pitEntry.InRecords() {
-				if face != packet.IncomingFaceID {
-					// TODO: Ad-hoc faces
+				if face != packet.IncomingFaceID || incomingFace.LinkType() == defn.AdHoc {
 					downstreams[face] = make([]byte, len(record.PitToken))
 					copy(downstreams[face], record.PitToken)
 				}
@@ -530,7 +738,7 @@ func (t *Thread) processIncomingData(packet *defn.Pkt) {
 			// Call outgoing Data pipeline for each pending downstream
 			for face, token := range downstreams {
 				core.Log.Trace(t, "Multiple PIT entries for Data", "name", packet.Name)
-				t.processOutgoingData(packet, face, token, packet.IncomingFaceID)
+				t.processOutgoingData(packet, face, token, packet.IncomingFaceID, nil)
 			}
 		}
 	}
@@ -542,6 +750,7 @@ func (t *Thread) processOutgoingData(
 	nexthop uint64,
 	pitToken []byte,
 	inFace uint64,
+	prefixAnn enc.Wire,
 ) {
 	data := packet.L3.Data
 	if data == nil {
@@ -551,7 +760,7 @@ func (t *Thread) processOutgoingData(
 	core.Log.Trace(t, "OnOutgoingData", "name", packet.Name, "faceid", nexthop)
 
 	// Get outgoing face
-	outgoingFace := dispatch.GetFace(nexthop)
+	outgoingFace := t.fwd.GetFace(nexthop)
 	if outgoingFace == nil {
 		core.Log.Error(t, "Non-existent nexthop for Data", "name", packet.Name, "faceid", nexthop)
 		return
@@ -569,8 +778,139 @@ func (t *Thread) processOutgoingData(
 
 	// Send on outgoing face
 	outgoingFace.SendPacket(dispatch.OutPkt{
-		Pkt:      packet,
+		Pkt:                packet,
+		PitToken:           pitToken,
+		InFace:             inFace,
+		PrefixAnnouncement: prefixAnn,
+	})
+}
+
+// processIncomingNack processes a Nack received from an upstream face.
+// The Nack is recorded in the out-record of the matching PIT entry and passed
+// to the strategy, which decides whether to retry or to return it downstream.
+func (t *Thread) processIncomingNack(packet *defn.Pkt) {
+	interest := packet.L3.Interest
+	if interest == nil || !packet.NackReason.IsSet() {
+		panic("processIncomingNack called with non-Nack packet")
+	}
+	reason := packet.NackReason.Unwrap()
+
+	// Get incoming face
+	incomingFace := t.fwd.GetFace(packet.IncomingFaceID)
+	if incomingFace == nil {
+		core.Log.Error(t, "Nack has non-existent incoming face", "faceid", packet.IncomingFaceID, "name", packet.Name)
+		return
+	}
+
+	core.Log.Trace(t, "OnIncomingNack", "name", packet.Name, "faceid", incomingFace.FaceID(), "reason", reason)
+
+	// Update counter
+	t.nInNacks.Add(1)
+
+	// Nacks on multi-access faces are not meaningful (see NFD dev guide)
+	if incomingFace.LinkType() == defn.MultiAccess {
+		core.Log.Debug(t, "Nack received on multi-access face - DROP", "name", packet.Name)
+		return
+	}
+
+	// Find matching PIT entry
+	pitEntry := t.pitCS.FindInterestExactMatchEnc(interest)
+	if pitEntry == nil {
+		core.Log.Debug(t, "Nack for non-existent PIT entry - DROP", "name", packet.Name)
+		return
+	}
+
+	// The Nack must match the latest Interest sent to the upstream
+	outRecord := pitEntry.OutRecords()[incomingFace.FaceID()]
+	if outRecord == nil {
+		core.Log.Debug(t, "Nack from face without out-record - DROP", "name", packet.Name)
+		return
+	}
+	if nonce, ok := interest.NonceV.Get(); !ok || nonce != outRecord.LatestNonce {
+		core.Log.Debug(t, "Nack with stale Nonce - DROP", "name", packet.Name)
+		return
+	}
+	outRecord.NackReason = optional.Some(reason)
+
+	// Get strategy for name
+	strategyName := t.fwd.Fib.FindStrategyEnc(interest.Name())
+	strategy, counters := t.strategy(strategyName)
+	counters.nNacks.Add(1)
+	strategy.AfterReceiveNack(packet, pitEntry, incomingFace.FaceID())
+}
+
+// processOutgoingNack sends a Nack to a downstream face of a PIT entry.
+// The Nack carries the latest Interest received from the downstream,
+// and the corresponding in-record is removed.
+func (t *Thread) processOutgoingNack(
+	packet *defn.Pkt,
+	pitEntry table.PitEntry,
+	nexthop uint64,
+	reason uint64,
+) {
+	inRecord := pitEntry.InRecords()[nexthop]
+	if inRecord == nil {
+		core.Log.Debug(t, "No in-record for outgoing Nack", "name", packet.Name, "faceid", nexthop)
+		return
+	}
+
+	// Use the Nonce the downstream knows about, which is not
+	// necessarily the Nonce of the Interest that brought the Nack
+	nack := *packet
+	if nonce, ok := packet.L3.Interest.NonceV.Get(); !ok || nonce != inRecord.LatestNonce {
+		wire, err := defn.SetInterestNonce(packet.Raw, inRecord.LatestNonce)
+		if err != nil {
+			core.Log.Warn(t, "Unable to set Nonce of outgoing Nack", "name", packet.Name, "err", err)
+			return
+		}
+		nack.Raw = wire
+	}
+
+	pitToken := inRecord.PitToken
+	pitEntry.RemoveInRecord(nexthop)
+
+	// Nobody is waiting for Data anymore
+	if len(pitEntry.InRecords()) == 0 {
+		table.UpdateExpirationTimer(pitEntry, time.Now())
+	}
+
+	t.sendNack(&nack, nexthop, pitToken, reason)
+}
+
+// sendNack sends a Nack with the given reason for the Interest in the packet.
+// It is safe to call from outside the forwarding thread.
+func (t *Thread) sendNack(
+	packet *defn.Pkt,
+	nexthop uint64,
+	pitToken []byte,
+	reason uint64,
+) {
+	outgoingFace := t.fwd.GetFace(nexthop)
+	if outgoingFace == nil {
+		core.Log.Error(t, "Non-existent nexthop for Nack", "name", packet.Name, "faceid", nexthop)
+		return
+	}
+
+	// Nacks are not sent on multi-access faces (see NFD dev guide)
+	if outgoingFace.LinkType() == defn.MultiAccess {
+		return
+	}
+
+	core.Log.Trace(t, "OnOutgoingNack", "name", packet.Name, "faceid", nexthop, "reason", reason)
+
+	// Update counters
+	t.nOutNacks.Add(1)
+
+	// Send on outgoing face
+	outgoingFace.SendPacket(dispatch.OutPkt{
+		Pkt: &defn.Pkt{
+			Name:           packet.Name,
+			L3:             packet.L3,
+			Raw:            packet.Raw,
+			NackReason:     optional.Some(reason),
+			IncomingFaceID: packet.IncomingFaceID,
+		},
 		PitToken: pitToken,
-		InFace:   inFace,
+		InFace:   packet.IncomingFaceID,
 	})
 }
@@ -0,0 +1,141 @@
+package fw
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/dispatch"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+// testFace is a face that records the packets sent on it.
+type testFace struct {
+	id       uint64
+	linkType defn.LinkType
+	sent     []dispatch.OutPkt
+}
+
+func (f *testFace) String() string          { return fmt.Sprintf("test-face-%d", f.id) }
+func (f *testFace) SetFaceID(faceID uint64) { f.id = faceID }
+func (f *testFace) FaceID() uint64          { return f.id }
+func (f *testFace) LocalURI() *defn.URI     { return defn.MakeNullFaceURI() }
+func (f *testFace) RemoteURI() *defn.URI    { return defn.MakeUDPFaceURI(4, "192.0.2.1", uint16(f.id)) }
+func (f *testFace) Scope() defn.Scope       { return defn.NonLocal }
+func (f *testFace) LinkType() defn.LinkType { return f.linkType }
+func (f *testFace) MTU() int                { return defn.MaxNDNPacketSize }
+func (f *testFace) State() defn.State       { return defn.Up }
+func (f *testFace) SendPacket(out dispatch.OutPkt) {
+	f.sent = append(f.sent, out)
+}
+
+// nInterests returns the number of Interests sent on the face.
+func (f *testFace) nInterests() int {
+	n := 0
+	for _, out := range f.sent {
+		if out.Pkt.L3.Interest != nil && !out.Pkt.NackReason.IsSet() {
+			n++
+		}
+	}
+	return n
+}
+
+// nextTestFaceID is the ID of the next face created by the tests.
+var nextTestFaceID uint64 = 1000
+
+// newTestFaces creates and registers faces for a test, which are removed when the test ends.
+func newTestFaces(t *testing.T, n int, linkType defn.LinkType) []*testFace {
+	faces := make([]*testFace, n)
+	for i := range faces {
+		nextTestFaceID++
+		faces[i] = &testFace{id: nextTestFaceID, linkType: linkType}
+		testFwd.AddFace(faces[i].id, faces[i])
+	}
+	t.Cleanup(func() {
+		for _, face := range faces {
+			testFwd.RemoveFace(face.id)
+		}
+	})
+	return faces
+}
+
+// testPrefix returns a unique prefix for a test, with its FIB entry and strategy removed when the test ends.
+func testPrefix(t *testing.T, strategy enc.Name) enc.Name {
+	prefix := enc.Name{enc.NewGenericComponent(t.Name())}
+	if strategy != nil {
+		testFwd.Fib.SetStrategyEnc(prefix, strategy)
+	}
+	t.Cleanup(func() {
+		testFwd.Fib.ClearNextHopsEnc(prefix)
+		testFwd.Fib.UnSetStrategyEnc(prefix)
+	})
+	return prefix
+}
+
+// makeInterestPkt creates an Interest packet received on a face.
+func makeInterestPkt(name enc.Name, lifetime time.Duration, inFace uint64) *defn.Pkt {
+	hopLimit := byte(32)
+	interest := &defn.FwInterest{
+		NameV:             name,
+		NonceV:            optional.Some(rand.Uint32()),
+		InterestLifetimeV: optional.Some(lifetime),
+		HopLimitV:         &hopLimit,
+	}
+	L3 := &defn.FwPacket{Interest: interest}
+	return &defn.Pkt{
+		Name:           name,
+		L3:             L3,
+		Raw:            L3.Encode(),
+		IncomingFaceID: inFace,
+	}
+}
+
+// makeDataPkt creates a Data packet received on a face.
+func makeDataPkt(name enc.Name, inFace uint64) *defn.Pkt {
+	L3 := &defn.FwPacket{Data: &defn.FwData{NameV: name}}
+	return &defn.Pkt{
+		Name:           name,
+		L3:             L3,
+		Raw:            L3.Encode(),
+		IncomingFaceID: inFace,
+	}
+}
+
+// testFwd is the forwarder of the forwarding threads in the tests.
+var testFwd *dispatch.Forwarder
+
+// TestMain initializes the tables used by the forwarding threads.
+func TestMain(m *testing.M) {
+	table.Initialize()
+	testFwd = dispatch.NewForwarder(table.FibStrategyTable, table.Rib)
+	os.Exit(m.Run())
+}
+
+func TestThreadRunTask(t *testing.T) {
+	thread := NewThread(0, testFwd)
+	go thread.Run()
+
+	// Tasks run in the forwarding thread
+	ran := false
+	assert.NoError(t, thread.RunTask(func() { ran = true }))
+	assert.True(t, ran)
+
+	thread.TellToQuit()
+	<-thread.HasQuit
+
+	// Tasks on a stopped thread fail instead of blocking forever
+	done := make(chan error)
+	go func() { done <- thread.RunTask(func() { t.Error("task ran on stopped thread") }) }()
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, ErrThreadStopped)
+	case <-time.After(time.Second):
+		t.Fatal("RunTask blocked on stopped thread")
+	}
+}
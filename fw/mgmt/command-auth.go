@@ -0,0 +1,229 @@
+package mgmt
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	sec "github.com/named-data/ndnd/std/security"
+	"github.com/named-data/ndnd/std/security/signer"
+)
+
+// CommandTimestampGrace is the maximum difference between the signature time
+// of a control command and the time it is received.
+const CommandTimestampGrace = time.Minute
+
+// datasetVerbs are the verbs of each module that only read status datasets or
+// notifications. All other verbs, including unknown ones, are control commands
+// that must be signed.
+var datasetVerbs = map[string][]string{
+	"cs":              {"info", "query"},
+	"faces":           {"list", "query", "events"},
+	"fib":             {"list"},
+	"measurements":    {"list"},
+	"rib":             {"list"},
+	"status":          {"general"},
+	"strategy-choice": {"list"},
+}
+
+// CommandAuthenticator validates the signatures of control commands against the
+// trust schema of the management configuration, and authorizes their signers.
+type CommandAuthenticator struct {
+	trust *localTrust
+	rules []commandAuthRule
+
+	// Latest signature time of each signer key, to reject replayed commands.
+	// Times older than CommandTimestampGrace are removed, since such commands are rejected anyway.
+	lastSigTime map[string]time.Time
+}
+
+// commandAuthRule is a parsed authorization rule of the configuration.
+type commandAuthRule struct {
+	signer   enc.Name
+	commands []string
+	prefixes []enc.Name
+}
+
+// NewCommandAuthenticator creates an authenticator from the management configuration.
+func NewCommandAuthenticator(trust *localTrust) (*CommandAuthenticator, error) {
+	rules := make([]commandAuthRule, 0, len(core.C.Mgmt.Security.Authorization))
+	for _, r := range core.C.Mgmt.Security.Authorization {
+		signerName, err := enc.NameFromStr(r.Signer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signer name %s: %w", r.Signer, err)
+		}
+
+		prefixes := make([]enc.Name, 0, len(r.Prefixes))
+		for _, prefix := range r.Prefixes {
+			name, err := enc.NameFromStr(prefix)
+			if err != nil {
+				return nil, fmt.Errorf("invalid prefix %s: %w", prefix, err)
+			}
+			prefixes = append(prefixes, name)
+		}
+
+		rules = append(rules, commandAuthRule{
+			signer:   signerName,
+			commands: r.Commands,
+			prefixes: prefixes,
+		})
+	}
+
+	return &CommandAuthenticator{
+		trust:       trust,
+		rules:       rules,
+		lastSigTime: make(map[string]time.Time),
+	}, nil
+}
+
+// String returns the log identifier of the authenticator.
+func (a *CommandAuthenticator) String() string {
+	return "mgmt-command-auth"
+}
+
+// isControlCommand returns whether the verb of the module is a control command.
+func isControlCommand(module string, verb string) bool {
+	return !slices.Contains(datasetVerbs[module], verb)
+}
+
+// Authenticate checks that a control command is signed by a trusted signer
+// that is authorized to run it. Commands over /localhost are accepted unless
+// configured otherwise. This must be called from the management thread.
+func (a *CommandAuthenticator) Authenticate(interest *Interest, module string, verb string) error {
+	if LOCAL_PREFIX.IsPrefix(interest.Name()) && !core.C.Mgmt.Security.ValidateLocalhost {
+		return nil
+	}
+
+	sig := interest.Signature()
+	keyLocator := sig.KeyName()
+	if sig.SigType() == ndn.SignatureNone || len(keyLocator) == 0 {
+		return errors.New("command is not signed")
+	}
+
+	// Reject old and replayed commands
+	sigTime := sig.SigTime()
+	if sigTime == nil {
+		return errors.New("command has no signature time")
+	}
+	if diff := time.Since(*sigTime); diff > CommandTimestampGrace || diff < -CommandTimestampGrace {
+		return fmt.Errorf("signature time is out of range: %s", sigTime)
+	}
+	if last, ok := a.lastSigTime[keyLocator.String()]; ok && !sigTime.After(last) {
+		return errors.New("command is replayed")
+	}
+
+	// Validate the certificate of the signer
+	cert, certSigCov, err := a.trust.findCert(keyLocator)
+	if err != nil {
+		return err
+	}
+	if slices.ContainsFunc(a.trust.anchors, cert.Name().Equal) {
+		if sec.CertIsExpired(cert) {
+			return fmt.Errorf("certificate is expired: %s", cert.Name())
+		}
+	} else if err := a.trust.validate(cert, certSigCov); err != nil {
+		return fmt.Errorf("untrusted certificate %s: %w", cert.Name(), err)
+	}
+
+	// Check the command name against the trust schema, without the digest of the parameters
+	name := interest.Name()
+	if name.At(-1).Typ == enc.TypeParametersSha256DigestComponent {
+		name = name.Prefix(-1)
+	}
+	if !a.trust.schema.Check(name, cert.Name()) {
+		return fmt.Errorf("trust schema mismatch: %s signed by %s", name, cert.Name())
+	}
+
+	// Validate the signature of the command
+	valid, err := signer.ValidateInterest(interest, interest.sigCovered, cert)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return errors.New("signature is invalid")
+	}
+
+	// Check the authorization rules
+	if !a.authorize(cert.Name(), module, verb, a.commandTarget(interest)) {
+		return fmt.Errorf("%s is not authorized to run %s/%s", keyLocator, module, verb)
+	}
+
+	a.recordSigTime(keyLocator, *sigTime)
+	return nil
+}
+
+// recordSigTime records the signature time of an accepted command, and removes the
+// times that are too old to accept a replayed command.
+func (a *CommandAuthenticator) recordSigTime(keyLocator enc.Name, sigTime time.Time) {
+	expired := time.Now().Add(-CommandTimestampGrace)
+	for key, last := range a.lastSigTime {
+		if last.Before(expired) {
+			delete(a.lastSigTime, key)
+		}
+	}
+	a.lastSigTime[keyLocator.String()] = sigTime
+}
+
+// commandTarget returns the name that the command acts on, if any.
+func (a *CommandAuthenticator) commandTarget(interest *Interest) enc.Name {
+	if len(interest.Name()) < len(LOCAL_PREFIX)+3 {
+		return nil
+	}
+	paramVal := interest.Name()[len(LOCAL_PREFIX)+2].Val
+	params, err := mgmt.ParseControlParameters(enc.NewBufferView(paramVal), true)
+	if err != nil || params.Val == nil {
+		return nil
+	}
+	return params.Val.Name
+}
+
+// authorize checks whether any rule allows the signer to run the command on the target name.
+// Rules restricted to prefixes allow commands without a target name only if they list them
+// explicitly. All trusted signers are authorized if there are no rules.
+func (a *CommandAuthenticator) authorize(certName enc.Name, module string, verb string, target enc.Name) bool {
+	if len(a.rules) == 0 {
+		return true
+	}
+
+	for _, rule := range a.rules {
+		if !rule.signer.IsPrefix(certName) {
+			continue
+		}
+		if !slices.Contains(rule.commands, "*") &&
+			!slices.Contains(rule.commands, module) &&
+			!slices.Contains(rule.commands, module+"/"+verb) {
+			continue
+		}
+		if len(rule.prefixes) > 0 {
+			// Commands without a name are only allowed if listed explicitly
+			if target == nil {
+				if !slices.Contains(rule.commands, module) && !slices.Contains(rule.commands, module+"/"+verb) {
+					continue
+				}
+			} else if !slices.ContainsFunc(rule.prefixes, func(prefix enc.Name) bool { return prefix.IsPrefix(target) }) {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// newResponseSigner returns the signer of the key of the configured identity.
+func newResponseSigner(trust *localTrust) (ndn.Signer, error) {
+	identityName, err := enc.NameFromStr(core.C.Mgmt.Security.Identity)
+	if err != nil || len(identityName) == 0 {
+		return nil, fmt.Errorf("invalid identity %q", core.C.Mgmt.Security.Identity)
+	}
+
+	identity := trust.keychain.IdentityByName(identityName)
+	if identity == nil || len(identity.Keys()) == 0 {
+		return nil, fmt.Errorf("no key for identity %s in keychain", identityName)
+	}
+	return identity.Keys()[0].Signer(), nil
+}
@@ -0,0 +1,155 @@
+package mgmt
+
+import (
+	"testing"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	basic_engine "github.com/named-data/ndnd/std/engine/basic"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/object/storage"
+	sec "github.com/named-data/ndnd/std/security"
+	"github.com/named-data/ndnd/std/security/keychain"
+	sig "github.com/named-data/ndnd/std/security/signer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsControlCommand(t *testing.T) {
+	tests := []struct {
+		module  string
+		verb    string
+		control bool
+	}{
+		{"faces", "list", false},
+		{"faces", "events", false},
+		{"status", "general", false},
+		{"cs", "info", false},
+		{"faces", "create", true},
+		{"rib", "register", true},
+		{"rib", "announce", true},
+		{"cs", "erase", true},
+		// Unknown verbs and modules are denied by default
+		{"faces", "frobnicate", true},
+		{"unknown", "list", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.module+"/"+tt.verb, func(t *testing.T) {
+			assert.Equal(t, tt.control, isControlCommand(tt.module, tt.verb))
+		})
+	}
+}
+
+func TestAuthenticateWithoutKeychain(t *testing.T) {
+	m := &Thread{}
+	command := func(prefix enc.Name) *Interest {
+		name := prefix.
+			Append(enc.NewGenericComponent("rib")).
+			Append(enc.NewGenericComponent("register"))
+		return &Interest{Interest: spec.Interest{NameV: name}}
+	}
+
+	// Commands over /localhop cannot be validated, so they are rejected
+	assert.NoError(t, m.authenticate(command(LOCAL_PREFIX), "rib", "register"))
+	assert.Error(t, m.authenticate(command(NON_LOCAL_PREFIX), "rib", "register"))
+}
+
+func TestCommandSigTimeExpiry(t *testing.T) {
+	a := &CommandAuthenticator{lastSigTime: make(map[string]time.Time)}
+	key := func(s string) enc.Name {
+		name, _ := enc.NameFromStr(s)
+		return name
+	}
+
+	now := time.Now()
+	a.lastSigTime[key("/a/KEY/1").String()] = now.Add(-2 * CommandTimestampGrace)
+	a.lastSigTime[key("/b/KEY/1").String()] = now.Add(-CommandTimestampGrace / 2)
+
+	// Times of signers outside the grace period are removed
+	a.recordSigTime(key("/c/KEY/1"), now)
+	assert.Len(t, a.lastSigTime, 2)
+	assert.NotContains(t, a.lastSigTime, key("/a/KEY/1").String())
+	assert.Contains(t, a.lastSigTime, key("/b/KEY/1").String())
+}
+
+func TestAuthenticateSentCommand(t *testing.T) {
+	// Keychain of the receiving forwarder, with the self-signed certificate of the sender
+	dir := t.TempDir()
+	kc, err := keychain.NewKeyChainDir(dir, storage.NewMemoryStore())
+	require.NoError(t, err)
+	senderName, _ := enc.NameFromStr("/ndn/sender")
+	senderKey, err := sig.KeygenEd25519(sec.MakeKeyName(senderName))
+	require.NoError(t, err)
+	require.NoError(t, kc.InsertKey(senderKey))
+
+	secret, err := sig.MarshalSecret(senderKey)
+	require.NoError(t, err)
+	keyData, _, err := spec.Spec{}.ReadData(enc.NewWireView(secret))
+	require.NoError(t, err)
+	cert, err := sec.SignCert(sec.SignCertArgs{
+		Signer:    senderKey,
+		Data:      keyData,
+		IssuerId:  enc.NewGenericComponent("self"),
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().AddDate(1, 0, 0),
+	})
+	require.NoError(t, err)
+	require.NoError(t, kc.InsertCert(cert.Join()))
+	certData, _, err := spec.Spec{}.ReadData(enc.NewWireView(cert))
+	require.NoError(t, err)
+
+	trust, err := loadLocalTrust("dir://"+dir, "", []string{certData.Name().String()})
+	require.NoError(t, err)
+	receiver, err := NewCommandAuthenticator(trust)
+	require.NoError(t, err)
+
+	// Commands sent by the management thread of the sender
+	sender := &Thread{timer: basic_engine.NewTimer(), signer: senderKey}
+	name, _ := enc.NameFromStr("/localhop/nfd/rib/announce")
+	receive := func() *Interest {
+		encoded, err := sender.makeInterest(name, enc.Wire{})
+		require.NoError(t, err)
+		pkt, ctx, err := spec.ReadPacket(enc.NewWireView(encoded.Wire))
+		require.NoError(t, err)
+		return &Interest{Interest: *pkt.Interest, sigCovered: ctx.Interest_context.SigCovered()}
+	}
+
+	first := receive()
+	assert.NoError(t, receiver.Authenticate(first, "rib", "announce"))
+	assert.Error(t, receiver.Authenticate(first, "rib", "announce"))
+
+	// Commands sent in the same millisecond are not rejected as replayed
+	assert.NoError(t, receiver.Authenticate(receive(), "rib", "announce"))
+	assert.NoError(t, receiver.Authenticate(receive(), "rib", "announce"))
+}
+
+func TestAuthorizeCommandWithoutTarget(t *testing.T) {
+	name := func(s string) enc.Name {
+		n, _ := enc.NameFromStr(s)
+		return n
+	}
+	a := &CommandAuthenticator{rules: []commandAuthRule{{
+		signer:   name("/op"),
+		commands: []string{"*"},
+		prefixes: []enc.Name{name("/app")},
+	}, {
+		signer:   name("/admin"),
+		commands: []string{"rib", "faces/create"},
+		prefixes: []enc.Name{name("/app")},
+	}}}
+	op, admin := name("/op/KEY/1"), name("/admin/KEY/1")
+
+	assert.True(t, a.authorize(op, "rib", "register", name("/app/video")))
+	assert.False(t, a.authorize(op, "rib", "register", name("/other")))
+	assert.True(t, a.authorize(admin, "rib", "register", name("/app")))
+	assert.False(t, a.authorize(admin, "rib", "register", name("/other")))
+
+	// Prefix restrictions are not bypassed by commands without a name,
+	// unless the rule lists the command explicitly
+	assert.False(t, a.authorize(op, "faces", "create", nil))
+	assert.False(t, a.authorize(op, "cs", "erase", nil))
+	assert.True(t, a.authorize(admin, "faces", "create", nil))
+	assert.True(t, a.authorize(admin, "rib", "announce", nil))
+	assert.False(t, a.authorize(admin, "faces", "destroy", nil))
+}
@@ -8,15 +8,21 @@
 package mgmt
 
 import (
+	"time"
+
 	"github.com/named-data/ndnd/fw/core"
-	"github.com/named-data/ndnd/fw/dispatch"
-	"github.com/named-data/ndnd/fw/fw"
 	"github.com/named-data/ndnd/fw/table"
 	enc "github.com/named-data/ndnd/std/encoding"
 	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
 	"github.com/named-data/ndnd/std/types/optional"
 )
 
+// CsEraseLimit is the maximum number of CS entries erased by a single command.
+const CsEraseLimit = 256
+
+// CsQueryLimit is the maximum number of CS entries listed by a single query.
+const CsQueryLimit = 4096
+
 // ContentStoreModule is the module that handles Content Store Management.
 type ContentStoreModule struct {
 	manager *Thread
@@ -51,10 +57,11 @@ func (c *ContentStoreModule) handleIncomingInterest(interest *Interest) {
 	case "config":
 		c.config(interest)
 	case "erase":
-		// TODO
-		//c.erase(interest)
+		c.erase(interest)
 	case "info":
 		c.info(interest)
+	case "query":
+		c.query(interest)
 	default:
 		core.Log.Warn(c, "Received Interest for non-existent verb", "verb", verb)
 		c.manager.sendCtrlResp(interest, 501, "Unknown verb", nil)
@@ -88,6 +95,23 @@ func (c *ContentStoreModule) config(interest *Interest) {
 		table.CfgSetCsCapacity(int(capacity))
 	}
 
+	if capacityBytes, ok := params.CapacityBytes.Get(); ok {
+		core.Log.Info(c, "Setting CS byte capacity", "capacity", capacityBytes)
+		table.CfgSetCsCapacityBytes(int(capacityBytes))
+	}
+
+	// Shrink the CS of all forwarding threads to the new capacity
+	if params.Capacity.IsSet() || params.CapacityBytes.IsSet() {
+		for _, thread := range c.manager.threads {
+			err := thread.RunTask(func() {
+				thread.PitCs().EvictCsEntries()
+			})
+			if err != nil {
+				core.Log.Warn(c, "Unable to shrink CS", "thread", thread.GetID(), "err", err)
+			}
+		}
+	}
+
 	if params.Mask.IsSet() && params.Flags.IsSet() {
 		mask := params.Mask.Unwrap()
 		flags := params.Flags.Unwrap()
@@ -106,11 +130,64 @@ func (c *ContentStoreModule) config(interest *Interest) {
 	}
 
 	c.manager.sendCtrlResp(interest, 200, "OK", &mgmt.ControlArgs{
-		Capacity: optional.Some(uint64(table.CfgCsCapacity())),
-		Flags:    optional.Some(c.getFlags()),
+		Capacity:      optional.Some(uint64(table.CfgCsCapacity())),
+		CapacityBytes: optional.Some(uint64(table.CfgCsCapacityBytes())),
+		Flags:         optional.Some(c.getFlags()),
 	})
 }
 
+// erase erases cached Data under a prefix from the CS of all forwarding threads.
+// At most Count (or CsEraseLimit) entries are erased. If the limit is reached,
+// the response includes the limit as Capacity, and more entries may remain.
+func (c *ContentStoreModule) erase(interest *Interest) {
+	if len(interest.Name()) < len(LOCAL_PREFIX)+3 {
+		// Name not long enough to contain ControlParameters
+		core.Log.Warn(c, "Missing ControlParameters", "name", interest.Name())
+		c.manager.sendCtrlResp(interest, 400, "ControlParameters is incorrect", nil)
+		return
+	}
+
+	params := decodeControlParameters(c, interest)
+	if params == nil || params.Name == nil {
+		c.manager.sendCtrlResp(interest, 400, "ControlParameters is incorrect", nil)
+		return
+	}
+
+	limit := uint64(CsEraseLimit)
+	if count, ok := params.Count.Get(); ok {
+		if count == 0 {
+			c.manager.sendCtrlResp(interest, 400, "Count must be positive", nil)
+			return
+		}
+		limit = min(count, limit)
+	}
+
+	// The CS is owned by the forwarding threads
+	nErased := 0
+	for _, thread := range c.manager.threads {
+		if nErased >= int(limit) {
+			break
+		}
+		err := thread.RunTask(func() {
+			nErased += thread.PitCs().EraseCsDataByPrefix(params.Name, int(limit)-nErased)
+		})
+		if err != nil {
+			core.Log.Warn(c, "Unable to erase CS entries", "thread", thread.GetID(), "err", err)
+		}
+	}
+
+	core.Log.Info(c, "Erased CS entries", "name", params.Name, "count", nErased)
+
+	response := &mgmt.ControlArgs{
+		Name:  params.Name,
+		Count: optional.Some(uint64(nErased)),
+	}
+	if nErased >= int(limit) {
+		response.Capacity = optional.Some(limit)
+	}
+	c.manager.sendCtrlResp(interest, 200, "OK", response)
+}
+
 // (AI GENERATED DESCRIPTION): Collects content‑store statistics from all threads and replies to the Interest with a status dataset containing the CS capacity, flags, entry count, hit and miss counts.
 func (c *ContentStoreModule) info(interest *Interest) {
 	if len(interest.Name()) > len(LOCAL_PREFIX)+2 {
@@ -121,16 +198,16 @@ func (c *ContentStoreModule) info(interest *Interest) {
 	// Generate new dataset
 	status := mgmt.CsInfoMsg{
 		CsInfo: &mgmt.CsInfo{
-			Capacity:   uint64(table.CfgCsCapacity()),
-			Flags:      c.getFlags(),
-			NCsEntries: 0,
+			Capacity:      uint64(table.CfgCsCapacity()),
+			CapacityBytes: optional.Some(uint64(table.CfgCsCapacityBytes())),
+			Flags:         c.getFlags(),
+			NCsEntries:    uint64(table.CsTotalEntries()),
+			NCsBytes:      optional.Some(uint64(table.CsTotalBytes())),
 		},
 	}
-	for threadID := 0; threadID < fw.CfgNumThreads(); threadID++ {
-		thread := dispatch.GetFWThread(threadID)
+	for _, thread := range c.manager.threads {
 		counters := thread.Counters()
 
-		status.CsInfo.NCsEntries += uint64(counters.NCsEntries)
 		status.CsInfo.NHits += uint64(counters.NCsHits)
 		status.CsInfo.NMisses += uint64(counters.NCsMisses)
 	}
@@ -141,6 +218,51 @@ func (c *ContentStoreModule) info(interest *Interest) {
 	c.manager.sendStatusDataset(interest, name, status.Encode())
 }
 
+// query replies with a dataset of the cached Data of all forwarding threads.
+// The optional CsQueryFilter selects a prefix and the maximum number of entries.
+func (c *ContentStoreModule) query(interest *Interest) {
+	filter := &mgmt.CsQueryFilterValue{Name: enc.Name{}}
+	if len(interest.Name()) > len(LOCAL_PREFIX)+2 {
+		filterV, err := mgmt.ParseCsQueryFilter(enc.NewBufferView(interest.Name()[len(LOCAL_PREFIX)+2].Val), true)
+		if err != nil || filterV == nil || filterV.Val == nil {
+			return
+		}
+		filter = filterV.Val
+	}
+
+	limit := uint64(CsQueryLimit)
+	if count, ok := filter.Count.Get(); ok {
+		limit = min(count, limit)
+	}
+
+	// The CS is owned by the forwarding threads
+	dataset := &mgmt.CsQueryStatus{}
+	for _, thread := range c.manager.threads {
+		if len(dataset.Entries) >= int(limit) {
+			break
+		}
+		err := thread.RunTask(func() {
+			now := time.Now()
+			for _, entry := range thread.PitCs().FindCsDataByPrefix(filter.Name, int(limit)-len(dataset.Entries)) {
+				status := &mgmt.CsQuery{
+					Name:       entry.Name().Clone(),
+					ThreadId:   uint64(thread.GetID()),
+					PacketSize: uint64(entry.Size()),
+				}
+				if fresh := entry.StaleTime().Sub(now); fresh > 0 {
+					status.FreshnessPeriod = optional.Some(uint64(fresh.Milliseconds()))
+				}
+				dataset.Entries = append(dataset.Entries, status)
+			}
+		})
+		if err != nil {
+			core.Log.Warn(c, "Unable to query CS entries", "thread", thread.GetID(), "err", err)
+		}
+	}
+
+	c.manager.sendStatusDataset(interest, interest.Name(), dataset.Encode())
+}
+
 // (AI GENERATED DESCRIPTION): Generates a 64‑bit flag mask indicating which content‑store features (admit and serve) are enabled, based on the current configuration.
 func (c *ContentStoreModule) getFlags() uint64 {
 	flags := uint64(0)
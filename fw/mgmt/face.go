@@ -11,19 +11,29 @@ import (
 	"math"
 	"net"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/named-data/ndnd/fw/core"
 	"github.com/named-data/ndnd/fw/defn"
 	"github.com/named-data/ndnd/fw/face"
 	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
 	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
 	"github.com/named-data/ndnd/std/types/optional"
 )
 
+// FaceEventFreshness is the freshness period of face event notifications.
+const FaceEventFreshness = time.Second
+
 // FaceModule is the module that handles Face Management.
 type FaceModule struct {
 	manager *Thread
+
+	// sequence number of the next face event notification
+	eventSeq   uint64
+	eventMutex sync.Mutex
 }
 
 // (AI GENERATED DESCRIPTION): Returns the fixed identifier string for the Face module (`"mgmt-face"`), used for naming and logging.
@@ -62,6 +72,9 @@ func (f *FaceModule) handleIncomingInterest(interest *Interest) {
 		f.list(interest)
 	case "query":
 		f.query(interest)
+	case "events":
+		// Subscribers to the notification stream wait for the next event
+		return
 	default:
 		core.Log.Warn(f, "Received Interest for non-existent verb", "verb", verb)
 		f.manager.sendCtrlResp(interest, 501, "Unknown verb", nil)
@@ -69,7 +82,7 @@ func (f *FaceModule) handleIncomingInterest(interest *Interest) {
 	}
 }
 
-// (AI GENERATED DESCRIPTION): Creates a new unicast UDP or TCP face from the supplied ControlParameters, performing validation, configuring the transport and NDNLP link service, and replying with the face properties or an error status.
+// (AI GENERATED DESCRIPTION): Creates a new unicast UDP, TCP or Ethernet face from the supplied ControlParameters, performing validation, configuring the transport and NDNLP link service, and replying with the face properties or an error status.
 func (f *FaceModule) create(interest *Interest) {
 	if len(interest.Name()) < len(LOCAL_PREFIX)+3 {
 		f.manager.sendCtrlResp(interest, 400, "ControlParameters is incorrect", nil)
@@ -98,8 +111,15 @@ func (f *FaceModule) create(interest *Interest) {
 		return
 	}
 
-	// Ensure does not conflict with existing face
-	existingFace := face.FaceTable.GetByURI(URI)
+	// Ensure does not conflict with existing face.
+	// Ethernet faces are identified by both the remote address and the interface.
+	existingFace := f.manager.faces.GetByURI(URI)
+	if URI.Scheme() == "ether" {
+		existingFace = nil
+		if localURI := defn.DecodeURIString(params.LocalUri.GetOr("")); localURI != nil {
+			existingFace = f.manager.faces.GetByURIs(URI, localURI)
+		}
+	}
 	if existingFace != nil {
 		core.Log.Warn(f, "Cannot create face, conflicts with existing face",
 			"faceid", existingFace.FaceID(), "uri", existingFace.RemoteURI())
@@ -134,17 +154,6 @@ func (f *FaceModule) create(interest *Interest) {
 			return
 		}
 
-		// Check congestion control
-		baseCongestionMarkingInterval := 100 * time.Millisecond
-		if bcmi, ok := params.BaseCongestionMarkInterval.Get(); ok {
-			baseCongestionMarkingInterval = time.Duration(bcmi) * time.Nanosecond
-		}
-
-		defaultCongestionThresholdBytes := uint64(math.Pow(2, 16))
-		if dct, ok := params.DefaultCongestionThreshold.Get(); ok {
-			defaultCongestionThresholdBytes = dct
-		}
-
 		// Create new UDP face
 		transport, err := face.MakeUnicastUDPTransport(URI, nil, persistency)
 		if err != nil {
@@ -158,35 +167,10 @@ func (f *FaceModule) create(interest *Interest) {
 		}
 
 		// NDNLP link service parameters
-		options := face.MakeNDNLPLinkServiceOptions()
-		if params.Flags.IsSet() && params.Mask.IsSet() {
-			// Mask already guaranteed to be present if Flags is above
-			flags := params.Flags.Unwrap()
-			mask := params.Mask.Unwrap()
-
-			if mask&face.FaceFlagLocalFields > 0 {
-				// LocalFieldsEnabled
-				if flags&face.FaceFlagLocalFields > 0 {
-					options.IsConsumerControlledForwardingEnabled = true
-					options.IsIncomingFaceIndicationEnabled = true
-					options.IsLocalCachePolicyEnabled = true
-				} else {
-					options.IsConsumerControlledForwardingEnabled = false
-					options.IsIncomingFaceIndicationEnabled = false
-					options.IsLocalCachePolicyEnabled = false
-				}
-			}
-
-			// Congestion control
-			if mask&face.FaceFlagCongestionMarking > 0 {
-				// CongestionMarkingEnabled
-				options.IsCongestionMarkingEnabled = flags&face.FaceFlagCongestionMarking > 0
-			}
-			options.BaseCongestionMarkingInterval = baseCongestionMarkingInterval
-			options.DefaultCongestionThresholdBytes = defaultCongestionThresholdBytes
-		}
+		options := f.makeLinkServiceOptions(params)
 
 		linkService = face.MakeNDNLPLinkService(transport, options)
+		linkService.SetFaceTable(f.manager.faces)
 		linkService.Run(nil)
 	} else if URI.Scheme() == "tcp4" || URI.Scheme() == "tcp6" {
 		// Validate that remote endpoint is an IP address
@@ -211,17 +195,6 @@ func (f *FaceModule) create(interest *Interest) {
 			return
 		}
 
-		// Check congestion control
-		baseCongestionMarkingInterval := 100 * time.Millisecond
-		if bcmi, ok := params.BaseCongestionMarkInterval.Get(); ok {
-			baseCongestionMarkingInterval = time.Duration(bcmi) * time.Nanosecond
-		}
-
-		defaultCongestionThresholdBytes := uint64(math.Pow(2, 16))
-		if dct, ok := params.DefaultCongestionThreshold.Get(); ok {
-			defaultCongestionThresholdBytes = dct
-		}
-
 		// Create new TCP face
 		transport, err := face.MakeUnicastTCPTransport(URI, nil, persistency)
 		if err != nil {
@@ -235,36 +208,53 @@ func (f *FaceModule) create(interest *Interest) {
 		}
 
 		// NDNLP link service parameters
-		options := face.MakeNDNLPLinkServiceOptions()
+		options := f.makeLinkServiceOptions(params)
 		options.IsFragmentationEnabled = false // reliable stream
-		if params.Flags.IsSet() && params.Mask.IsSet() {
-			// Mask already guaranteed to be present if Flags is above
-			flags := params.Flags.Unwrap()
-			mask := params.Mask.Unwrap()
 
-			if mask&face.FaceFlagLocalFields > 0 {
-				// LocalFieldsEnabled
-				if flags&face.FaceFlagLocalFields > 0 {
-					options.IsConsumerControlledForwardingEnabled = true
-					options.IsIncomingFaceIndicationEnabled = true
-					options.IsLocalCachePolicyEnabled = true
-				} else {
-					options.IsConsumerControlledForwardingEnabled = false
-					options.IsIncomingFaceIndicationEnabled = false
-					options.IsLocalCachePolicyEnabled = false
-				}
-			}
+		linkService = face.MakeNDNLPLinkService(transport, options)
+		linkService.SetFaceTable(f.manager.faces)
+		linkService.Run(nil)
+	} else if URI.Scheme() == "ether" {
+		// Validate that remote endpoint is a unicast address
+		remoteAddr, _ := net.ParseMAC(URI.Path())
+		if remoteAddr[0]&0x01 != 0 {
+			f.manager.sendCtrlResp(interest, 406, "URI must be unicast", nil)
+			return
+		}
 
-			// Congestion control
-			if mask&face.FaceFlagCongestionMarking > 0 {
-				// CongestionMarkingEnabled
-				options.IsCongestionMarkingEnabled = flags&face.FaceFlagCongestionMarking > 0
-			}
-			options.BaseCongestionMarkingInterval = baseCongestionMarkingInterval
-			options.DefaultCongestionThresholdBytes = defaultCongestionThresholdBytes
+		// Ethernet faces need the local interface
+		localURI := defn.DecodeURIString(params.LocalUri.GetOr(""))
+		if !params.LocalUri.IsSet() || localURI.Scheme() != "dev" || !localURI.IsCanonical() {
+			f.manager.sendCtrlResp(interest, 406, "LocalUri must be a network device", nil)
+			return
+		}
+
+		// Check face persistency
+		persistency := mgmt.PersistencyPersistent
+		if pers, ok := params.FacePersistency.Get(); ok && (pers == uint64(mgmt.PersistencyPersistent) || pers == uint64(mgmt.PersistencyPermanent)) {
+			persistency = mgmt.Persistency(pers)
+		} else if params.FacePersistency.IsSet() {
+			f.manager.sendCtrlResp(interest, 406, "Unacceptable persistency", nil)
+			return
 		}
 
+		// Create new Ethernet face
+		transport, err := face.MakeUnicastEthernetTransport(URI, localURI, persistency)
+		if err != nil {
+			core.Log.Warn(f, "Unable to create unicast Ethernet face", "uri", URI, "err", err)
+			f.manager.sendCtrlResp(interest, 406, "Transport error", nil)
+			return
+		}
+
+		if mtu, ok := params.Mtu.Get(); ok {
+			transport.SetMTU(min(int(mtu), transport.MTU()))
+		}
+
+		// NDNLP link service parameters
+		options := f.makeLinkServiceOptions(params)
+
 		linkService = face.MakeNDNLPLinkService(transport, options)
+		linkService.SetFaceTable(f.manager.faces)
 		linkService.Run(nil)
 	} else {
 		f.manager.sendCtrlResp(interest, 406, "Unsupported scheme "+URI.Scheme(), nil)
@@ -284,6 +274,56 @@ func (f *FaceModule) create(interest *Interest) {
 	core.Log.Info(f, "Created face", "uri", URI)
 }
 
+// makeLinkServiceOptions returns the NDNLP link service options requested in ControlParameters.
+func (f *FaceModule) makeLinkServiceOptions(params *mgmt.ControlArgs) face.NDNLPLinkServiceOptions {
+	// Check congestion control
+	baseCongestionMarkingInterval := 100 * time.Millisecond
+	if bcmi, ok := params.BaseCongestionMarkInterval.Get(); ok {
+		baseCongestionMarkingInterval = time.Duration(bcmi) * time.Nanosecond
+	}
+
+	defaultCongestionThresholdBytes := uint64(math.Pow(2, 16))
+	if dct, ok := params.DefaultCongestionThreshold.Get(); ok {
+		defaultCongestionThresholdBytes = dct
+	}
+
+	options := face.MakeNDNLPLinkServiceOptions()
+	if params.Flags.IsSet() && params.Mask.IsSet() {
+		// Mask already guaranteed to be present if Flags is above
+		flags := params.Flags.Unwrap()
+		mask := params.Mask.Unwrap()
+
+		if mask&face.FaceFlagLocalFields > 0 {
+			// LocalFieldsEnabled
+			if flags&face.FaceFlagLocalFields > 0 {
+				options.IsConsumerControlledForwardingEnabled = true
+				options.IsIncomingFaceIndicationEnabled = true
+				options.IsLocalCachePolicyEnabled = true
+			} else {
+				options.IsConsumerControlledForwardingEnabled = false
+				options.IsIncomingFaceIndicationEnabled = false
+				options.IsLocalCachePolicyEnabled = false
+			}
+		}
+
+		// Link-layer reliability
+		if mask&face.FaceFlagLpReliabilityEnabled > 0 {
+			// LpReliabilityEnabled
+			options.IsReliabilityEnabled = flags&face.FaceFlagLpReliabilityEnabled > 0
+		}
+
+		// Congestion control
+		if mask&face.FaceFlagCongestionMarking > 0 {
+			// CongestionMarkingEnabled
+			options.IsCongestionMarkingEnabled = flags&face.FaceFlagCongestionMarking > 0
+		}
+		options.BaseCongestionMarkingInterval = baseCongestionMarkingInterval
+		options.DefaultCongestionThresholdBytes = defaultCongestionThresholdBytes
+	}
+
+	return options
+}
+
 // (AI GENERATED DESCRIPTION): Updates a specified NDN face according to the ControlParameters carried in an incoming Interest, validating and applying changes such as persistency, MTU, congestion options, and flag settings, then responds with the updated face properties.
 func (f *FaceModule) update(interest *Interest) {
 	if len(interest.Name()) < len(LOCAL_PREFIX)+3 {
@@ -306,7 +346,7 @@ func (f *FaceModule) update(interest *Interest) {
 	responseParams := &mgmt.ControlArgs{}
 	areParamsValid := true
 
-	selectedFace := face.FaceTable.Get(faceID)
+	selectedFace := f.manager.faces.Get(faceID)
 	if selectedFace == nil {
 		core.Log.Warn(f, "Cannot update specified (or implicit) face because it does not exist", "faceid", faceID)
 		f.manager.sendCtrlResp(interest, 404, "Face does not exist", &mgmt.ControlArgs{FaceId: optional.Some(faceID)})
@@ -320,7 +360,8 @@ func (f *FaceModule) update(interest *Interest) {
 	}
 
 	if pers, ok := params.FacePersistency.Get(); ok {
-		if selectedFace.RemoteURI().Scheme() == "ether" && pers != uint64(mgmt.PersistencyPermanent) {
+		if selectedFace.RemoteURI().Scheme() == "ether" && selectedFace.LinkType() == defn.MultiAccess &&
+			pers != uint64(mgmt.PersistencyPermanent) {
 			responseParams.FacePersistency = params.FacePersistency
 			areParamsValid = false
 		} else if (selectedFace.RemoteURI().Scheme() == "udp4" || selectedFace.RemoteURI().Scheme() == "udp6") &&
@@ -398,6 +439,15 @@ func (f *FaceModule) update(interest *Interest) {
 				}
 			}
 
+			if mask&face.FaceFlagLpReliabilityEnabled > 0 {
+				options.IsReliabilityEnabled = flags&face.FaceFlagLpReliabilityEnabled > 0
+				if flags&face.FaceFlagLpReliabilityEnabled > 0 {
+					core.Log.Info(f, "Enable link-layer reliability", "faceid", faceID)
+				} else {
+					core.Log.Info(f, "Disable link-layer reliability", "faceid", faceID)
+				}
+			}
+
 			if mask&face.FaceFlagCongestionMarking > 0 {
 				options.IsCongestionMarkingEnabled = flags&face.FaceFlagCongestionMarking > 0
 				if flags&face.FaceFlagCongestionMarking > 0 {
@@ -411,6 +461,8 @@ func (f *FaceModule) update(interest *Interest) {
 		lpLinkService.SetOptions(options)
 	}
 
+	f.manager.faces.NotifyEvent(mgmt.FaceEventUpdated, selectedFace)
+
 	f.fillFaceProperties(responseParams, selectedFace)
 	responseParams.Uri.Unset()
 	responseParams.LocalUri.Unset()
@@ -435,7 +487,7 @@ func (f *FaceModule) destroy(interest *Interest) {
 		return
 	}
 
-	if link := face.FaceTable.Get(params.FaceId.Unwrap()); link != nil {
+	if link := f.manager.faces.Get(params.FaceId.Unwrap()); link != nil {
 		link.Close()
 		core.Log.Info(f, "Destroyed face", "faceid", params.FaceId.Unwrap())
 	} else {
@@ -455,7 +507,7 @@ func (f *FaceModule) list(interest *Interest) {
 	// Generate new dataset
 	faces := make(map[uint64]face.LinkService)
 	faceIDs := make([]uint64, 0)
-	for _, face := range face.FaceTable.GetAll() {
+	for _, face := range f.manager.faces.GetAll() {
 		faces[face.FaceID()] = face
 		faceIDs = append(faceIDs, face.FaceID())
 	}
@@ -501,7 +553,7 @@ func (f *FaceModule) query(interest *Interest) {
 	}
 
 	// filter all faces to match filter
-	faces := face.FaceTable.GetAll()
+	faces := f.manager.faces.GetAll()
 	matchingFaces := make([]int, 0)
 	for pos, face := range faces {
 		if fid, ok := filter.FaceId.Get(); ok && fid != face.FaceID() {
@@ -548,6 +600,55 @@ func (f *FaceModule) query(interest *Interest) {
 	f.manager.sendStatusDataset(interest, interest.Name(), dataset.Encode())
 }
 
+// publishEvent publishes a face event notification to subscribers of faces/events.
+// The notification is sent without a PIT token, so it satisfies all pending Interests
+// for the stream, and is cached for late subscribers until it becomes stale.
+func (f *FaceModule) publishEvent(kind uint64, link face.LinkService) {
+	// Do not notify about the management face, which may be shutting down
+	if link.FaceID() == f.manager.face.FaceID() {
+		return
+	}
+
+	status := f.createDataset(link)
+	notif := &mgmt.FaceEventNotification{
+		Val: &mgmt.FaceEventNotificationValue{
+			FaceEventKind:   kind,
+			FaceId:          status.FaceId,
+			Uri:             status.Uri,
+			LocalUri:        status.LocalUri,
+			FaceScope:       status.FaceScope,
+			FacePersistency: status.FacePersistency,
+			LinkType:        status.LinkType,
+			Flags:           status.Flags,
+		},
+	}
+
+	f.eventMutex.Lock()
+	defer f.eventMutex.Unlock()
+
+	name := LOCAL_PREFIX.
+		Append(enc.NewGenericComponent("faces")).
+		Append(enc.NewGenericComponent("events")).
+		Append(enc.NewSequenceNumComponent(f.eventSeq))
+	f.eventSeq++
+
+	data, err := spec.Spec{}.MakeData(name,
+		&ndn.DataConfig{
+			ContentType: optional.Some(ndn.ContentTypeBlob),
+			Freshness:   optional.Some(FaceEventFreshness),
+		},
+		notif.Encode(),
+		f.manager.signer,
+	)
+	if err != nil {
+		core.Log.Warn(f, "Unable to encode face event", "name", name, "err", err)
+		return
+	}
+
+	f.manager.transport.Send(&spec.LpPacket{Fragment: data.Wire})
+	core.Log.Debug(f, "Published face event", "kind", mgmt.FaceEventKindList[kind], "faceid", link.FaceID())
+}
+
 // (AI GENERATED DESCRIPTION): Creates a `mgmt.FaceStatus` dataset from a `face.LinkService`, populating its identifiers, statistics, and optional NDN‑LP configuration flags for use in management reporting.
 func (f *FaceModule) createDataset(selectedFace face.LinkService) *mgmt.FaceStatus {
 	faceDataset := &mgmt.FaceStatus{
@@ -560,10 +661,10 @@ func (f *FaceModule) createDataset(selectedFace face.LinkService) *mgmt.FaceStat
 		Mtu:             optional.Some(uint64(selectedFace.MTU())),
 		NInInterests:    selectedFace.NInInterests(),
 		NInData:         selectedFace.NInData(),
-		NInNacks:        0,
+		NInNacks:        selectedFace.NInNacks(),
 		NOutInterests:   selectedFace.NOutInterests(),
 		NOutData:        selectedFace.NOutData(),
-		NOutNacks:       0,
+		NOutNacks:       selectedFace.NOutNacks(),
 		NInBytes:        selectedFace.NInBytes(),
 		NOutBytes:       selectedFace.NInBytes(),
 	}
@@ -584,6 +685,11 @@ func (f *FaceModule) createDataset(selectedFace face.LinkService) *mgmt.FaceStat
 		if options.IsCongestionMarkingEnabled {
 			faceDataset.Flags |= face.FaceFlagCongestionMarking
 		}
+		if options.IsReliabilityEnabled {
+			faceDataset.NAcknowledged = optional.Some(linkService.NAcknowledged())
+			faceDataset.NRetransmitted = optional.Some(linkService.NRetransmitted())
+			faceDataset.NRetxExhausted = optional.Some(linkService.NRetxExhausted())
+		}
 	}
 
 	return faceDataset
@@ -0,0 +1,103 @@
+/* YaNFD - Yet another NDN Forwarding Daemon
+ *
+ * Copyright (C) 2020-2021 Eric Newberry.
+ *
+ * This file is licensed under the terms of the MIT License, as found in LICENSE.md.
+ */
+
+package mgmt
+
+import (
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	enc "github.com/named-data/ndnd/std/encoding"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	"github.com/named-data/ndnd/std/types/optional"
+)
+
+// MeasurementsModule is the module that exposes the Measurements tables for debugging.
+type MeasurementsModule struct {
+	manager *Thread
+}
+
+// String returns the name of the module for logging.
+func (m *MeasurementsModule) String() string {
+	return "mgmt-measurements"
+}
+
+// registerManager sets the management thread of the module.
+func (m *MeasurementsModule) registerManager(manager *Thread) {
+	m.manager = manager
+}
+
+// getManager returns the management thread of the module.
+func (m *MeasurementsModule) getManager() *Thread {
+	return m.manager
+}
+
+// handleIncomingInterest dispatches a Measurements management Interest by verb.
+func (m *MeasurementsModule) handleIncomingInterest(interest *Interest) {
+	// Only allow from /localhost
+	if !LOCAL_PREFIX.IsPrefix(interest.Name()) {
+		core.Log.Warn(m, "Received Measurements management Interest from non-local source - DROP")
+		return
+	}
+
+	// Dispatch by verb
+	verb := interest.Name()[len(LOCAL_PREFIX)+1].String()
+	switch verb {
+	case "list":
+		m.list(interest)
+	default:
+		m.manager.sendCtrlResp(interest, 501, "Unknown verb", nil)
+		return
+	}
+}
+
+// list replies with a dataset of the Measurements entries of all forwarding threads.
+func (m *MeasurementsModule) list(interest *Interest) {
+	if len(interest.Name()) > len(LOCAL_PREFIX)+2 {
+		// Ignore because contains version and/or segment components
+		return
+	}
+
+	// Generate new dataset
+	dataset := &mgmt.MeasurementsStatus{}
+	for _, thread := range m.manager.threads {
+		// The Measurements table is owned by the forwarding thread
+		err := thread.RunTask(func() {
+			now := time.Now()
+			for _, entry := range thread.Measurements().GetAll() {
+				status := &mgmt.MeasurementsEntry{
+					Name:     entry.Name(),
+					ThreadId: uint64(thread.GetID()),
+					ExpirationPeriod: optional.Some(
+						uint64(max(entry.ExpirationTime().Sub(now), 0).Milliseconds())),
+				}
+				if entry.LastWorkingFace != 0 {
+					status.LastWorkingFace = optional.Some(entry.LastWorkingFace)
+				}
+				for faceID, info := range entry.Faces() {
+					status.Faces = append(status.Faces, &mgmt.MeasurementsFaceRecord{
+						FaceId:     faceID,
+						Srtt:       uint64(info.Rtt.Srtt()),
+						RttVar:     uint64(info.Rtt.RttVar()),
+						Rto:        uint64(info.Rtt.Rto()),
+						NSatisfied: info.NSatisfied,
+						NTimeouts:  info.NTimeouts,
+					})
+				}
+				dataset.Entries = append(dataset.Entries, status)
+			}
+		})
+		if err != nil {
+			core.Log.Warn(m, "Unable to list Measurements entries", "thread", thread.GetID(), "err", err)
+		}
+	}
+
+	name := LOCAL_PREFIX.
+		Append(enc.NewGenericComponent("measurements")).
+		Append(enc.NewGenericComponent("list"))
+	m.manager.sendStatusDataset(interest, name, dataset.Encode())
+}
@@ -28,9 +28,10 @@ func (r *NlsrReadvertiser) String() string {
 	return "mgmt-nlsr-readvertiser"
 }
 
-// (AI GENERATED DESCRIPTION): Announces a client‑originated route by sending an RIB register interest containing the route’s name, face ID, and cost to the NLSR.
+// Announce readvertises a route registered by a client or created by a Prefix Announcement
+// by sending an RIB register interest containing the route’s name, face ID, and cost to the NLSR.
 func (r *NlsrReadvertiser) Announce(name enc.Name, route *table.Route) {
-	if route.Origin != uint64(spec_mgmt.RouteOriginClient) {
+	if !r.shouldReadvertise(route) {
 		return
 	}
 	core.Log.Info(r, "NlsrAdvertise", "name", name)
@@ -53,12 +54,12 @@ func (r *NlsrReadvertiser) Announce(name enc.Name, route *table.Route) {
 		enc.NewGenericBytesComponent(params.Encode().Join()),
 	}
 
-	r.m.sendInterest(cmd, enc.Wire{})
+	r.m.sendInterest(cmd, enc.Wire{}, optional.None[uint64]())
 }
 
 // (AI GENERATED DESCRIPTION): Sends an unregister interest to the NLSR to withdraw a client‑originated route from the routing table.
 func (r *NlsrReadvertiser) Withdraw(name enc.Name, route *table.Route) {
-	if route.Origin != uint64(spec_mgmt.RouteOriginClient) {
+	if !r.shouldReadvertise(route) {
 		return
 	}
 	core.Log.Info(r, "NlsrWithdraw", "name", name)
@@ -80,5 +81,11 @@ func (r *NlsrReadvertiser) Withdraw(name enc.Name, route *table.Route) {
 		enc.NewGenericBytesComponent(params.Encode().Join()),
 	}
 
-	r.m.sendInterest(cmd, enc.Wire{})
+	r.m.sendInterest(cmd, enc.Wire{}, optional.None[uint64]())
+}
+
+// shouldReadvertise returns whether the route is readvertised to NLSR.
+func (r *NlsrReadvertiser) shouldReadvertise(route *table.Route) bool {
+	return route.Origin == uint64(spec_mgmt.RouteOriginClient) ||
+		route.Origin == uint64(spec_mgmt.RouteOriginPrefixAnn)
 }
@@ -0,0 +1,106 @@
+package mgmt
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+)
+
+// PrefixAnnCost is the cost of routes created by Prefix Announcements.
+const PrefixAnnCost = 2048
+
+// PrefixAnnValidator validates signed Prefix Announcements against
+// the trust schema of the management configuration.
+type PrefixAnnValidator struct {
+	// Trust configuration, or nil to accept all announcements
+	trust *localTrust
+}
+
+// NewPrefixAnnValidator creates a validator from the management configuration.
+// Returns nil if Prefix Announcements are not enabled.
+func NewPrefixAnnValidator() (*PrefixAnnValidator, error) {
+	config := core.C.Mgmt.PrefixAnnouncement
+	switch config.Keychain {
+	case "":
+		return nil, nil
+	case "insecure":
+		core.Log.Warn(nil, "Prefix Announcements are accepted without validation - insecure mode")
+		return &PrefixAnnValidator{}, nil
+	}
+
+	trust, err := loadLocalTrust(config.Keychain, config.TrustSchema, config.TrustAnchors)
+	if err != nil {
+		return nil, err
+	}
+	return &PrefixAnnValidator{trust: trust}, nil
+}
+
+// String returns the log identifier of the validator.
+func (v *PrefixAnnValidator) String() string {
+	return "mgmt-prefixann-validator"
+}
+
+// Validate checks the signature of the announcement against the trust schema.
+// The certificate chain must be present in the keychain, since the management
+// thread does not fetch certificates from the network.
+func (v *PrefixAnnValidator) Validate(data ndn.Data, sigCov enc.Wire) error {
+	if v.trust == nil {
+		return nil
+	}
+	return v.trust.validate(data, sigCov)
+}
+
+// parsePrefixAnnouncement returns the announced prefix and the content of a Prefix Announcement,
+// whose name is the prefix followed by the 32=PA keyword, a version and a segment number.
+func parsePrefixAnnouncement(data ndn.Data) (enc.Name, *mgmt.PrefixAnnouncement, error) {
+	name := data.Name()
+	if len(name) < 3 || !name[len(name)-3].IsKeyword("PA") ||
+		!name[len(name)-2].IsVersion() || !name[len(name)-1].IsSegment() {
+		return nil, nil, fmt.Errorf("invalid name %s", name)
+	}
+
+	if contentType, ok := data.ContentType().Get(); !ok || contentType != ndn.ContentTypePrefixAnnouncement {
+		return nil, nil, errors.New("invalid content type")
+	}
+
+	pa, err := mgmt.ParsePrefixAnnouncement(enc.NewWireView(data.Content()), true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return name[:len(name)-3], pa, nil
+}
+
+// prefixAnnExpiration returns the lifetime of the route created by a Prefix Announcement,
+// which is its expiration period limited by the end of its validity period.
+func prefixAnnExpiration(pa *mgmt.PrefixAnnouncement) (time.Duration, error) {
+	expiration := time.Duration(pa.ExpirationPeriod) * time.Millisecond
+
+	if validity := pa.ValidityPeriod; validity != nil {
+		notBefore, err := time.Parse(spec.TimeFmt, validity.NotBefore)
+		if err != nil {
+			return 0, fmt.Errorf("invalid validity period: %w", err)
+		}
+		notAfter, err := time.Parse(spec.TimeFmt, validity.NotAfter)
+		if err != nil {
+			return 0, fmt.Errorf("invalid validity period: %w", err)
+		}
+
+		now := time.Now()
+		if now.Before(notBefore) || !now.Before(notAfter) {
+			return 0, errors.New("outside of validity period")
+		}
+		expiration = min(expiration, notAfter.Sub(now))
+	}
+
+	if expiration <= 0 {
+		return 0, errors.New("announcement is expired")
+	}
+	return expiration, nil
+}
@@ -0,0 +1,89 @@
+package mgmt
+
+import (
+	"sync"
+
+	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	spec_mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	"github.com/named-data/ndnd/std/types/optional"
+)
+
+// PrefixAnnReadvertiser propagates Prefix Announcements to the upstream forwarders
+// that are nexthops of /localhop/nfd, so producers can announce prefixes over
+// multiple hops without a routing daemon. Announcements are not sent back
+// to the face they were received from, and forwarders receiving the same
+// announcement again do not readvertise it, which prevents loops.
+type PrefixAnnReadvertiser struct {
+	m *Thread
+	// This is called from RIB (i.e. could be fw threads)
+	mutex sync.Mutex
+}
+
+// NewPrefixAnnReadvertiser creates a PrefixAnnReadvertiser sending commands through the management thread.
+func NewPrefixAnnReadvertiser(m *Thread) *PrefixAnnReadvertiser {
+	return &PrefixAnnReadvertiser{m: m}
+}
+
+// String returns the log identifier of the readvertiser.
+func (r *PrefixAnnReadvertiser) String() string {
+	return "mgmt-prefixann-readvertiser"
+}
+
+// Announce sends the Prefix Announcement of the route to the upstream forwarders.
+func (r *PrefixAnnReadvertiser) Announce(name enc.Name, route *table.Route) {
+	if route.PrefixAnnouncement == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	cmd := NON_LOCAL_PREFIX.
+		Append(enc.NewGenericComponent("rib")).
+		Append(enc.NewGenericComponent("announce"))
+
+	for _, nexthop := range r.upstreams(route) {
+		core.Log.Info(r, "PrefixAnnAdvertise", "name", name, "faceid", nexthop)
+		r.m.sendInterest(cmd, enc.Wire{route.PrefixAnnouncement}, optional.Some(nexthop))
+	}
+}
+
+// Withdraw removes the route created by the Prefix Announcement from the upstream forwarders.
+func (r *PrefixAnnReadvertiser) Withdraw(name enc.Name, route *table.Route) {
+	if route.PrefixAnnouncement == nil {
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	params := &spec_mgmt.ControlParameters{
+		Val: &spec_mgmt.ControlArgs{
+			Name:   name,
+			Origin: optional.Some(uint64(spec_mgmt.RouteOriginPrefixAnn)),
+		},
+	}
+
+	cmd := NON_LOCAL_PREFIX.
+		Append(enc.NewGenericComponent("rib")).
+		Append(enc.NewGenericComponent("unregister")).
+		Append(enc.NewGenericBytesComponent(params.Encode().Join()))
+
+	for _, nexthop := range r.upstreams(route) {
+		core.Log.Info(r, "PrefixAnnWithdraw", "name", name, "faceid", nexthop)
+		r.m.sendInterest(cmd, enc.Wire{}, optional.Some(nexthop))
+	}
+}
+
+// upstreams returns the faces of upstream forwarders, except the face of the route.
+func (r *PrefixAnnReadvertiser) upstreams(route *table.Route) []uint64 {
+	faces := make([]uint64, 0)
+	for _, nexthop := range r.m.fwd.Fib.FindNextHopsEnc(NON_LOCAL_PREFIX) {
+		if nexthop.Nexthop != route.FaceID && nexthop.Nexthop != r.m.face.FaceID() {
+			faces = append(faces, nexthop.Nexthop)
+		}
+	}
+	return faces
+}
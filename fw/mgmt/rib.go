@@ -12,7 +12,6 @@ import (
 	"time"
 
 	"github.com/named-data/ndnd/fw/core"
-	"github.com/named-data/ndnd/fw/face"
 	"github.com/named-data/ndnd/fw/table"
 	enc "github.com/named-data/ndnd/std/encoding"
 	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
@@ -22,7 +21,8 @@ import (
 
 // RIBModule is the module that handles RIB Management.
 type RIBModule struct {
-	manager *Thread
+	manager     *Thread
+	paValidator *PrefixAnnValidator
 }
 
 // (AI GENERATED DESCRIPTION): Returns the string identifier for this RIBModule, which is always `"mgmt-rib"`.
@@ -30,9 +30,15 @@ func (r *RIBModule) String() string {
 	return "mgmt-rib"
 }
 
-// (AI GENERATED DESCRIPTION): Registers the supplied Thread as the manager for the RIBModule.
+// registerManager registers the manager thread, and configures Prefix Announcement validation.
 func (r *RIBModule) registerManager(manager *Thread) {
 	r.manager = manager
+
+	validator, err := NewPrefixAnnValidator()
+	if err != nil {
+		core.Log.Fatal(r, "Unable to configure Prefix Announcement validation", "err", err)
+	}
+	r.paValidator = validator
 }
 
 // (AI GENERATED DESCRIPTION): Returns the manager thread associated with this RIBModule.
@@ -80,7 +86,7 @@ func (r *RIBModule) register(interest *Interest) {
 	faceID := interest.inFace.Unwrap()
 	if fid, ok := params.FaceId.Get(); ok && fid != 0 {
 		faceID = fid
-		if face.FaceTable.Get(faceID) == nil {
+		if r.manager.faces.Get(faceID) == nil {
 			r.manager.sendCtrlResp(interest, 410, "Face does not exist", nil)
 			return
 		}
@@ -96,7 +102,7 @@ func (r *RIBModule) register(interest *Interest) {
 		*expirationPeriod = time.Duration(expiry) * time.Millisecond
 	}
 
-	table.Rib.AddEncRoute(params.Name, &table.Route{
+	r.manager.fwd.Rib.AddEncRoute(params.Name, &table.Route{
 		FaceID:           faceID,
 		Origin:           origin,
 		Cost:             cost,
@@ -147,7 +153,7 @@ func (r *RIBModule) unregister(interest *Interest) {
 	}
 
 	origin := params.Origin.GetOr(uint64(mgmt.RouteOriginApp))
-	table.Rib.RemoveRouteEnc(params.Name, faceID, origin)
+	r.manager.fwd.Rib.RemoveRouteEnc(params.Name, faceID, origin)
 
 	r.manager.sendCtrlResp(interest, 200, "OK", &mgmt.ControlArgs{
 		Name:   params.Name,
@@ -158,7 +164,8 @@ func (r *RIBModule) unregister(interest *Interest) {
 	core.Log.Info(r, "Removed route", "name", params.Name, "faceid", faceID, "origin", origin)
 }
 
-// (AI GENERATED DESCRIPTION): Handles a PrefixAnnouncement Interest by validating its name and application parameters and replying with a 501 Not Implemented response, since the announcement logic is not yet implemented.
+// announce validates a signed Prefix Announcement, and creates a route for the
+// announced prefix to the requesting face until the announcement expires.
 func (r *RIBModule) announce(interest *Interest) {
 	if len(interest.Name()) != len(LOCAL_PREFIX)+3 || interest.Name()[len(LOCAL_PREFIX)+2].Typ != enc.TypeParametersSha256DigestComponent {
 		r.manager.sendCtrlResp(interest, 400, "Name is incorrect", nil)
@@ -172,15 +179,60 @@ func (r *RIBModule) announce(interest *Interest) {
 		return
 	}
 
-	data, _, err := spec.Spec{}.ReadData(enc.NewWireView(appParam))
+	data, sigCov, err := spec.Spec{}.ReadData(enc.NewWireView(appParam))
+	if err != nil {
+		r.manager.sendCtrlResp(interest, 400, "PrefixAnnouncement is invalid", nil)
+		return
+	}
+
+	prefix, pa, err := parsePrefixAnnouncement(data)
 	if err != nil {
+		core.Log.Warn(r, "Invalid PrefixAnnouncement", "name", data.Name(), "err", err)
 		r.manager.sendCtrlResp(interest, 400, "PrefixAnnouncement is invalid", nil)
 		return
 	}
-	if data != nil {
+
+	if r.paValidator == nil {
+		core.Log.Warn(r, "Refusing PrefixAnnouncement since validation is not configured", "name", data.Name())
+		r.manager.sendCtrlResp(interest, 403, "PrefixAnnouncement is not accepted", nil)
+		return
 	}
 
-	r.manager.sendCtrlResp(interest, 501, "PrefixAnnouncement not implemented yet", nil)
+	expiration, err := prefixAnnExpiration(pa)
+	if err != nil {
+		core.Log.Warn(r, "Refusing expired PrefixAnnouncement", "name", data.Name(), "err", err)
+		r.manager.sendCtrlResp(interest, 403, "PrefixAnnouncement is expired", nil)
+		return
+	}
+
+	if err := r.paValidator.Validate(data, sigCov); err != nil {
+		core.Log.Warn(r, "Refusing untrusted PrefixAnnouncement", "name", data.Name(), "err", err)
+		r.manager.sendCtrlResp(interest, 403, "PrefixAnnouncement is not trusted", nil)
+		return
+	}
+
+	faceID := interest.inFace.Unwrap()
+	origin := uint64(mgmt.RouteOriginPrefixAnn)
+	flags := uint64(mgmt.RouteFlagChildInherit)
+	r.manager.fwd.Rib.AddEncRoute(prefix, &table.Route{
+		FaceID:             faceID,
+		Origin:             origin,
+		Cost:               PrefixAnnCost,
+		Flags:              flags,
+		ExpirationPeriod:   &expiration,
+		PrefixAnnouncement: appParam.Join(),
+	})
+
+	core.Log.Info(r, "Created route from PrefixAnnouncement", "name", prefix, "faceid", faceID, "expires", expiration)
+
+	r.manager.sendCtrlResp(interest, 200, "OK", &mgmt.ControlArgs{
+		Name:             prefix,
+		FaceId:           optional.Some(faceID),
+		Origin:           optional.Some(origin),
+		Cost:             optional.Some(uint64(PrefixAnnCost)),
+		Flags:            optional.Some(flags),
+		ExpirationPeriod: optional.Some(uint64(expiration.Milliseconds())),
+	})
 }
 
 // (AI GENERATED DESCRIPTION): Responds to a “/local/rib/list” Interest by collecting all current RIB entries, encoding them into a mgmt.RibStatus dataset, and sending the dataset back as a Data packet with a name derived from the Interest’s prefix and the components “rib”/“list”.
@@ -191,7 +243,7 @@ func (r *RIBModule) list(interest *Interest) {
 	}
 
 	// Generate new dataset
-	entries := table.Rib.GetAllEntries()
+	entries := r.manager.fwd.Rib.GetAllEntries()
 	dataset := &mgmt.RibStatus{}
 	for _, entry := range entries {
 		ribEntry := &mgmt.RibEntry{
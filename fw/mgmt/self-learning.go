@@ -0,0 +1,174 @@
+package mgmt
+
+import (
+	"time"
+
+	"github.com/named-data/ndnd/fw/core"
+	"github.com/named-data/ndnd/fw/table"
+	enc "github.com/named-data/ndnd/std/encoding"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+)
+
+// SelfLearningRouteLifetime is the maximum lifetime of a route learned by the self-learning
+// strategy. Routes are renewed while they are used, and expire otherwise.
+const SelfLearningRouteLifetime = 10 * time.Minute
+
+// selfLearningQueueSize is the number of pending route updates of the self-learning strategy.
+const selfLearningQueueSize = 256
+
+// SelfLearningRoutes creates the routes learned by the self-learning strategy from the
+// Prefix Announcements attached to Data. Announcements are validated like announcements
+// received by the RIB module, in a separate goroutine so that forwarding is not delayed.
+type SelfLearningRoutes struct {
+	// Validator of the announcements, or nil if announcements are refused
+	validator *PrefixAnnValidator
+	// RIB of the learned routes
+	rib *table.RibTable
+	// Pending route updates
+	queue chan func()
+	// Closed when route updates stop
+	stop chan struct{}
+}
+
+// NewSelfLearningRoutes creates the route handler of the self-learning strategy,
+// and starts processing route updates until Stop is called.
+func NewSelfLearningRoutes(rib *table.RibTable) *SelfLearningRoutes {
+	validator, err := NewPrefixAnnValidator()
+	if err != nil {
+		core.Log.Fatal(nil, "Unable to configure Prefix Announcement validation", "err", err)
+	}
+
+	r := &SelfLearningRoutes{
+		validator: validator,
+		rib:       rib,
+		queue:     make(chan func(), selfLearningQueueSize),
+		stop:      make(chan struct{}),
+	}
+	go r.run()
+	return r
+}
+
+// String returns the log identifier of the route handler.
+func (r *SelfLearningRoutes) String() string {
+	return "mgmt-self-learning"
+}
+
+// run processes route updates in order.
+func (r *SelfLearningRoutes) run() {
+	for {
+		select {
+		case update := <-r.queue:
+			update()
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// Stop stops processing route updates. Later updates are dropped.
+func (r *SelfLearningRoutes) Stop() {
+	close(r.stop)
+}
+
+// enqueue queues a route update, which is dropped if the queue is full.
+func (r *SelfLearningRoutes) enqueue(update func()) {
+	select {
+	case r.queue <- update:
+	default:
+		core.Log.Debug(r, "Route update dropped due to full queue")
+	}
+}
+
+// Learn validates the Prefix Announcement, and creates a route for the announced prefix to the face.
+func (r *SelfLearningRoutes) Learn(prefixAnn enc.Wire, faceID uint64) {
+	if r.validator == nil {
+		return
+	}
+
+	// Copy the announcement to not retain the whole frame
+	wire := prefixAnn.Join()
+	r.enqueue(func() { r.learn(wire, faceID) })
+}
+
+// learn creates a route from a Prefix Announcement.
+func (r *SelfLearningRoutes) learn(wire []byte, faceID uint64) {
+	data, sigCov, err := spec.Spec{}.ReadData(enc.NewBufferView(wire))
+	if err != nil {
+		core.Log.Debug(r, "Invalid PrefixAnnouncement", "faceid", faceID, "err", err)
+		return
+	}
+
+	prefix, pa, err := parsePrefixAnnouncement(data)
+	if err != nil {
+		core.Log.Debug(r, "Invalid PrefixAnnouncement", "name", data.Name(), "err", err)
+		return
+	}
+
+	expiration, err := prefixAnnExpiration(pa)
+	if err != nil {
+		core.Log.Debug(r, "Refusing expired PrefixAnnouncement", "name", data.Name(), "err", err)
+		return
+	}
+	expiration = min(expiration, SelfLearningRouteLifetime)
+
+	if err := r.validator.Validate(data, sigCov); err != nil {
+		core.Log.Warn(r, "Refusing untrusted PrefixAnnouncement", "name", data.Name(), "err", err)
+		return
+	}
+
+	r.rib.AddEncRoute(prefix, &table.Route{
+		FaceID:             faceID,
+		Origin:             uint64(mgmt.RouteOriginPrefixAnn),
+		Cost:               PrefixAnnCost,
+		Flags:              uint64(mgmt.RouteFlagChildInherit),
+		ExpirationPeriod:   &expiration,
+		PrefixAnnouncement: wire,
+	})
+
+	core.Log.Debug(r, "Learned route", "name", prefix, "faceid", faceID, "expires", expiration)
+}
+
+// Renew extends the lifetime of the learned route of the name to the face,
+// within the lifetime of its Prefix Announcement.
+func (r *SelfLearningRoutes) Renew(name enc.Name, faceID uint64) {
+	name = name.Clone()
+	r.enqueue(func() {
+		prefix, wire := r.rib.FindPrefixAnn(name, faceID)
+		if wire == nil {
+			return
+		}
+
+		// The announcement was validated when the route was learned
+		data, _, err := spec.Spec{}.ReadData(enc.NewBufferView(wire))
+		if err != nil {
+			return
+		}
+		_, pa, err := parsePrefixAnnouncement(data)
+		if err != nil {
+			return
+		}
+		expiration, err := prefixAnnExpiration(pa)
+		if err != nil {
+			r.rib.RemoveRouteEnc(prefix, faceID, uint64(mgmt.RouteOriginPrefixAnn))
+			return
+		}
+		expiration = min(expiration, SelfLearningRouteLifetime)
+
+		// Only the lifetime changes, so the FIB is left untouched
+		r.rib.RenewRoute(prefix, faceID, uint64(mgmt.RouteOriginPrefixAnn), expiration)
+	})
+}
+
+// Forget removes the learned route of the name to the face.
+func (r *SelfLearningRoutes) Forget(name enc.Name, faceID uint64) {
+	name = name.Clone()
+	r.enqueue(func() {
+		prefix, wire := r.rib.FindPrefixAnn(name, faceID)
+		if wire == nil {
+			return
+		}
+		r.rib.RemoveRouteEnc(prefix, faceID, uint64(mgmt.RouteOriginPrefixAnn))
+		core.Log.Debug(r, "Removed learned route", "name", prefix, "faceid", faceID)
+	})
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/named-data/ndnd/fw/core"
 	"github.com/named-data/ndnd/fw/defn"
 	"github.com/named-data/ndnd/fw/fw"
-	"github.com/named-data/ndnd/fw/table"
 	enc "github.com/named-data/ndnd/std/encoding"
 	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
 )
@@ -131,7 +130,16 @@ func (s *StrategyChoiceModule) set(interest *Interest) {
 		params.Strategy.Name = params.Strategy.Name.
 			Append(enc.NewVersionComponent(strategyVersion))
 	}
-	table.FibStrategyTable.SetStrategyEnc(params.Name, params.Strategy.Name)
+
+	// Check the parameters following the version
+	if err := fw.CheckStrategyParameters(params.Strategy.Name); err != nil {
+		core.Log.Warn(s, "Invalid strategy parameters", "strategy", params.Strategy.Name, "err", err)
+		s.manager.sendCtrlResp(interest, 400, "Invalid strategy parameters", nil)
+		return
+	}
+
+	s.manager.fwd.Fib.SetStrategyEnc(params.Name, params.Strategy.Name)
+	s.releaseStrategies() // the previous strategy of the prefix may be unused now
 
 	s.manager.sendCtrlResp(interest, 200, "OK", &mgmt.ControlArgs{
 		Name:     params.Name,
@@ -164,12 +172,22 @@ func (s *StrategyChoiceModule) unset(interest *Interest) {
 		return
 	}
 
-	table.FibStrategyTable.UnSetStrategyEnc(params.Name)
+	s.manager.fwd.Fib.UnSetStrategyEnc(params.Name)
+	s.releaseStrategies()
 	core.Log.Info(s, "Unset Strategy", "name", params.Name)
 
 	s.manager.sendCtrlResp(interest, 200, "OK", &mgmt.ControlArgs{Name: params.Name})
 }
 
+// releaseStrategies frees the instances of strategies with parameters that are not chosen anymore.
+func (s *StrategyChoiceModule) releaseStrategies() {
+	for _, thread := range s.manager.threads {
+		if err := thread.RunTask(thread.ReleaseStrategies); err != nil {
+			core.Log.Warn(s, "Unable to release strategies", "thread", thread.GetID(), "err", err)
+		}
+	}
+}
+
 // (AI GENERATED DESCRIPTION): Handles an interest that requests the list of strategy choices by collecting all registered forwarding strategies, assembling them into a StrategyChoiceMsg dataset, and replying with that dataset.
 func (s *StrategyChoiceModule) list(interest *Interest) {
 	if len(interest.Name()) > len(LOCAL_PREFIX)+2 {
@@ -179,7 +197,7 @@ func (s *StrategyChoiceModule) list(interest *Interest) {
 
 	// Generate new dataset
 	// TODO: For thread safety, we should lock the Strategy table from writes until we are done
-	entries := table.FibStrategyTable.GetAllForwardingStrategies()
+	entries := s.manager.fwd.Fib.GetAllForwardingStrategies()
 	choices := []*mgmt.StrategyChoice{}
 	for _, fsEntry := range entries {
 		choices = append(choices, &mgmt.StrategyChoice{
@@ -8,13 +8,16 @@
 package mgmt
 
 import (
+	"errors"
 	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/named-data/ndnd/fw/core"
 	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/fw/dispatch"
 	"github.com/named-data/ndnd/fw/face"
-	"github.com/named-data/ndnd/fw/table"
+	"github.com/named-data/ndnd/fw/fw"
 	enc "github.com/named-data/ndnd/std/encoding"
 	basic_engine "github.com/named-data/ndnd/std/engine/basic"
 	"github.com/named-data/ndnd/std/ndn"
@@ -31,6 +34,9 @@ var NON_LOCAL_PREFIX = defn.NON_LOCAL_PREFIX
 
 // Thread Represents the management thread
 type Thread struct {
+	fwd       *dispatch.Forwarder
+	faces     *face.Table
+	threads   []*fw.Thread
 	face      face.LinkService
 	transport *face.InternalTransport
 	modules   map[string]Module
@@ -39,6 +45,14 @@ type Thread struct {
 	store  ndn.Store
 	objDir *storage.MemoryFifoDir
 	signer ndn.Signer
+	// Authenticator of control commands, or nil if commands are not validated
+	auth *CommandAuthenticator
+	// Signature time of the last sent Interest. Peers reject commands whose
+	// signature time does not increase, so it is increased by at least 1ms.
+	lastSigTime  time.Duration
+	sigTimeMutex sync.Mutex
+	// Routes learned by the self-learning strategy
+	selfLearning *SelfLearningRoutes
 }
 
 // (AI GENERATED DESCRIPTION): Returns the constant string “mgmt”, identifying the Thread as a management thread.
@@ -46,9 +60,13 @@ func (m *Thread) String() string {
 	return "mgmt"
 }
 
-// MakeMgmtThread creates a new management thread.
-func MakeMgmtThread() *Thread {
+// MakeMgmtThread creates a new management thread of the forwarder of the face table.
+// The forwarding threads must not run yet.
+func MakeMgmtThread(faces *face.Table, threads []*fw.Thread) *Thread {
 	m := &Thread{
+		fwd:     faces.Forwarder(),
+		faces:   faces,
+		threads: threads,
 		modules: make(map[string]Module),
 		timer:   basic_engine.NewTimer(),
 		store:   storage.NewMemoryStore(),
@@ -56,9 +74,14 @@ func MakeMgmtThread() *Thread {
 		signer:  signer.NewSha256Signer(),
 	}
 
+	if err := m.configureSecurity(); err != nil {
+		core.Log.Fatal(m, "Unable to configure management security", "err", err)
+	}
+
 	m.registerModule("cs", new(ContentStoreModule))
 	m.registerModule("faces", new(FaceModule))
 	m.registerModule("fib", new(FIBModule))
+	m.registerModule("measurements", new(MeasurementsModule))
 	m.registerModule("rib", new(RIBModule))
 	m.registerModule("status", new(ForwarderStatusModule))
 	m.registerModule("strategy-choice", new(StrategyChoiceModule))
@@ -66,12 +89,50 @@ func MakeMgmtThread() *Thread {
 	// readvertisers run in the management thread for ease of
 	// implementation, since they use the internal transport
 	if core.C.Tables.Rib.ReadvertiseNlsr {
-		table.AddReadvertiser(NewNlsrReadvertiser(m))
+		m.fwd.Rib.AddReadvertiser(NewNlsrReadvertiser(m))
+	}
+	if core.C.Tables.Rib.ReadvertisePrefixAnn {
+		m.fwd.Rib.AddReadvertiser(NewPrefixAnnReadvertiser(m))
+	}
+
+	// routes learned by the self-learning strategy
+	m.selfLearning = NewSelfLearningRoutes(m.fwd.Rib)
+	for _, thread := range threads {
+		thread.SetSelfLearningRoutes(m.selfLearning)
 	}
 
 	return m
 }
 
+// configureSecurity loads the keychain of the management configuration, to validate
+// control commands and to sign management responses with the forwarder key.
+func (m *Thread) configureSecurity() error {
+	config := core.C.Mgmt.Security
+	if config.Keychain == "" {
+		if core.C.Mgmt.AllowLocalhop {
+			core.Log.Warn(m, "Control commands over /localhop are rejected - set a management keychain")
+		}
+		return nil
+	}
+
+	trust, err := loadLocalTrust(config.Keychain, config.TrustSchema, config.TrustAnchors)
+	if err != nil {
+		return err
+	}
+
+	if m.auth, err = NewCommandAuthenticator(trust); err != nil {
+		return err
+	}
+
+	if config.Identity != "" {
+		if m.signer, err = newResponseSigner(trust); err != nil {
+			return err
+		}
+		core.Log.Info(m, "Signing management responses", "key", m.signer.KeyName())
+	}
+	return nil
+}
+
 // (AI GENERATED DESCRIPTION): Registers a module under a given name in the thread and links it to the thread by calling the module’s `registerManager` method.
 func (m *Thread) registerModule(name string, module Module) {
 	m.modules[name] = module
@@ -83,17 +144,19 @@ func (m *Thread) Run() {
 	core.Log.Info(m, "Starting management thread")
 
 	// Create and register Internal transport
-	m.face, m.transport = face.RegisterInternalTransport()
-	table.FibStrategyTable.InsertNextHopEnc(LOCAL_PREFIX, m.face.FaceID(), 0)
-	if core.C.Mgmt.AllowLocalhop {
-		table.FibStrategyTable.InsertNextHopEnc(NON_LOCAL_PREFIX, m.face.FaceID(), 0)
-	}
+	m.face, m.transport = face.RegisterInternalTransport(m.faces)
+	m.fwd.Fib.InsertNextHopEnc(LOCAL_PREFIX, m.face.FaceID(), 0)
+	m.UpdateLocalhop(core.C.Mgmt.AllowLocalhop)
+
+	// Publish face events once the internal face is available
+	m.faces.AddEventHandler(m.modules["faces"].(*FaceModule).publishEvent)
 
 	for {
 		lpPkt := m.transport.Receive()
 		if lpPkt == nil {
 			// Indicates that internal face has quit, which means it's time for us to quit
 			core.Log.Info(m, "Face quit, so management quitting")
+			m.selfLearning.Stop()
 			break
 		}
 
@@ -102,7 +165,7 @@ func (m *Thread) Run() {
 			continue
 		}
 
-		pkt, _, err := spec.ReadPacket(enc.NewWireView(lpPkt.Fragment))
+		pkt, ctx, err := spec.ReadPacket(enc.NewWireView(lpPkt.Fragment))
 		if err != nil {
 			core.Log.Warn(m, "Unable to decode internal packet - DROP", "err", err)
 			continue
@@ -116,9 +179,10 @@ func (m *Thread) Run() {
 
 		// Create internal Interest object for easier handling
 		interest := &Interest{
-			Interest: *pkt.Interest,
-			pitToken: lpPkt.PitToken,
-			inFace:   lpPkt.IncomingFaceId,
+			Interest:   *pkt.Interest,
+			sigCovered: ctx.Interest_context.SigCovered(),
+			pitToken:   lpPkt.PitToken,
+			inFace:     lpPkt.IncomingFaceId,
 		}
 
 		// Ensure Interest name matches expectations
@@ -145,8 +209,18 @@ func (m *Thread) Run() {
 			continue
 		}
 
-		// Dispatch interest based on name
+		// Validate and authorize control commands
 		moduleName := interest.Name()[len(LOCAL_PREFIX)].String()
+		verb := interest.Name()[len(LOCAL_PREFIX)+1].String()
+		if isControlCommand(moduleName, verb) {
+			if err := m.authenticate(interest, moduleName, verb); err != nil {
+				core.Log.Warn(m, "Rejected control command", "name", interest.Name(), "err", err)
+				m.sendCtrlResp(interest, 403, "Authorization failed", nil)
+				continue
+			}
+		}
+
+		// Dispatch interest based on name
 		if module, ok := m.modules[moduleName]; ok {
 			module.handleIncomingInterest(interest)
 		} else {
@@ -156,22 +230,68 @@ func (m *Thread) Run() {
 	}
 }
 
-// Send an Interest to the internal transport
-func (m *Thread) sendInterest(name enc.Name, params enc.Wire) {
-	config := ndn.InterestConfig{
-		MustBeFresh: true,
-		Nonce:       optional.Some(rand.Uint32()),
+// authenticate checks that a control command may run. Without a management keychain,
+// commands over /localhop are rejected since they cannot be validated.
+func (m *Thread) authenticate(interest *Interest, module string, verb string) error {
+	if m.auth != nil {
+		return m.auth.Authenticate(interest, module, verb)
+	}
+	if !LOCAL_PREFIX.IsPrefix(interest.Name()) {
+		return errors.New("no management keychain to validate commands over /localhop")
 	}
-	interest, err := spec.Spec{}.MakeInterest(name, &config, params, m.signer)
+	return nil
+}
+
+// UpdateLocalhop adds or removes the route of management over /localhop,
+// depending on whether it is allowed.
+func (m *Thread) UpdateLocalhop(allow bool) {
+	if m.face == nil {
+		return // not running yet
+	}
+
+	if allow {
+		m.fwd.Fib.InsertNextHopEnc(NON_LOCAL_PREFIX, m.face.FaceID(), 0)
+	} else {
+		m.fwd.Fib.RemoveNextHopEnc(NON_LOCAL_PREFIX, m.face.FaceID())
+	}
+}
+
+// Send an Interest to the internal transport.
+// If nextHop is set, the Interest is forwarded only to that face.
+func (m *Thread) sendInterest(name enc.Name, params enc.Wire, nextHop optional.Optional[uint64]) {
+	interest, err := m.makeInterest(name, params)
 	if err != nil {
 		core.Log.Warn(m, "Unable to encode Interest", "name", name, "err", err)
 		return
 	}
 
-	m.transport.Send(&spec.LpPacket{Fragment: interest.Wire})
+	m.transport.Send(&spec.LpPacket{
+		Fragment:      interest.Wire,
+		NextHopFaceId: nextHop,
+	})
 	core.Log.Trace(m, "Sent management Interest", "name", interest.FinalName)
 }
 
+// makeInterest encodes a management Interest. It is signed with a signature time
+// and nonce, so that peer forwarders accept it as a control command.
+func (m *Thread) makeInterest(name enc.Name, params enc.Wire) (*ndn.EncodedInterest, error) {
+	m.sigTimeMutex.Lock()
+	sigTime := time.Duration(m.timer.Now().UnixMilli()) * time.Millisecond
+	if sigTime <= m.lastSigTime {
+		sigTime = m.lastSigTime + time.Millisecond
+	}
+	m.lastSigTime = sigTime
+	m.sigTimeMutex.Unlock()
+
+	config := ndn.InterestConfig{
+		MustBeFresh: true,
+		Nonce:       optional.Some(rand.Uint32()),
+		SigNonce:    m.timer.Nonce(),
+		SigTime:     optional.Some(sigTime),
+	}
+	return spec.Spec{}.MakeInterest(name, &config, params, m.signer)
+}
+
 // Send a Data packet to the internal transport
 func (m *Thread) sendData(interest *Interest, name enc.Name, content enc.Wire) {
 	data, err := spec.Spec{}.MakeData(name,
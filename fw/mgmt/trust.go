@@ -0,0 +1,127 @@
+package mgmt
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/named-data/ndnd/fw/core"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/object/storage"
+	sec "github.com/named-data/ndnd/std/security"
+	"github.com/named-data/ndnd/std/security/keychain"
+	"github.com/named-data/ndnd/std/security/trust_schema"
+)
+
+// localTrust is a trust configuration whose certificates are all in a local keychain,
+// since the management thread does not fetch certificates from the network.
+type localTrust struct {
+	keychain ndn.KeyChain
+	schema   ndn.TrustSchema
+	anchors  []enc.Name
+	store    ndn.Store
+	config   *sec.TrustConfig
+}
+
+// loadLocalTrust opens the keychain, and loads the trust schema file (relative to the
+// configuration file) and the trust anchors. Without a schema file, any signer with a
+// certificate chain to a trust anchor is accepted.
+func loadLocalTrust(keychainUri string, schemaFile string, anchorNames []string) (*localTrust, error) {
+	store := storage.NewMemoryStore()
+	kc, err := keychain.NewKeyChain(keychainUri, store)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open keychain %s: %w", keychainUri, err)
+	}
+
+	var schema ndn.TrustSchema = trust_schema.NewNullSchema()
+	if schemaFile != "" {
+		schemaBytes, err := os.ReadFile(core.C.ResolveRelPath(schemaFile))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read trust schema: %w", err)
+		}
+		if schema, err = trust_schema.NewLvsSchema(schemaBytes); err != nil {
+			return nil, fmt.Errorf("unable to parse trust schema: %w", err)
+		}
+	}
+
+	anchors := make([]enc.Name, 0, len(anchorNames))
+	for _, anchor := range anchorNames {
+		name, err := enc.NameFromStr(anchor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trust anchor name %s: %w", anchor, err)
+		}
+		anchors = append(anchors, name)
+	}
+
+	config, err := sec.NewTrustConfig(kc, schema, anchors)
+	if err != nil {
+		return nil, err
+	}
+
+	return &localTrust{
+		keychain: kc,
+		schema:   schema,
+		anchors:  anchors,
+		store:    store,
+		config:   config,
+	}, nil
+}
+
+// validate checks the signature of a Data packet against the trust schema.
+// The certificate chain must be present in the keychain.
+func (t *localTrust) validate(data ndn.Data, sigCov enc.Wire) error {
+	result := errors.New("validation did not complete")
+	t.config.Validate(sec.TrustConfigValidateArgs{
+		Data:       data,
+		DataSigCov: sigCov,
+		Fetch:      t.fetchLocal,
+		Callback: func(valid bool, err error) {
+			switch {
+			case err != nil:
+				result = err
+			case !valid:
+				result = errors.New("signature is not trusted")
+			default:
+				result = nil
+			}
+		},
+	})
+	return result
+}
+
+// findCert returns the latest certificate in the keychain whose name starts with the key locator.
+func (t *localTrust) findCert(keyLocator enc.Name) (ndn.Data, enc.Wire, error) {
+	wire, _ := t.store.Get(keyLocator, true)
+	if wire == nil {
+		return nil, nil, fmt.Errorf("certificate not in keychain: %s", keyLocator)
+	}
+	return spec.Spec{}.ReadData(enc.NewBufferView(wire))
+}
+
+// fetchLocal looks up a certificate in the keychain.
+func (t *localTrust) fetchLocal(name enc.Name, _ *ndn.InterestConfig, callback ndn.ExpressCallbackFunc) {
+	wire, _ := t.store.Get(name, true)
+	if wire == nil {
+		callback(ndn.ExpressCallbackArgs{
+			Result: ndn.InterestResultError,
+			Error:  fmt.Errorf("certificate not in keychain: %s", name),
+		})
+		return
+	}
+
+	data, sigCov, err := spec.Spec{}.ReadData(enc.NewBufferView(wire))
+	if err != nil {
+		callback(ndn.ExpressCallbackArgs{Result: ndn.InterestResultError, Error: err})
+		return
+	}
+
+	callback(ndn.ExpressCallbackArgs{
+		Result:     ndn.InterestResultData,
+		Data:       data,
+		RawData:    enc.Wire{wire},
+		SigCovered: sigCov,
+		IsLocal:    true,
+	})
+}
@@ -17,27 +17,23 @@ import (
 
 // Mutable table configuration
 var mutCfg = struct {
-	csCapacity atomic.Int32
-	csAdmit    atomic.Bool
-	csServe    atomic.Bool
+	csCapacity      atomic.Int64
+	csCapacityBytes atomic.Int64
+	csAdmit         atomic.Bool
+	csServe         atomic.Bool
 }{}
 
 // Initialize creates tables and configuration.
 func Initialize() {
 	// Content Store
-	mutCfg.csCapacity.Store(int32(core.C.Tables.ContentStore.Capacity))
+	mutCfg.csCapacity.Store(int64(core.C.Tables.ContentStore.Capacity))
+	mutCfg.csCapacityBytes.Store(int64(core.C.Tables.ContentStore.CapacityBytes))
 	mutCfg.csAdmit.Store(core.C.Tables.ContentStore.Admit)
 	mutCfg.csServe.Store(core.C.Tables.ContentStore.Serve)
 
-	// Create FIB strategy table
-	switch core.C.Tables.Fib.Algorithm {
-	case "hashtable":
-		newFibStrategyTableHashTable(core.C.Tables.Fib.Hashtable.M)
-	case "nametree":
-		newFibStrategyTableTree()
-	default:
-		core.Log.Fatal(nil, "Unknown FIB table algorithm", "algo", core.C.Tables.Fib.Algorithm)
-	}
+	// Create FIB strategy table and RIB
+	FibStrategyTable = NewFibStrategyTable()
+	Rib = NewRibTable(FibStrategyTable)
 
 	// Create Network Region Table
 	for _, region := range core.C.Tables.NetworkRegion.Regions {
@@ -70,14 +66,26 @@ func CfgSetCsServe(serve bool) {
 	mutCfg.csServe.Store(serve)
 }
 
-// CfgCsCapacity returns the capacity of each forwarding thread's Content Store.
+// CfgCsCapacity returns the capacity of each forwarding thread's Content Store in packets.
 func CfgCsCapacity() int {
 	return int(mutCfg.csCapacity.Load())
 }
 
-// CfgSetCsCapacity sets the capacity of each forwarding thread's Content Store.
+// CfgSetCsCapacity sets the capacity of each forwarding thread's Content Store in packets.
 func CfgSetCsCapacity(capacity int) {
-	mutCfg.csCapacity.Store(int32(capacity))
+	mutCfg.csCapacity.Store(int64(capacity))
+}
+
+// CfgCsCapacityBytes returns the capacity of each forwarding thread's Content Store in bytes,
+// or zero if unlimited.
+func CfgCsCapacityBytes() int {
+	return int(mutCfg.csCapacityBytes.Load())
+}
+
+// CfgSetCsCapacityBytes sets the capacity of each forwarding thread's Content Store in bytes,
+// or zero if unlimited.
+func CfgSetCsCapacityBytes(capacity int) {
+	mutCfg.csCapacityBytes.Store(int64(capacity))
 }
 
 // CfgCsReplacementPolicy returns the replacement policy used by Content Stores in the forwarder.
@@ -0,0 +1,130 @@
+/* YaNFD - Yet another NDN Forwarding Daemon
+ *
+ * Copyright (C) 2020-2021 Eric Newberry.
+ *
+ * This file is licensed under the terms of the MIT License, as found in LICENSE.md.
+ */
+
+package table
+
+import (
+	"container/list"
+
+	"github.com/named-data/ndnd/fw/defn"
+)
+
+// Queues of the ARC replacement policy
+const (
+	arcT1 = iota // cached, seen once recently
+	arcT2        // cached, seen at least twice recently
+	arcB1        // ghost entries evicted from T1
+	arcB2        // ghost entries evicted from T2
+)
+
+// CsARC is an adaptive replacement cache (ARC) policy for the Content Store.
+// It balances recency and frequency by adapting the target size of the
+// recency queue based on hits in the history of recently evicted entries.
+type CsARC struct {
+	cs        PitCsTable
+	target    int // target size of T1
+	queues    [4]*list.List
+	locations map[uint64]*list.Element
+}
+
+// csArcItem is an entry in one of the ARC queues.
+type csArcItem struct {
+	index uint64
+	queue int
+}
+
+// NewCsARC creates a new ARC replacement policy for the Content Store.
+func NewCsARC(cs PitCsTable) *CsARC {
+	a := new(CsARC)
+	a.cs = cs
+	for i := range a.queues {
+		a.queues[i] = list.New()
+	}
+	a.locations = make(map[uint64]*list.Element)
+	return a
+}
+
+// AfterInsert is called after a new entry is inserted into the Content Store.
+func (a *CsARC) AfterInsert(index uint64, wire []byte, data *defn.FwData) {
+	if location, ok := a.locations[index]; ok {
+		// Hit in the history, adapt the target size of T1
+		b1, b2 := a.queues[arcB1].Len(), a.queues[arcB2].Len()
+		switch location.Value.(*csArcItem).queue {
+		case arcB1:
+			a.target = min(a.target+max(b2/b1, 1), CfgCsCapacity())
+		case arcB2:
+			a.target = max(a.target-max(b1/b2, 1), 0)
+		}
+		a.moveTo(index, arcT2)
+		return
+	}
+
+	a.moveTo(index, arcT1)
+}
+
+// AfterRefresh is called after a new data packet refreshes an existing entry in the Content Store.
+func (a *CsARC) AfterRefresh(index uint64, wire []byte, data *defn.FwData) {
+	a.moveTo(index, arcT2)
+}
+
+// BeforeErase is called before an entry is erased from the Content Store through management.
+func (a *CsARC) BeforeErase(index uint64, wire []byte) {
+	if location, ok := a.locations[index]; ok {
+		a.queues[location.Value.(*csArcItem).queue].Remove(location)
+		delete(a.locations, index)
+	}
+}
+
+// BeforeUse is called before an entry in the Content Store is used to satisfy a pending Interest.
+func (a *CsARC) BeforeUse(index uint64, wire []byte) {
+	a.moveTo(index, arcT2)
+}
+
+// EvictEntries is called to instruct the policy to evict enough entries to reduce the Content Store size
+// below its size limit.
+func (a *CsARC) EvictEntries() {
+	t1, t2 := a.queues[arcT1], a.queues[arcT2]
+	for t1.Len()+t2.Len() > 0 && a.cs.csOverCapacity() {
+		var item *csArcItem
+		if t1.Len() > 0 && (t1.Len() > a.target || t2.Len() == 0) {
+			item = t1.Front().Value.(*csArcItem)
+			a.moveTo(item.index, arcB1)
+		} else {
+			item = t2.Front().Value.(*csArcItem)
+			a.moveTo(item.index, arcB2)
+		}
+		a.cs.eraseCsDataFromReplacementStrategy(item.index)
+	}
+
+	// Bound the history to the number of cached entries
+	capacity := max(t1.Len()+t2.Len(), 1)
+	b1, b2 := a.queues[arcB1], a.queues[arcB2]
+	for b1.Len() > 0 && t1.Len()+b1.Len() > capacity {
+		a.forget(b1.Front())
+	}
+	for b2.Len() > 0 && t1.Len()+t2.Len()+b1.Len()+b2.Len() > 2*capacity {
+		a.forget(b2.Front())
+	}
+}
+
+// moveTo moves (or inserts) the entry to the most recently used end of the queue.
+func (a *CsARC) moveTo(index uint64, queue int) {
+	item := &csArcItem{index: index}
+	if location, ok := a.locations[index]; ok {
+		item = location.Value.(*csArcItem)
+		a.queues[item.queue].Remove(location)
+	}
+	item.queue = queue
+	a.locations[index] = a.queues[queue].PushBack(item)
+}
+
+// forget removes a ghost entry from the history.
+func (a *CsARC) forget(location *list.Element) {
+	item := location.Value.(*csArcItem)
+	a.queues[item.queue].Remove(location)
+	delete(a.locations, item.index)
+}
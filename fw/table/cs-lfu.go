@@ -0,0 +1,92 @@
+/* YaNFD - Yet another NDN Forwarding Daemon
+ *
+ * Copyright (C) 2020-2021 Eric Newberry.
+ *
+ * This file is licensed under the terms of the MIT License, as found in LICENSE.md.
+ */
+
+package table
+
+import (
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/std/types/priority_queue"
+)
+
+// CsLFU is a least frequently used replacement policy with dynamic aging (LFU-DA)
+// for the Content Store. The key of an entry is its use count plus the cache age,
+// which is the key of the last evicted entry. Aging allows formerly popular
+// entries to be evicted once they are no longer used.
+type CsLFU struct {
+	cs      PitCsTable
+	queue   priority_queue.Queue[uint64, uint64]
+	entries map[uint64]*csLfuEntry
+	age     uint64
+}
+
+// csLfuEntry is the use count of an entry in the Content Store.
+type csLfuEntry struct {
+	item  *priority_queue.Item[uint64, uint64]
+	count uint64
+}
+
+// NewCsLFU creates a new LFU-DA replacement policy for the Content Store.
+func NewCsLFU(cs PitCsTable) *CsLFU {
+	l := new(CsLFU)
+	l.cs = cs
+	l.queue = priority_queue.New[uint64, uint64]()
+	l.entries = make(map[uint64]*csLfuEntry)
+	return l
+}
+
+// AfterInsert is called after a new entry is inserted into the Content Store.
+func (l *CsLFU) AfterInsert(index uint64, wire []byte, data *defn.FwData) {
+	if entry, ok := l.entries[index]; ok {
+		l.use(entry)
+		return
+	}
+
+	entry := &csLfuEntry{count: 1}
+	entry.item = l.queue.Push(index, l.age+entry.count)
+	l.entries[index] = entry
+}
+
+// AfterRefresh is called after a new data packet refreshes an existing entry in the Content Store.
+func (l *CsLFU) AfterRefresh(index uint64, wire []byte, data *defn.FwData) {
+	if entry, ok := l.entries[index]; ok {
+		l.use(entry)
+	}
+}
+
+// BeforeErase is called before an entry is erased from the Content Store through management.
+func (l *CsLFU) BeforeErase(index uint64, wire []byte) {
+	if entry, ok := l.entries[index]; ok {
+		// Keys are at least 1, so the entry becomes the minimum
+		l.queue.UpdatePriority(entry.item, 0)
+		l.queue.Pop()
+		delete(l.entries, index)
+	}
+}
+
+// BeforeUse is called before an entry in the Content Store is used to satisfy a pending Interest.
+func (l *CsLFU) BeforeUse(index uint64, wire []byte) {
+	if entry, ok := l.entries[index]; ok {
+		l.use(entry)
+	}
+}
+
+// EvictEntries is called to instruct the policy to evict enough entries to reduce the Content Store size
+// below its size limit.
+func (l *CsLFU) EvictEntries() {
+	for l.queue.Len() > 0 && l.cs.csOverCapacity() {
+		l.age = l.queue.PeekPriority()
+		indexToErase := l.queue.Pop()
+		delete(l.entries, indexToErase)
+		l.cs.eraseCsDataFromReplacementStrategy(indexToErase)
+	}
+}
+
+// use increments the use count of the entry and updates its key.
+func (l *CsLFU) use(entry *csLfuEntry) {
+	entry.count++
+	l.queue.UpdatePriority(entry.item, l.age+entry.count)
+}
@@ -0,0 +1,103 @@
+/* YaNFD - Yet another NDN Forwarding Daemon
+ *
+ * Copyright (C) 2020-2021 Eric Newberry.
+ *
+ * This file is licensed under the terms of the MIT License, as found in LICENSE.md.
+ */
+
+package table
+
+import (
+	"container/list"
+	"math"
+	"time"
+
+	"github.com/named-data/ndnd/fw/defn"
+	"github.com/named-data/ndnd/std/types/priority_queue"
+)
+
+// CsPriorityFIFO is a priority FIFO replacement policy for the Content Store.
+// Stale entries are evicted first, in the order they became stale.
+// If no entry is stale, the entry inserted first is evicted.
+// Uses of entries do not change the eviction order.
+type CsPriorityFIFO struct {
+	cs      PitCsTable
+	queue   *list.List
+	stale   priority_queue.Queue[uint64, int64]
+	entries map[uint64]*csFifoEntry
+}
+
+// csFifoEntry is the position of an entry in the FIFO and stale queues.
+type csFifoEntry struct {
+	location *list.Element
+	item     *priority_queue.Item[uint64, int64]
+}
+
+// NewCsPriorityFIFO creates a new priority FIFO replacement policy for the Content Store.
+func NewCsPriorityFIFO(cs PitCsTable) *CsPriorityFIFO {
+	p := new(CsPriorityFIFO)
+	p.cs = cs
+	p.queue = list.New()
+	p.stale = priority_queue.New[uint64, int64]()
+	p.entries = make(map[uint64]*csFifoEntry)
+	return p
+}
+
+// AfterInsert is called after a new entry is inserted into the Content Store.
+func (p *CsPriorityFIFO) AfterInsert(index uint64, wire []byte, data *defn.FwData) {
+	p.BeforeErase(index, wire)
+
+	p.entries[index] = &csFifoEntry{
+		location: p.queue.PushBack(index),
+		item:     p.stale.Push(index, csFifoStaleTime(data)),
+	}
+}
+
+// AfterRefresh is called after a new data packet refreshes an existing entry in the Content Store.
+func (p *CsPriorityFIFO) AfterRefresh(index uint64, wire []byte, data *defn.FwData) {
+	p.AfterInsert(index, wire, data)
+}
+
+// BeforeErase is called before an entry is erased from the Content Store through management.
+func (p *CsPriorityFIFO) BeforeErase(index uint64, wire []byte) {
+	if entry, ok := p.entries[index]; ok {
+		p.remove(index, entry)
+	}
+}
+
+// BeforeUse is called before an entry in the Content Store is used to satisfy a pending Interest.
+func (p *CsPriorityFIFO) BeforeUse(index uint64, wire []byte) {
+	// Uses do not change the eviction order
+}
+
+// EvictEntries is called to instruct the policy to evict enough entries to reduce the Content Store size
+// below its size limit.
+func (p *CsPriorityFIFO) EvictEntries() {
+	now := time.Now().UnixNano()
+	for p.queue.Len() > 0 && p.cs.csOverCapacity() {
+		indexToErase := p.queue.Front().Value.(uint64)
+		if p.stale.PeekPriority() <= now {
+			indexToErase = p.stale.Peek()
+		}
+
+		p.remove(indexToErase, p.entries[indexToErase])
+		p.cs.eraseCsDataFromReplacementStrategy(indexToErase)
+	}
+}
+
+// remove removes the entry from both queues.
+func (p *CsPriorityFIFO) remove(index uint64, entry *csFifoEntry) {
+	p.queue.Remove(entry.location)
+	p.stale.UpdatePriority(entry.item, math.MinInt64)
+	p.stale.Pop()
+	delete(p.entries, index)
+}
+
+// csFifoStaleTime returns the time at which the Data becomes stale.
+func csFifoStaleTime(data *defn.FwData) int64 {
+	staleTime := time.Now()
+	if data.MetaInfo != nil && data.MetaInfo.FreshnessPeriod.IsSet() {
+		staleTime = staleTime.Add(data.MetaInfo.FreshnessPeriod.Unwrap())
+	}
+	return staleTime.UnixNano()
+}
@@ -0,0 +1,266 @@
+package table
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/fw/defn"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+var csReplacementPolicies = []string{"lru", "arc", "lfu", "priority_fifo"}
+
+// makeCsName returns the name of the i-th object in a test.
+func makeCsName(i int) enc.Name {
+	name, _ := enc.NameFromStr(fmt.Sprintf("/cs/%d", i))
+	return name
+}
+
+// makeFreshData creates a Data packet that stays fresh for the given period.
+func makeFreshData(name enc.Name, freshness time.Duration) *defn.FwData {
+	return &defn.FwData{
+		NameV:    name,
+		MetaInfo: &defn.FwMetaInfo{FreshnessPeriod: optional.Some(freshness)},
+	}
+}
+
+// csHas checks whether the CS contains the name, without notifying the replacement policy.
+func csHas(pitCS *PitCsTree, name enc.Name) bool {
+	_, ok := pitCS.csMap[name.Hash()]
+	return ok
+}
+
+func TestCsARC(t *testing.T) {
+	setReplacementPolicy("arc")
+	CfgSetCsCapacity(4)
+	pitCS := NewPitCS(func(PitEntry) {})
+
+	// Objects used twice move to the frequency queue
+	for i := 0; i < 2; i++ {
+		pitCS.InsertData(makeData(makeCsName(i)), []byte{0x06, 0x00})
+		assert.NotNil(t, pitCS.FindMatchingDataFromCS(makeInterest(makeCsName(i))))
+	}
+
+	// A scan of objects used once does not evict them
+	for i := 2; i < 8; i++ {
+		pitCS.InsertData(makeData(makeCsName(i)), []byte{0x06, 0x00})
+	}
+	assert.Equal(t, 4, pitCS.CsSize())
+	assert.True(t, csHas(pitCS, makeCsName(0)))
+	assert.True(t, csHas(pitCS, makeCsName(1)))
+	assert.False(t, csHas(pitCS, makeCsName(2)))
+	assert.True(t, csHas(pitCS, makeCsName(6)))
+	assert.True(t, csHas(pitCS, makeCsName(7)))
+
+	// A hit in the history grows the recency target
+	arc := pitCS.csReplacement.(*CsARC)
+	pitCS.InsertData(makeData(makeCsName(5)), []byte{0x06, 0x00})
+	assert.Equal(t, 1, arc.target)
+	assert.Equal(t, 4, pitCS.CsSize())
+	assert.True(t, csHas(pitCS, makeCsName(5)))
+	assert.False(t, csHas(pitCS, makeCsName(6)))
+	assert.Equal(t, 3, arc.queues[arcT2].Len())
+}
+
+func TestCsLFU(t *testing.T) {
+	setReplacementPolicy("lfu")
+	CfgSetCsCapacity(3)
+	pitCS := NewPitCS(func(PitEntry) {})
+
+	for i := 0; i < 3; i++ {
+		pitCS.InsertData(makeData(makeCsName(i)), []byte{0x06, 0x00})
+	}
+	for i := 0; i < 2; i++ {
+		pitCS.FindMatchingDataFromCS(makeInterest(makeCsName(0)))
+	}
+	pitCS.FindMatchingDataFromCS(makeInterest(makeCsName(1)))
+
+	// Least frequently used entry is evicted
+	pitCS.InsertData(makeData(makeCsName(3)), []byte{0x06, 0x00})
+	assert.Equal(t, 3, pitCS.CsSize())
+	assert.False(t, csHas(pitCS, makeCsName(2)))
+	assert.True(t, csHas(pitCS, makeCsName(0)))
+	assert.Equal(t, uint64(1), pitCS.csReplacement.(*CsLFU).age)
+
+	// Aging eventually evicts formerly popular entries
+	for i := 4; i < 12; i++ {
+		pitCS.InsertData(makeData(makeCsName(i)), []byte{0x06, 0x00})
+	}
+	assert.Equal(t, 3, pitCS.CsSize())
+	assert.False(t, csHas(pitCS, makeCsName(0)))
+	assert.True(t, csHas(pitCS, makeCsName(11)))
+}
+
+func TestCsPriorityFIFO(t *testing.T) {
+	setReplacementPolicy("priority_fifo")
+	CfgSetCsCapacity(3)
+	pitCS := NewPitCS(func(PitEntry) {})
+
+	pitCS.InsertData(makeFreshData(makeCsName(0), time.Minute), []byte{0x06, 0x00})
+	pitCS.InsertData(makeData(makeCsName(1)), []byte{0x06, 0x00})
+	pitCS.InsertData(makeFreshData(makeCsName(2), time.Minute), []byte{0x06, 0x00})
+	assert.NotNil(t, pitCS.FindMatchingDataFromCS(makeInterest(makeCsName(0))))
+
+	// Stale entries are evicted first
+	pitCS.InsertData(makeFreshData(makeCsName(3), time.Minute), []byte{0x06, 0x00})
+	assert.False(t, csHas(pitCS, makeCsName(1)))
+	assert.True(t, csHas(pitCS, makeCsName(0)))
+
+	// Otherwise entries are evicted in insertion order, regardless of use
+	pitCS.InsertData(makeFreshData(makeCsName(4), time.Minute), []byte{0x06, 0x00})
+	assert.False(t, csHas(pitCS, makeCsName(0)))
+	assert.True(t, csHas(pitCS, makeCsName(2)))
+
+	// Refreshed entries are moved to the back of the queue
+	pitCS.InsertData(makeFreshData(makeCsName(2), time.Minute), []byte{0x06, 0x00})
+	pitCS.InsertData(makeFreshData(makeCsName(5), time.Minute), []byte{0x06, 0x00})
+	assert.False(t, csHas(pitCS, makeCsName(3)))
+	assert.True(t, csHas(pitCS, makeCsName(2)))
+}
+
+func TestCsReplacementErase(t *testing.T) {
+	for _, policy := range csReplacementPolicies {
+		setReplacementPolicy(policy)
+		CfgSetCsCapacity(8)
+		pitCS := NewPitCS(func(PitEntry) {})
+
+		for i := 0; i < 8; i++ {
+			pitCS.InsertData(makeData(makeCsName(i)), []byte{0x06, 0x00})
+		}
+		pitCS.FindMatchingDataFromCS(makeInterest(makeCsName(0)))
+
+		// Erased entries are no longer tracked by the policy
+		assert.Equal(t, 8, pitCS.EraseCsDataByPrefix(enc.Name{}, 8), policy)
+		for i := 8; i < 24; i++ {
+			pitCS.InsertData(makeData(makeCsName(i)), []byte{0x06, 0x00})
+		}
+		assert.Equal(t, 8, pitCS.CsSize(), policy)
+		assert.True(t, csHas(pitCS, makeCsName(23)), policy)
+	}
+}
+
+// csTrace is a sequence of requested object IDs.
+type csTrace struct {
+	name     string
+	requests []int
+	objects  int
+}
+
+// makeZipfTrace generates requests for objects with Zipf-distributed popularity.
+func makeZipfTrace(objects int, length int) csTrace {
+	rng := rand.New(rand.NewSource(1))
+	zipf := rand.NewZipf(rng, 1.1, 1, uint64(objects-1))
+	trace := csTrace{name: "zipf", objects: objects}
+	for range length {
+		trace.requests = append(trace.requests, int(zipf.Uint64()))
+	}
+	return trace
+}
+
+// makeVideoTrace generates requests for all segments of videos with Zipf-distributed popularity.
+func makeVideoTrace(videos int, segments int, length int) csTrace {
+	rng := rand.New(rand.NewSource(1))
+	zipf := rand.NewZipf(rng, 1.1, 1, uint64(videos-1))
+	trace := csTrace{name: "video", objects: videos * segments}
+	for len(trace.requests) < length {
+		video := int(zipf.Uint64())
+		for s := range segments {
+			trace.requests = append(trace.requests, video*segments+s)
+		}
+	}
+	return trace
+}
+
+// makeScanTrace generates a Zipf trace interrupted by scans of objects requested once.
+func makeScanTrace(objects int, scan int, length int) csTrace {
+	trace := makeZipfTrace(objects, length)
+	trace.name = "scan"
+	next := objects
+	for i := 0; i < len(trace.requests); i += 4 * scan {
+		for j := i; j < min(i+scan, len(trace.requests)); j++ {
+			trace.requests[j] = next
+			next++
+		}
+	}
+	trace.objects = next
+	return trace
+}
+
+// loadCsTrace loads a recorded trace with one requested name per line.
+func loadCsTrace(path string) (csTrace, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return csTrace{}, err
+	}
+	defer file.Close()
+
+	trace := csTrace{name: "recorded"}
+	ids := make(map[string]int)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		id, ok := ids[line]
+		if !ok {
+			id = len(ids)
+			ids[line] = id
+		}
+		trace.requests = append(trace.requests, id)
+	}
+	trace.objects = len(ids)
+	return trace, scanner.Err()
+}
+
+// BenchmarkCsReplacement replays traces through the CS of each policy and reports the hit ratio.
+// A recorded trace with one name per line can be replayed by setting NDND_CS_TRACE.
+func BenchmarkCsReplacement(b *testing.B) {
+	traces := []csTrace{
+		makeZipfTrace(10000, 200000),
+		makeVideoTrace(500, 20, 200000),
+		makeScanTrace(10000, 500, 200000),
+	}
+	if path := os.Getenv("NDND_CS_TRACE"); path != "" {
+		trace, err := loadCsTrace(path)
+		if err != nil {
+			b.Fatalf("unable to load trace %s: %v", path, err)
+		}
+		traces = append(traces, trace)
+	}
+
+	for _, trace := range traces {
+		data := make([]*defn.FwData, trace.objects)
+		interests := make([]*defn.FwInterest, trace.objects)
+		for i := range trace.objects {
+			data[i] = makeFreshData(makeCsName(i), time.Hour)
+			interests[i] = makeInterest(data[i].NameV)
+		}
+
+		for _, policy := range csReplacementPolicies {
+			b.Run(trace.name+"/"+policy, func(b *testing.B) {
+				setReplacementPolicy(policy)
+				CfgSetCsCapacity(1000)
+				pitCS := NewPitCS(func(PitEntry) {})
+
+				hits := 0
+				b.ResetTimer()
+				for i := range b.N {
+					id := trace.requests[i%len(trace.requests)]
+					if pitCS.FindMatchingDataFromCS(interests[id]) != nil {
+						hits++
+					} else {
+						pitCS.InsertData(data[id], []byte{0x06, 0x00})
+					}
+				}
+				b.ReportMetric(100*float64(hits)/float64(b.N), "hit%")
+			})
+		}
+	}
+}
@@ -50,11 +50,10 @@ type FibStrategyHashTable struct {
 	fibStrategyRWMutex sync.RWMutex
 }
 
-// newFibStrategyTableHashTable creates a new FIB with the hash table algorithm.
+// newFibStrategyHashTable creates a new FIB with the hash table algorithm.
 // The argument m determines the virtual name length.
-func newFibStrategyTableHashTable(m uint16) {
-	FibStrategyTable = new(FibStrategyHashTable)
-	fibStrategyTableHashTable := FibStrategyTable.(*FibStrategyHashTable)
+func newFibStrategyHashTable(m uint16) *FibStrategyHashTable {
+	fibStrategyTableHashTable := new(FibStrategyHashTable)
 
 	fibStrategyTableHashTable.m = int(m) // Cast to int so that it's easy to pass to name.Prefix
 	fibStrategyTableHashTable.realTable = make(map[uint64]*baseFibStrategyEntry)
@@ -65,6 +64,12 @@ func newFibStrategyTableHashTable(m uint16) {
 	rtEntry.name = enc.Name{}
 	rtEntry.strategy = defn.DEFAULT_STRATEGY
 	fibStrategyTableHashTable.realTable[enc.Name{}.Hash()] = rtEntry
+	return fibStrategyTableHashTable
+}
+
+// newFibStrategyTableHashTable sets FibStrategyTable to a new FIB with the hash table algorithm.
+func newFibStrategyTableHashTable(m uint16) {
+	FibStrategyTable = newFibStrategyHashTable(m)
 }
 
 // findLongestPrefixMatch returns the entry corresponding to the longest
@@ -32,16 +32,21 @@ type FibStrategyTree struct {
 	mutex sync.RWMutex
 }
 
-// (AI GENERATED DESCRIPTION): Initializes the FibStrategyTable with a new FibStrategyTree whose root entry has an empty component, default strategy, and empty name.
-func newFibStrategyTableTree() {
-	FibStrategyTable = new(FibStrategyTree)
-	tree := FibStrategyTable.(*FibStrategyTree)
+// newFibStrategyTree creates a new FIB with the name tree algorithm.
+func newFibStrategyTree() *FibStrategyTree {
+	tree := new(FibStrategyTree)
 
 	// Root component will be empty
 	tree.root = new(fibStrategyTreeEntry)
 	tree.root.component = enc.Component{}
 	tree.root.strategy = defn.DEFAULT_STRATEGY
 	tree.root.name = enc.Name{}
+	return tree
+}
+
+// newFibStrategyTableTree sets FibStrategyTable to a new FIB with the name tree algorithm.
+func newFibStrategyTableTree() {
+	FibStrategyTable = newFibStrategyTree()
 }
 
 // findExactMatchEntry returns the entry corresponding to the exact match of
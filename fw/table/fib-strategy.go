@@ -8,6 +8,7 @@
 package table
 
 import (
+	"github.com/named-data/ndnd/fw/core"
 	enc "github.com/named-data/ndnd/std/encoding"
 )
 
@@ -50,6 +51,19 @@ type FibStrategy interface {
 // FibStrategy is a table containing FIB and Strategy entries for given prefixes.
 var FibStrategyTable FibStrategy
 
+// NewFibStrategyTable creates an empty FIB-Strategy table with the configured algorithm.
+func NewFibStrategyTable() FibStrategy {
+	switch core.C.Tables.Fib.Algorithm {
+	case "hashtable":
+		return newFibStrategyHashTable(core.C.Tables.Fib.Hashtable.M)
+	case "nametree":
+		return newFibStrategyTree()
+	default:
+		core.Log.Fatal(nil, "Unknown FIB table algorithm", "algo", core.C.Tables.Fib.Algorithm)
+		return nil
+	}
+}
+
 // Name returns the name associated with the baseFibStrategyEntry.
 func (e *baseFibStrategyEntry) Name() enc.Name {
 	return e.name
@@ -0,0 +1,284 @@
+/* YaNFD - Yet another NDN Forwarding Daemon
+ *
+ * Copyright (C) 2020-2021 Eric Newberry.
+ *
+ * This file is licensed under the terms of the MIT License, as found in LICENSE.md.
+ */
+
+package table
+
+import (
+	"sync/atomic"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/types/priority_queue"
+)
+
+// DefaultMeasurementsLifetime is the initial lifetime of a Measurements entry.
+const DefaultMeasurementsLifetime = 4 * time.Second
+
+const measurementsTickerInterval = 1 * time.Second
+
+// MeasurementsTable stores per-prefix state for forwarding strategies.
+// Each forwarding thread has a separate Measurements table.
+// Warning: All functions must be called in the forwarding goroutine that owns the table.
+type MeasurementsTable struct {
+	root        *measurementsNode
+	nEntries    atomic.Int64
+	expiryQueue priority_queue.Queue[*MeasurementsEntry, int64]
+	Ticker      *time.Ticker
+}
+
+// measurementsNode is a node in the Measurements name tree.
+type measurementsNode struct {
+	component enc.Component
+	depth     int
+	parent    *measurementsNode
+	children  map[uint64]*measurementsNode
+	entry     *MeasurementsEntry
+}
+
+// MeasurementsEntry holds the measurements of a name prefix.
+type MeasurementsEntry struct {
+	name           enc.Name
+	node           *measurementsNode
+	expirationTime time.Time
+	pqItem         *priority_queue.Item[*MeasurementsEntry, int64]
+	faces          map[uint64]*MeasurementsFaceInfo
+	strategyInfo   map[string]any
+
+	// LastWorkingFace is the last face that satisfied an Interest under this prefix (0 if none).
+	LastWorkingFace uint64
+}
+
+// MeasurementsFaceInfo holds the measurements of an upstream face for a name prefix.
+type MeasurementsFaceInfo struct {
+	Rtt     RttEstimator
+	LastRtt time.Duration
+
+	NSatisfied           uint64
+	NTimeouts            uint64
+	NConsecutiveTimeouts uint64
+}
+
+// NewMeasurementsTable creates a new Measurements table for a forwarding thread.
+func NewMeasurementsTable() *MeasurementsTable {
+	m := new(MeasurementsTable)
+	m.root = newMeasurementsNode()
+	m.expiryQueue = priority_queue.New[*MeasurementsEntry, int64]()
+	m.Ticker = time.NewTicker(measurementsTickerInterval)
+	return m
+}
+
+// newMeasurementsNode creates an empty Measurements name tree node.
+func newMeasurementsNode() *measurementsNode {
+	return &measurementsNode{children: make(map[uint64]*measurementsNode)}
+}
+
+// Get returns the entry for the given name, creating it if it does not exist.
+// New entries expire after DefaultMeasurementsLifetime unless extended.
+func (m *MeasurementsTable) Get(name enc.Name) *MeasurementsEntry {
+	node := m.root.fillTreeToPrefixEnc(name)
+	if node.entry == nil {
+		node.entry = &MeasurementsEntry{
+			name:           name.Clone(),
+			node:           node,
+			expirationTime: time.Now().Add(DefaultMeasurementsLifetime),
+			faces:          make(map[uint64]*MeasurementsFaceInfo),
+		}
+		node.entry.pqItem = m.expiryQueue.Push(node.entry, node.entry.expirationTime.UnixNano())
+		m.nEntries.Add(1)
+	}
+	return node.entry
+}
+
+// FindExactMatch returns the entry for the given name, or nil if none exists.
+func (m *MeasurementsTable) FindExactMatch(name enc.Name) *MeasurementsEntry {
+	node := m.root.findLongestPrefixEntryEnc(name)
+	if node.depth == len(name) {
+		return node.entry
+	}
+	return nil
+}
+
+// FindLongestPrefixMatch returns the entry with the longest prefix of the given name, or nil if none exists.
+func (m *MeasurementsTable) FindLongestPrefixMatch(name enc.Name) *MeasurementsEntry {
+	for node := m.root.findLongestPrefixEntryEnc(name); node != nil; node = node.parent {
+		if node.entry != nil {
+			return node.entry
+		}
+	}
+	return nil
+}
+
+// ExtendLifetime ensures the entry does not expire before the specified lifetime elapses.
+func (m *MeasurementsTable) ExtendLifetime(entry *MeasurementsEntry, lifetime time.Duration) {
+	if entry.node == nil {
+		return // erased
+	}
+
+	expiry := time.Now().Add(lifetime)
+	if expiry.After(entry.expirationTime) {
+		entry.expirationTime = expiry
+		m.expiryQueue.UpdatePriority(entry.pqItem, expiry.UnixNano())
+	}
+}
+
+// Erase removes the entry from the table.
+func (m *MeasurementsTable) Erase(entry *MeasurementsEntry) {
+	if entry.node == nil {
+		return // already erased
+	}
+
+	entry.node.entry = nil
+	entry.node.pruneIfEmpty()
+	entry.node = nil
+	m.nEntries.Add(-1)
+
+	// The queue item will be discarded on the next update
+	m.expiryQueue.UpdatePriority(entry.pqItem, 0)
+}
+
+// Update removes all expired entries from the table.
+func (m *MeasurementsTable) Update() {
+	now := time.Now().UnixNano()
+	for m.expiryQueue.Len() > 0 && m.expiryQueue.PeekPriority() <= now {
+		entry := m.expiryQueue.Pop()
+		entry.pqItem = nil
+		if entry.node != nil {
+			entry.node.entry = nil
+			entry.node.pruneIfEmpty()
+			entry.node = nil
+			m.nEntries.Add(-1)
+		}
+	}
+}
+
+// Size returns the number of entries in the table.
+func (m *MeasurementsTable) Size() int {
+	return int(m.nEntries.Load())
+}
+
+// GetAll returns all entries in the table.
+func (m *MeasurementsTable) GetAll() []*MeasurementsEntry {
+	entries := make([]*MeasurementsEntry, 0, m.Size())
+	var walk func(node *measurementsNode)
+	walk = func(node *measurementsNode) {
+		if node.entry != nil {
+			entries = append(entries, node.entry)
+		}
+		for _, child := range node.children {
+			walk(child)
+		}
+	}
+	walk(m.root)
+	return entries
+}
+
+// Name returns the name prefix of the entry.
+func (e *MeasurementsEntry) Name() enc.Name {
+	return e.name
+}
+
+// ExpirationTime returns the time at which the entry expires.
+func (e *MeasurementsEntry) ExpirationTime() time.Time {
+	return e.expirationTime
+}
+
+// Face returns the measurements of the specified face, creating them if they do not exist.
+func (e *MeasurementsEntry) Face(faceID uint64) *MeasurementsFaceInfo {
+	info := e.faces[faceID]
+	if info == nil {
+		info = &MeasurementsFaceInfo{Rtt: NewRttEstimator()}
+		e.faces[faceID] = info
+	}
+	return info
+}
+
+// FindFace returns the measurements of the specified face, or nil if there are none.
+func (e *MeasurementsEntry) FindFace(faceID uint64) *MeasurementsFaceInfo {
+	return e.faces[faceID]
+}
+
+// Faces returns the measurements of all faces in the entry. Key is face ID.
+func (e *MeasurementsEntry) Faces() map[uint64]*MeasurementsFaceInfo {
+	return e.faces
+}
+
+// EraseFace removes the measurements of the specified face.
+func (e *MeasurementsEntry) EraseFace(faceID uint64) {
+	delete(e.faces, faceID)
+	if e.LastWorkingFace == faceID {
+		e.LastWorkingFace = 0
+	}
+}
+
+// StrategyInfo returns strategy-specific information stored with the key, or nil.
+func (e *MeasurementsEntry) StrategyInfo(key string) any {
+	return e.strategyInfo[key]
+}
+
+// SetStrategyInfo stores strategy-specific information with the key.
+func (e *MeasurementsEntry) SetStrategyInfo(key string, info any) {
+	if e.strategyInfo == nil {
+		e.strategyInfo = make(map[string]any)
+	}
+	e.strategyInfo[key] = info
+}
+
+// RecordRtt records an RTT sample for an Interest satisfied by the face.
+func (f *MeasurementsFaceInfo) RecordRtt(rtt time.Duration) {
+	f.Rtt.AddMeasurement(rtt)
+	f.LastRtt = rtt
+	f.NSatisfied++
+	f.NConsecutiveTimeouts = 0
+}
+
+// RecordTimeout records an Interest that timed out on the face.
+func (f *MeasurementsFaceInfo) RecordTimeout() {
+	f.NTimeouts++
+	f.NConsecutiveTimeouts++
+	f.Rtt.Backoff()
+}
+
+// SuccessRatio returns the ratio of satisfied Interests over all measured Interests.
+// If there was no measurement, the ratio is 1.
+func (f *MeasurementsFaceInfo) SuccessRatio() float64 {
+	total := f.NSatisfied + f.NTimeouts
+	if total == 0 {
+		return 1
+	}
+	return float64(f.NSatisfied) / float64(total)
+}
+
+// findLongestPrefixEntryEnc returns the deepest node matching a prefix of the name.
+func (n *measurementsNode) findLongestPrefixEntryEnc(name enc.Name) *measurementsNode {
+	if len(name) > n.depth {
+		if child, ok := n.children[At(name, n.depth).Hash()]; ok {
+			return child.findLongestPrefixEntryEnc(name)
+		}
+	}
+	return n
+}
+
+// fillTreeToPrefixEnc creates any missing nodes up to the name and returns the node of the name.
+func (n *measurementsNode) fillTreeToPrefixEnc(name enc.Name) *measurementsNode {
+	node := n.findLongestPrefixEntryEnc(name)
+	for depth := node.depth; depth < len(name); depth++ {
+		child := newMeasurementsNode()
+		child.component = At(name, depth).Clone()
+		child.depth = depth + 1
+		child.parent = node
+		node.children[child.component.Hash()] = child
+		node = child
+	}
+	return node
+}
+
+// pruneIfEmpty removes empty leaf nodes from the tree, starting at this node.
+func (n *measurementsNode) pruneIfEmpty() {
+	for node := n; node.parent != nil && len(node.children) == 0 && node.entry == nil; node = node.parent {
+		delete(node.parent.children, node.component.Hash())
+	}
+}
@@ -0,0 +1,104 @@
+package table
+
+import (
+	"testing"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeasurementsGetAndFind(t *testing.T) {
+	m := NewMeasurementsTable()
+	nameA, _ := enc.NameFromStr("/a")
+	nameAB, _ := enc.NameFromStr("/a/b")
+	nameABC, _ := enc.NameFromStr("/a/b/c")
+	nameX, _ := enc.NameFromStr("/x")
+
+	assert.Nil(t, m.FindExactMatch(nameA))
+	assert.Nil(t, m.FindLongestPrefixMatch(nameABC))
+
+	entryA := m.Get(nameA)
+	assert.True(t, entryA.Name().Equal(nameA))
+	assert.Equal(t, 1, m.Size())
+	assert.Same(t, entryA, m.Get(nameA))
+	assert.Equal(t, 1, m.Size())
+
+	assert.Same(t, entryA, m.FindExactMatch(nameA))
+	assert.Nil(t, m.FindExactMatch(nameAB))
+	assert.Same(t, entryA, m.FindLongestPrefixMatch(nameABC))
+	assert.Nil(t, m.FindLongestPrefixMatch(nameX))
+
+	entryAB := m.Get(nameAB)
+	assert.Equal(t, 2, m.Size())
+	assert.Same(t, entryAB, m.FindLongestPrefixMatch(nameABC))
+	assert.Len(t, m.GetAll(), 2)
+
+	m.Erase(entryAB)
+	assert.Equal(t, 1, m.Size())
+	assert.Same(t, entryA, m.FindLongestPrefixMatch(nameABC))
+	assert.Len(t, m.GetAll(), 1)
+
+	// Erased entries must be discarded by the expiry queue
+	m.Update()
+	assert.Equal(t, 1, m.Size())
+}
+
+func TestMeasurementsExpiry(t *testing.T) {
+	m := NewMeasurementsTable()
+	nameA, _ := enc.NameFromStr("/a")
+	nameB, _ := enc.NameFromStr("/b")
+
+	entryA := m.Get(nameA)
+	entryB := m.Get(nameB)
+	m.ExtendLifetime(entryB, time.Hour)
+	assert.True(t, entryB.ExpirationTime().After(time.Now().Add(time.Minute)))
+
+	// Shortening is not possible
+	m.ExtendLifetime(entryB, time.Millisecond)
+	assert.True(t, entryB.ExpirationTime().After(time.Now().Add(time.Minute)))
+
+	m.Update()
+	assert.Equal(t, 2, m.Size())
+
+	// Force expiration of entry A
+	entryA.expirationTime = time.Now()
+	m.expiryQueue.UpdatePriority(entryA.pqItem, entryA.expirationTime.UnixNano())
+	m.Update()
+	assert.Equal(t, 1, m.Size())
+	assert.Nil(t, m.FindExactMatch(nameA))
+	assert.Same(t, entryB, m.FindExactMatch(nameB))
+}
+
+func TestMeasurementsFaceInfo(t *testing.T) {
+	m := NewMeasurementsTable()
+	name, _ := enc.NameFromStr("/a")
+	entry := m.Get(name)
+
+	assert.Nil(t, entry.FindFace(1))
+	face := entry.Face(1)
+	assert.Same(t, face, entry.FindFace(1))
+	assert.False(t, face.Rtt.HasMeasurement())
+	assert.Equal(t, 1.0, face.SuccessRatio())
+
+	face.RecordRtt(10 * time.Millisecond)
+	assert.True(t, face.Rtt.HasMeasurement())
+	assert.Equal(t, 10*time.Millisecond, face.Rtt.Srtt())
+
+	face.RecordTimeout()
+	face.RecordTimeout()
+	assert.Equal(t, uint64(2), face.NConsecutiveTimeouts)
+	assert.InDelta(t, 1.0/3.0, face.SuccessRatio(), 1e-9)
+
+	face.RecordRtt(10 * time.Millisecond)
+	assert.Equal(t, uint64(0), face.NConsecutiveTimeouts)
+
+	entry.LastWorkingFace = 1
+	entry.EraseFace(1)
+	assert.Nil(t, entry.FindFace(1))
+	assert.Equal(t, uint64(0), entry.LastWorkingFace)
+
+	entry.SetStrategyInfo("test", 42)
+	assert.Equal(t, 42, entry.StrategyInfo("test"))
+	assert.Nil(t, entry.StrategyInfo("other"))
+}
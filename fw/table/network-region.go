@@ -8,6 +8,8 @@
 package table
 
 import (
+	"sync"
+
 	enc "github.com/named-data/ndnd/std/encoding"
 )
 
@@ -16,10 +18,14 @@ var NetworkRegion = &networkRegionTable{}
 
 type networkRegionTable struct {
 	table []enc.Name
+	mutex sync.RWMutex
 }
 
 // Add adds a name to the network region table.
 func (n *networkRegionTable) Add(name enc.Name) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
 	for _, region := range n.table {
 		if region.Equal(name) {
 			return
@@ -30,6 +36,9 @@ func (n *networkRegionTable) Add(name enc.Name) {
 
 // IsProducer returns whether an entry in the network region table is a prefix of the specified name.
 func (n *networkRegionTable) IsProducer(name enc.Name) bool {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
 	for _, region := range n.table {
 		if region.IsPrefix(name) {
 			return true
@@ -37,3 +46,10 @@ func (n *networkRegionTable) IsProducer(name enc.Name) bool {
 	}
 	return false
 }
+
+// Set replaces all names in the network region table.
+func (n *networkRegionTable) Set(names []enc.Name) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.table = names
+}
@@ -15,6 +15,22 @@ const pitTokenLookupTableSize = 125000 // 1MB
 
 type OnPitExpiration func(PitEntry)
 
+// Usage of the Content Stores of all forwarding threads
+var csUsage = struct {
+	entries atomic.Int64
+	bytes   atomic.Int64
+}{}
+
+// CsTotalEntries returns the number of entries in the Content Stores of all forwarding threads.
+func CsTotalEntries() int {
+	return int(csUsage.entries.Load())
+}
+
+// CsTotalBytes returns the total size of the Data in the Content Stores of all forwarding threads.
+func CsTotalBytes() int {
+	return int(csUsage.bytes.Load())
+}
+
 // PitCsTree represents a PIT-CS implementation that uses a name tree
 type PitCsTree struct {
 	root *pitCsTreeNode
@@ -25,6 +41,7 @@ type PitCsTree struct {
 	pitTokens []*nameTreePitEntry
 
 	nCsEntries    atomic.Int64
+	nCsBytes      atomic.Int64
 	csReplacement CsReplacementPolicy
 	csMap         map[uint64]*nameTreeCsEntry
 
@@ -45,6 +62,11 @@ type nameTreeCsEntry struct {
 	node        *pitCsTreeNode // the tree node associated with this entry
 }
 
+// Name returns the name of the cached Data.
+func (e *nameTreeCsEntry) Name() enc.Name {
+	return e.node.name
+}
+
 // pitCsTreeNode represents an entry in a PIT-CS tree.
 type pitCsTreeNode struct {
 	component enc.Component
@@ -74,6 +96,12 @@ func NewPitCS(onExpiration OnPitExpiration) *PitCsTree {
 	switch CfgCsReplacementPolicy() {
 	case "lru":
 		pitCs.csReplacement = NewCsLRU(pitCs)
+	case "arc":
+		pitCs.csReplacement = NewCsARC(pitCs)
+	case "lfu":
+		pitCs.csReplacement = NewCsLFU(pitCs)
+	case "priority_fifo":
+		pitCs.csReplacement = NewCsPriorityFIFO(pitCs)
 	default:
 		core.Log.Fatal(nil, "Unknown CS replacement policy", "policy", CfgCsReplacementPolicy())
 	}
@@ -192,24 +220,29 @@ func (p *PitCsTree) RemoveInterest(pitEntry PitEntry) bool {
 	return false
 }
 
-// FindInterestExactMatch returns the PIT entry for an exact match of the
-// given interest.
-
-// FindInterestPrefixMatchByData returns all interests that could be satisfied
-// by the given data.
-// Example: If we have interests /a and /a/b, a prefix search for data with name /a/b
-// will return PitEntries for both /a and /a/b
+// FindInterestExactMatchEnc returns the PIT entry for an exact match of the
+// given interest. If several entries only differ by forwarding hint, the
+// entry with the same forwarding hint as the interest is preferred.
 func (p *PitCsTree) FindInterestExactMatchEnc(interest *defn.FwInterest) PitEntry {
 	node := p.root.findExactMatchEntryEnc(interest.NameV)
-	if node != nil {
-		for _, curEntry := range node.pitEntries {
-			if curEntry.CanBePrefix() == interest.CanBePrefixV &&
-				curEntry.MustBeFresh() == interest.MustBeFreshV {
+	if node == nil {
+		return nil
+	}
+
+	hint := interest.ForwardingHint()
+	var match PitEntry
+	for _, curEntry := range node.pitEntries {
+		if curEntry.CanBePrefix() == interest.CanBePrefixV &&
+			curEntry.MustBeFresh() == interest.MustBeFreshV {
+			if hint.Equal(curEntry.ForwardingHintNew()) {
 				return curEntry
 			}
+			if match == nil {
+				match = curEntry
+			}
 		}
 	}
-	return nil
+	return match
 }
 
 // FindInterestPrefixMatchByData returns all interests that could be satisfied
@@ -250,6 +283,11 @@ func (p *PitCsTree) CsSize() int {
 	return int(p.nCsEntries.Load())
 }
 
+// CsBytes returns the total size of the Data in the CS.
+func (p *PitCsTree) CsBytes() int {
+	return int(p.nCsBytes.Load())
+}
+
 // IsCsAdmitting returns whether the CS is admitting content.
 func (p *PitCsTree) IsCsAdmitting() bool {
 	return CfgCsAdmit()
@@ -321,9 +359,23 @@ func (p *pitCsTreeNode) getChildrenCount() int {
 // (AI GENERATED DESCRIPTION): Removes empty leaf nodes from the PIT/CS tree by climbing upward from the current node, deleting each node that has no children, PIT entries, or CS entry, and returning the pruned nodes to the pool.
 func (p *pitCsTreeNode) pruneIfEmpty() {
 	for curNode := p; curNode.parent != nil && curNode.getChildrenCount() == 0 &&
-		len(curNode.pitEntries) == 0 && curNode.csEntry == nil; curNode = curNode.parent {
-		delete(curNode.parent.children, curNode.component.Hash())
+		len(curNode.pitEntries) == 0 && curNode.csEntry == nil; {
+		// The node may be reused by another thread once it is in the pool
+		parent := curNode.parent
+		delete(parent.children, curNode.component.Hash())
 		PitCsPools.PitCsTreeNode.Put(curNode)
+		curNode = parent
+	}
+}
+
+// pruneEmptyDescendants removes all empty nodes below this node from the tree.
+func (p *pitCsTreeNode) pruneEmptyDescendants() {
+	for hash, child := range p.children {
+		child.pruneEmptyDescendants()
+		if child.getChildrenCount() == 0 && len(child.pitEntries) == 0 && child.csEntry == nil {
+			delete(p.children, hash)
+			PitCsPools.PitCsTreeNode.Put(child)
+		}
 	}
 }
 
@@ -372,13 +424,14 @@ func (p *PitCsTree) InsertData(data *defn.FwData, wire []byte) {
 
 	if entry, ok := p.csMap[index]; ok {
 		// Replace existing entry
+		p.accountCs(0, len(store)-len(entry.wire))
 		entry.wire = store
 		entry.staleTime = staleTime
 
 		p.csReplacement.AfterRefresh(index, wire, data)
 	} else {
 		// New entry
-		p.nCsEntries.Add(1)
+		p.accountCs(1, len(store))
 		node := p.root.fillTreeToPrefixEnc(data.NameV)
 		node.csEntry = &nameTreeCsEntry{
 			node: node,
@@ -391,10 +444,31 @@ func (p *PitCsTree) InsertData(data *defn.FwData, wire []byte) {
 
 		p.csMap[index] = node.csEntry
 		p.csReplacement.AfterInsert(index, wire, data)
-
-		// Tell replacement strategy to evict entries if needed
-		p.csReplacement.EvictEntries()
 	}
+
+	// Tell replacement strategy to evict entries if needed
+	p.csReplacement.EvictEntries()
+}
+
+// EvictCsEntries evicts entries until the CS is within its capacity.
+// This is needed after the capacity is reduced.
+func (p *PitCsTree) EvictCsEntries() {
+	p.csReplacement.EvictEntries()
+}
+
+// csOverCapacity returns whether the CS exceeds its capacity.
+func (p *PitCsTree) csOverCapacity() bool {
+	capacityBytes := CfgCsCapacityBytes()
+	return p.CsSize() > CfgCsCapacity() ||
+		(capacityBytes > 0 && p.CsBytes() > capacityBytes)
+}
+
+// accountCs updates the usage of the CS and of the Content Stores of all threads.
+func (p *PitCsTree) accountCs(entries int, bytes int) {
+	p.nCsEntries.Add(int64(entries))
+	p.nCsBytes.Add(int64(bytes))
+	csUsage.entries.Add(int64(entries))
+	csUsage.bytes.Add(int64(bytes))
 }
 
 // eraseCsDataFromReplacementStrategy allows the replacement strategy to
@@ -403,7 +477,60 @@ func (p *PitCsTree) eraseCsDataFromReplacementStrategy(index uint64) {
 	if entry, ok := p.csMap[index]; ok {
 		entry.node.csEntry = nil
 		delete(p.csMap, index)
-		p.nCsEntries.Add(-1)
+		p.accountCs(-1, -len(entry.wire))
+	}
+}
+
+// FindCsDataByPrefix returns at most limit CS entries under the prefix.
+func (p *PitCsTree) FindCsDataByPrefix(prefix enc.Name, limit int) []CsEntry {
+	node := p.root.findExactMatchEntryEnc(prefix)
+	if node == nil || limit <= 0 {
+		return nil
+	}
+
+	entries := make([]*nameTreeCsEntry, 0)
+	node.collectCsEntries(&entries, limit)
+
+	ret := make([]CsEntry, len(entries))
+	for i, entry := range entries {
+		ret[i] = entry
+	}
+	return ret
+}
+
+// EraseCsDataByPrefix erases at most limit CS entries under the prefix,
+// and returns the number of erased entries.
+func (p *PitCsTree) EraseCsDataByPrefix(prefix enc.Name, limit int) int {
+	node := p.root.findExactMatchEntryEnc(prefix)
+	if node == nil || limit <= 0 {
+		return 0
+	}
+
+	entries := make([]*nameTreeCsEntry, 0)
+	node.collectCsEntries(&entries, limit)
+
+	for _, entry := range entries {
+		p.csReplacement.BeforeErase(entry.index, entry.wire)
+		entry.node.csEntry = nil
+		delete(p.csMap, entry.index)
+		p.accountCs(-1, -len(entry.wire))
+	}
+
+	node.pruneEmptyDescendants()
+	node.pruneIfEmpty()
+	return len(entries)
+}
+
+// collectCsEntries appends up to limit CS entries reachable from this node.
+func (p *pitCsTreeNode) collectCsEntries(entries *[]*nameTreeCsEntry, limit int) {
+	if len(*entries) >= limit {
+		return
+	}
+	if p.csEntry != nil {
+		*entries = append(*entries, p.csEntry)
+	}
+	for _, child := range p.children {
+		child.collectCsEntries(entries, limit)
 	}
 }
 
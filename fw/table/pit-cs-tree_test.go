@@ -2,6 +2,7 @@ package table
 
 import (
 	"bytes"
+	"fmt"
 	"math/rand"
 	"sort"
 	"testing"
@@ -358,6 +359,16 @@ func TestFindInterestExactMatch(t *testing.T) {
 	_, _ = pitCS.InsertInterest(interest3, hint, inFace)
 	pitEntryNil = pitCS.FindInterestExactMatchEnc(interest)
 	assert.Nil(t, pitEntryNil)
+
+	// Entries that only differ by forwarding hint
+	hint2, _ := enc.NameFromStr("/hint2")
+	interest4 := makeInterest(name)
+	interest4.ForwardingHintV = &defn.FwLinks{Names: []enc.Name{hint2}}
+	pitEntry1, _ := pitCS.InsertInterest(interest, nil, inFace)
+	pitEntry2, _ := pitCS.InsertInterest(interest4, hint2, inFace)
+	assert.NotEqual(t, pitEntry1, pitEntry2)
+	assert.Equal(t, pitEntry1, pitCS.FindInterestExactMatchEnc(interest))
+	assert.Equal(t, pitEntry2, pitCS.FindInterestExactMatchEnc(interest4))
 }
 
 // (AI GENERATED DESCRIPTION): FindInterestPrefixMatchByDataEnc returns all PIT entries whose interest name is a prefix of the supplied Data packet name, enabling prefix‑based lookup for satisfying pending interests.
@@ -428,10 +439,12 @@ func TestInsertOutRecord(t *testing.T) {
 	oldNonce := uint32(2)
 	interest.NonceV.Set(oldNonce)
 	interest.NonceV.Set(3)
+	outRecord.NackReason = optional.Some(uint64(150))
 	outRecord = pitEntry.InsertOutRecord(interest, inFace)
 	assert.Equal(t, outRecord.Face, inFace)
 	assert.True(t, outRecord.LatestNonce == interest.NonceV.Unwrap())
 	assert.False(t, outRecord.LatestNonce == oldNonce)
+	assert.False(t, outRecord.NackReason.IsSet())
 
 	// Add new outrecord on a different face
 	inFace2 := uint64(2222)
@@ -554,3 +567,123 @@ func FindMatchingDataFromCS(t *testing.T) {
 	pitCS.InsertData(data2, VALID_DATA_2)
 	assert.Equal(t, pitCS.CsSize(), 1)
 }
+
+func TestEraseCsDataByPrefix(t *testing.T) {
+	setReplacementPolicy("lru")
+	CfgSetCsCapacity(1024)
+	pitCS := NewPitCS(func(PitEntry) {})
+
+	for _, s := range []string{"/a", "/a/b", "/a/b/c", "/a/d", "/x/y"} {
+		name, _ := enc.NameFromStr(s)
+		pitCS.InsertData(makeData(name), []byte{0x06, 0x00})
+	}
+	assert.Equal(t, 5, pitCS.CsSize())
+
+	// Unknown prefix
+	prefixZ, _ := enc.NameFromStr("/z")
+	assert.Equal(t, 0, pitCS.EraseCsDataByPrefix(prefixZ, 10))
+
+	// Limited erase
+	prefixA, _ := enc.NameFromStr("/a")
+	assert.Equal(t, 2, pitCS.EraseCsDataByPrefix(prefixA, 2))
+	assert.Equal(t, 3, pitCS.CsSize())
+
+	// Erase the rest of the prefix
+	assert.Equal(t, 2, pitCS.EraseCsDataByPrefix(prefixA, 10))
+	assert.Equal(t, 1, pitCS.CsSize())
+	assert.Nil(t, pitCS.root.findExactMatchEntryEnc(prefixA))
+
+	nameXY, _ := enc.NameFromStr("/x/y")
+	interest := makeInterest(nameXY)
+	assert.NotNil(t, pitCS.FindMatchingDataFromCS(interest))
+
+	// Erase everything, and check that the replacement policy forgot the entries
+	assert.Equal(t, 1, pitCS.EraseCsDataByPrefix(enc.Name{}, 10))
+	assert.Equal(t, 0, pitCS.CsSize())
+	assert.Nil(t, pitCS.FindMatchingDataFromCS(interest))
+	assert.Equal(t, 0, pitCS.csReplacement.(*CsLRU).queue.Len())
+	assert.Equal(t, 0, len(pitCS.root.children))
+}
+
+func TestFindCsDataByPrefix(t *testing.T) {
+	setReplacementPolicy("lru")
+	CfgSetCsCapacity(1024)
+	pitCS := NewPitCS(func(PitEntry) {})
+
+	for _, s := range []string{"/a/b", "/a/c", "/x"} {
+		name, _ := enc.NameFromStr(s)
+		pitCS.InsertData(makeData(name), []byte{0x06, 0x00})
+	}
+
+	prefixA, _ := enc.NameFromStr("/a")
+	entries := pitCS.FindCsDataByPrefix(prefixA, 10)
+	assert.Len(t, entries, 2)
+	for _, entry := range entries {
+		assert.True(t, prefixA.IsPrefix(entry.Name()))
+		assert.Equal(t, 2, entry.Size())
+	}
+
+	assert.Len(t, pitCS.FindCsDataByPrefix(prefixA, 1), 1)
+	assert.Len(t, pitCS.FindCsDataByPrefix(enc.Name{}, 10), 3)
+	prefixZ, _ := enc.NameFromStr("/z")
+	assert.Len(t, pitCS.FindCsDataByPrefix(prefixZ, 10), 0)
+
+	// Lookup does not modify the CS
+	assert.Equal(t, 3, pitCS.CsSize())
+}
+
+func TestCsCapacityBytes(t *testing.T) {
+	setReplacementPolicy("lru")
+	CfgSetCsCapacity(1024)
+	CfgSetCsCapacityBytes(100)
+	defer CfgSetCsCapacityBytes(0)
+	pitCS := NewPitCS(func(PitEntry) {})
+	totalEntries, totalBytes := CsTotalEntries(), CsTotalBytes()
+
+	for i := range 5 {
+		name, _ := enc.NameFromStr(fmt.Sprintf("/a/%d", i))
+		pitCS.InsertData(makeData(name), make([]byte, 30))
+	}
+	assert.Equal(t, 3, pitCS.CsSize())
+	assert.Equal(t, 90, pitCS.CsBytes())
+	assert.Equal(t, totalEntries+3, CsTotalEntries())
+	assert.Equal(t, totalBytes+90, CsTotalBytes())
+
+	// Refreshing with a larger Data evicts other entries
+	name, _ := enc.NameFromStr("/a/4")
+	pitCS.InsertData(makeData(name), make([]byte, 60))
+	assert.Equal(t, 2, pitCS.CsSize())
+	assert.Equal(t, 90, pitCS.CsBytes())
+
+	// Reducing the capacity at runtime evicts entries
+	CfgSetCsCapacityBytes(70)
+	pitCS.EvictCsEntries()
+	assert.Equal(t, 1, pitCS.CsSize())
+	assert.Equal(t, 60, pitCS.CsBytes())
+
+	assert.Equal(t, 1, pitCS.EraseCsDataByPrefix(enc.Name{}, 10))
+	assert.Equal(t, 0, pitCS.CsBytes())
+	assert.Equal(t, totalEntries, CsTotalEntries())
+	assert.Equal(t, totalBytes, CsTotalBytes())
+}
+
+func TestCsCapacityPerThread(t *testing.T) {
+	setReplacementPolicy("lru")
+	CfgSetCsCapacity(3)
+	pitCS1 := NewPitCS(func(PitEntry) {})
+	pitCS2 := NewPitCS(func(PitEntry) {})
+	totalEntries := CsTotalEntries()
+
+	// The capacity applies to the CS of each thread
+	for i := range 10 {
+		name, _ := enc.NameFromStr(fmt.Sprintf("/a/%d", i))
+		pitCS1.InsertData(makeData(name), []byte{0x06, 0x00})
+		pitCS2.InsertData(makeData(name), []byte{0x06, 0x00})
+	}
+	assert.Equal(t, 3, pitCS1.CsSize())
+	assert.Equal(t, 3, pitCS2.CsSize())
+	assert.Equal(t, totalEntries+6, CsTotalEntries())
+
+	pitCS1.EraseCsDataByPrefix(enc.Name{}, 10)
+	pitCS2.EraseCsDataByPrefix(enc.Name{}, 10)
+}
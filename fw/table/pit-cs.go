@@ -12,6 +12,7 @@ import (
 
 	"github.com/named-data/ndnd/fw/defn"
 	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/types/optional"
 )
 
 // PitCsTable dictates what functionality a Pit-Cs table should implement
@@ -32,8 +33,16 @@ type PitCsTable interface {
 	InsertData(data *defn.FwData, wire []byte)
 	// FindMatchingDataFromCS finds a matching Data in the CS.
 	FindMatchingDataFromCS(interest *defn.FwInterest) CsEntry
+	// FindCsDataByPrefix returns at most limit Data under the prefix in the CS.
+	FindCsDataByPrefix(prefix enc.Name, limit int) []CsEntry
+	// EraseCsDataByPrefix erases at most limit Data under the prefix from the CS.
+	EraseCsDataByPrefix(prefix enc.Name, limit int) int
 	// CsSize returns the number of entries in the CS.
 	CsSize() int
+	// CsBytes returns the total size of the Data in the CS.
+	CsBytes() int
+	// EvictCsEntries evicts entries until the CS is within its capacity.
+	EvictCsEntries()
 	// IsCsAdmitting returns whether the CS is admitting new entries.
 	IsCsAdmitting() bool
 	// IsCsServing returns whether the CS is serving entries.
@@ -46,6 +55,8 @@ type PitCsTable interface {
 
 	// eraseCsDataFromReplacementStrategy removes a Data from the replacement strategy.
 	eraseCsDataFromReplacementStrategy(index uint64)
+	// csOverCapacity returns whether the CS exceeds its capacity.
+	csOverCapacity() bool
 	// updatePitExpiry updates the PIT entry's expiration time.
 	updatePitExpiry(pitEntry PitEntry)
 }
@@ -104,6 +115,8 @@ type PitInRecord struct {
 	LatestNonce     uint32
 	ExpirationTime  time.Time
 	PitToken        []byte
+	// NonDiscovery is set if the latest Interest must not be flooded (self-learning)
+	NonDiscovery bool
 }
 
 // PitOutRecord records an outgoing Interest on a given face.
@@ -112,11 +125,20 @@ type PitOutRecord struct {
 	LatestTimestamp time.Time
 	LatestNonce     uint32
 	ExpirationTime  time.Time
+
+	// NackReason is set when a Nack was received for the latest Interest.
+	NackReason optional.Optional[uint64]
+	// NonDiscovery is set if the latest Interest was sent as non-discovery (self-learning)
+	NonDiscovery bool
+	// TimedOut is set by strategies once the latest Interest was counted as timed out.
+	TimedOut bool
 }
 
 // CsEntry is an entry in a thread's CS.
 type CsEntry interface {
 	Index() uint64 // the hash of the entry, for fast lookup
+	Name() enc.Name
+	Size() int // size of the Data packet in bytes
 	StaleTime() time.Time
 	Copy() (*defn.FwData, []byte, error)
 }
@@ -170,6 +192,8 @@ func (bpe *basePitEntry) InsertOutRecord(interest *defn.FwInterest, face uint64)
 		record.LatestNonce = interest.NonceV.Unwrap()
 		record.LatestTimestamp = time.Now()
 		record.ExpirationTime = time.Now().Add(lifetime)
+		record.NackReason = optional.None[uint64]()
+		record.TimedOut = false
 		bpe.outRecords[face] = record
 		return record
 	}
@@ -178,6 +202,8 @@ func (bpe *basePitEntry) InsertOutRecord(interest *defn.FwInterest, face uint64)
 	record.LatestNonce = interest.NonceV.Unwrap()
 	record.LatestTimestamp = time.Now()
 	record.ExpirationTime = time.Now().Add(lifetime)
+	record.NackReason = optional.None[uint64]()
+	record.TimedOut = false
 	return record
 }
 
@@ -279,6 +305,11 @@ func (bce *baseCsEntry) Index() uint64 {
 	return bce.index
 }
 
+// Size returns the size of the cached Data packet in bytes.
+func (bce *baseCsEntry) Size() int {
+	return len(bce.wire)
+}
+
 // (AI GENERATED DESCRIPTION): Retrieves the stale time associated with this cache entry.
 func (bce *baseCsEntry) StaleTime() time.Time {
 	return bce.staleTime
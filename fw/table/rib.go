@@ -8,6 +8,7 @@
 package table
 
 import (
+	"bytes"
 	"container/list"
 	"sync"
 	"time"
@@ -22,10 +23,16 @@ import (
 type RibTable struct {
 	root  RibEntry
 	mutex sync.RWMutex
+
+	// FIB-Strategy table updated with the nexthops of the RIB
+	fib FibStrategy
+	// Readvertising instances
+	readvertisers []RibReadvertise
 }
 
 // RibEntry represents an entry in the RIB table.
 type RibEntry struct {
+	table     *RibTable
 	Name      enc.Name
 	component enc.Component
 	depth     int
@@ -43,13 +50,24 @@ type Route struct {
 	Cost             uint64
 	Flags            uint64
 	ExpirationPeriod *time.Duration
+	// Signed Prefix Announcement Data that created the route, if any
+	PrefixAnnouncement []byte
+
+	// Removes the route after its expiration period
+	expirationTimer *time.Timer
 }
 
-// Rib is the Routing Information Base.
-var Rib = RibTable{
-	root: RibEntry{
+// Rib is the Routing Information Base of the forwarder, created by Initialize.
+var Rib *RibTable
+
+// NewRibTable creates an empty RIB, which updates the nexthops of a FIB-Strategy table.
+func NewRibTable(fib FibStrategy) *RibTable {
+	r := &RibTable{fib: fib}
+	r.root = RibEntry{
+		table:    r,
 		children: make(map[uint64]*RibEntry),
-	},
+	}
+	return r
 }
 
 // (AI GENERATED DESCRIPTION): Adds any missing intermediate prefix nodes for a given name in the RIB tree, starting from the longest existing prefix and creating new entries down to the full name, then returns the leaf entry.
@@ -59,6 +77,7 @@ func (r *RibEntry) fillTreeToPrefixEnc(name enc.Name) *RibEntry {
 	for depth := entry.depth; depth < len(name); depth++ {
 		component := At(name, depth).Clone()
 		child := &RibEntry{
+			table:     r.table,
 			Name:      entry.Name.Append(component),
 			depth:     depth + 1,
 			component: component,
@@ -104,40 +123,52 @@ func (r *RibEntry) pruneIfEmpty() {
 }
 
 // updateNexthopsEnc recursively updates the FIB nexthops under this entry.
+// The nexthops of an entry are its own routes, and the routes of its ancestors
+// with the child-inherit flag, up to and including the nearest ancestor with
+// a capture route. Routes of the entry take precedence over inherited routes,
+// and routes of nearer ancestors over routes of farther ones, for the same face.
 func (r *RibEntry) updateNexthopsEnc() {
-	FibStrategyTable.ClearNextHopsEnc(r.Name)
+	r.table.fib.ClearNextHopsEnc(r.Name)
 
 	if len(r.routes) > 0 {
-		// All routes including parents if needed
-		routes := slices.Clone(r.routes)
+		// Find minimum cost route per nexthop
+		nexthops := make(map[uint64]uint64) // FaceID -> Cost
+		for _, route := range r.routes {
+			cost, ok := nexthops[route.FaceID]
+			if !ok || route.Cost < cost {
+				nexthops[route.FaceID] = route.Cost
+			}
+		}
 
 		// Get all possible nexthops for parents that are inherited,
 		// unless we have the capture flag set
 		if !r.HasCaptureRoute() {
-			for entry := r; entry != nil; entry = entry.parent {
+			for entry := r.parent; entry != nil; entry = entry.parent {
+				inherited := make(map[uint64]uint64)
 				for _, route := range entry.routes {
-					if route.HasChildInheritFlag() {
-						routes = append(routes, route)
+					if !route.HasChildInheritFlag() {
+						continue
 					}
-					if route.HasCaptureFlag() {
-						break
+					cost, ok := inherited[route.FaceID]
+					if !ok || route.Cost < cost {
+						inherited[route.FaceID] = route.Cost
+					}
+				}
+				for nexthop, cost := range inherited {
+					if _, ok := nexthops[nexthop]; !ok {
+						nexthops[nexthop] = cost
 					}
 				}
-			}
-		}
 
-		// Find minimum cost route per nexthop
-		minCostRoutes := make(map[uint64]uint64) // FaceID -> Cost
-		for _, route := range routes {
-			cost, ok := minCostRoutes[route.FaceID]
-			if !ok || route.Cost < cost {
-				minCostRoutes[route.FaceID] = route.Cost
+				if entry.HasCaptureRoute() {
+					break
+				}
 			}
 		}
 
 		// Add "flattened" set of nexthops
-		for nexthop, cost := range minCostRoutes {
-			FibStrategyTable.InsertNextHopEnc(r.Name, nexthop, cost)
+		for nexthop, cost := range nexthops {
+			r.table.fib.InsertNextHopEnc(r.Name, nexthop, cost)
 		}
 	}
 
@@ -154,19 +185,80 @@ func (r *RibTable) AddEncRoute(name enc.Name, route *Route) {
 
 	node := r.root.fillTreeToPrefixEnc(name)
 
-	defer node.updateNexthopsEnc()
-	defer readvertiseAnnounce(name, route)
-
 	for _, existingRoute := range node.routes {
 		if existingRoute.FaceID == route.FaceID && existingRoute.Origin == route.Origin {
+			// Receiving the same Prefix Announcement again only refreshes the route.
+			// This stops announcements from looping between readvertising forwarders.
+			if route.PrefixAnnouncement == nil ||
+				!bytes.Equal(existingRoute.PrefixAnnouncement, route.PrefixAnnouncement) {
+				defer r.readvertiseAnnounce(name, route)
+			}
+
+			// The FIB is only updated if the next hops change
+			changed := existingRoute.Cost != route.Cost || existingRoute.Flags != route.Flags
 			existingRoute.Cost = route.Cost
 			existingRoute.Flags = route.Flags
 			existingRoute.ExpirationPeriod = route.ExpirationPeriod
+			existingRoute.PrefixAnnouncement = route.PrefixAnnouncement
+			r.scheduleExpiration(node, existingRoute)
+			if changed {
+				node.updateNexthopsEnc()
+			}
 			return
 		}
 	}
 
+	defer r.readvertiseAnnounce(name, route)
 	node.routes = append(node.routes, route)
+	r.scheduleExpiration(node, route)
+	node.updateNexthopsEnc()
+}
+
+// RenewRoute restarts the expiration period of an existing route, without changing
+// the FIB. Returns false if there is no such route.
+func (r *RibTable) RenewRoute(name enc.Name, faceID uint64, origin uint64, expiration time.Duration) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	entry := r.root.findExactMatchEntryEnc(name)
+	if entry == nil {
+		return false
+	}
+	for _, route := range entry.routes {
+		if route.FaceID == faceID && route.Origin == origin {
+			route.ExpirationPeriod = &expiration
+			r.scheduleExpiration(entry, route)
+			return true
+		}
+	}
+	return false
+}
+
+// scheduleExpiration (re)starts the timer removing the route after its expiration period.
+func (r *RibTable) scheduleExpiration(entry *RibEntry, route *Route) {
+	if route.expirationTimer != nil {
+		route.expirationTimer.Stop()
+		route.expirationTimer = nil
+	}
+	if route.ExpirationPeriod == nil {
+		return
+	}
+
+	// The timer is only read with the RIB locked, so it is set before use
+	var timer *time.Timer
+	timer = time.AfterFunc(*route.ExpirationPeriod, func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		// The route may have been refreshed or removed meanwhile
+		if route.expirationTimer != timer {
+			return
+		}
+		if i := slices.Index(entry.routes, route); i >= 0 {
+			entry.removeRoute(i)
+		}
+	})
+	route.expirationTimer = timer
 }
 
 // GetAllEntries returns all routes in the RIB.
@@ -206,12 +298,8 @@ func (r *RibTable) RemoveRouteEnc(name enc.Name, faceID uint64, origin uint64) {
 
 	for i, route := range entry.routes {
 		if route.FaceID == faceID && route.Origin == origin {
-			if i < len(entry.routes)-1 {
-				copy(entry.routes[i:], entry.routes[i+1:])
-			}
-			entry.routes = entry.routes[:len(entry.routes)-1]
-			readvertiseWithdraw(name, route)
-			break
+			entry.removeRoute(i)
+			return
 		}
 	}
 
@@ -219,6 +307,23 @@ func (r *RibTable) RemoveRouteEnc(name enc.Name, faceID uint64, origin uint64) {
 	entry.updateNexthopsEnc() // recursive
 }
 
+// FindPrefixAnn returns the Prefix Announcement of the longest prefix route of the name
+// to the face that was created by an announcement, and the prefix of this route.
+// Returns nil if there is no such route.
+func (r *RibTable) FindPrefixAnn(name enc.Name, faceID uint64) (enc.Name, []byte) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for entry := r.root.findLongestPrefixEntryEnc(name); entry != nil; entry = entry.parent {
+		for _, route := range entry.routes {
+			if route.FaceID == faceID && route.PrefixAnnouncement != nil {
+				return entry.Name, route.PrefixAnnouncement
+			}
+		}
+	}
+	return nil, nil
+}
+
 // (AI GENERATED DESCRIPTION): Removes all routing entries that reference the given face ID from the RIB, ensuring the table no longer uses that face.
 func (r *RibTable) CleanUpFace(faceId uint64) {
 	r.mutex.Lock()
@@ -240,22 +345,30 @@ func (r *RibEntry) cleanUpFace(faceId uint64) {
 		child.cleanUpFace(faceId)
 	}
 
-	for i, route := range r.routes {
-		if route.FaceID == faceId {
-			if i < len(r.routes)-1 {
-				copy(r.routes[i:], r.routes[i+1:])
-			}
-			r.routes = r.routes[:len(r.routes)-1]
-			readvertiseWithdraw(r.Name, route)
-
-			// entry changed, check and update FIB
-			r.pruneIfEmpty()
-			r.updateNexthopsEnc() // recursive
-			return
+	// Routes of different origins may use the face
+	for i := len(r.routes) - 1; i >= 0; i-- {
+		if r.routes[i].FaceID == faceId {
+			r.removeRoute(i)
 		}
 	}
 }
 
+// removeRoute removes the route at the index, and updates the FIB.
+func (r *RibEntry) removeRoute(i int) {
+	route := r.routes[i]
+	if route.expirationTimer != nil {
+		route.expirationTimer.Stop()
+		route.expirationTimer = nil
+	}
+
+	r.routes = slices.Delete(r.routes, i, i+1)
+	r.table.readvertiseWithdraw(r.Name, route)
+
+	// entry changed, check and update FIB
+	r.pruneIfEmpty()
+	r.updateNexthopsEnc() // recursive
+}
+
 // (AI GENERATED DESCRIPTION): Checks whether any route in the RIB entry is marked with the capture flag and returns true if at least one such route exists.
 func (r *RibEntry) HasCaptureRoute() bool {
 	for _, route := range r.routes {
@@ -2,9 +2,6 @@ package table
 
 import enc "github.com/named-data/ndnd/std/encoding"
 
-// Readvertising instances
-var readvertisers = make([]RibReadvertise, 0)
-
 type RibReadvertise interface {
 	// Advertise a route in the RIB
 	Announce(name enc.Name, route *Route)
@@ -12,21 +9,21 @@ type RibReadvertise interface {
 	Withdraw(name enc.Name, route *Route)
 }
 
-// (AI GENERATED DESCRIPTION): Adds the supplied RibReadvertise instance to the global list of readvertisers.
-func AddReadvertiser(r RibReadvertise) {
-	readvertisers = append(readvertisers, r)
+// AddReadvertiser adds a readvertising instance, which is notified of the changes of the RIB.
+func (r *RibTable) AddReadvertiser(readvertiser RibReadvertise) {
+	r.readvertisers = append(r.readvertisers, readvertiser)
 }
 
 // (AI GENERATED DESCRIPTION): Notifies every registered readvertiser of a new route for the specified name by calling its Announce method.
-func readvertiseAnnounce(name enc.Name, route *Route) {
-	for _, r := range readvertisers {
-		r.Announce(name, route)
+func (r *RibTable) readvertiseAnnounce(name enc.Name, route *Route) {
+	for _, readvertiser := range r.readvertisers {
+		readvertiser.Announce(name, route)
 	}
 }
 
 // (AI GENERATED DESCRIPTION): Instructs all registered readvertisers to withdraw the specified name on the given route.
-func readvertiseWithdraw(name enc.Name, route *Route) {
-	for _, r := range readvertisers {
-		r.Withdraw(name, route)
+func (r *RibTable) readvertiseWithdraw(name enc.Name, route *Route) {
+	for _, readvertiser := range r.readvertisers {
+		readvertiser.Withdraw(name, route)
 	}
 }
@@ -0,0 +1,312 @@
+package table
+
+import (
+	"testing"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	spec_mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingReadvertiser counts the routes announced and withdrawn by the RIB.
+type countingReadvertiser struct {
+	announced int
+	withdrawn int
+}
+
+func (r *countingReadvertiser) Announce(name enc.Name, route *Route) {
+	r.announced++
+}
+
+func (r *countingReadvertiser) Withdraw(name enc.Name, route *Route) {
+	r.withdrawn++
+}
+
+// ribHasRoute checks whether the RIB has a route for the name through the face.
+func ribHasRoute(name enc.Name, faceID uint64) bool {
+	Rib.mutex.RLock()
+	defer Rib.mutex.RUnlock()
+
+	entry := Rib.root.findExactMatchEntryEnc(name)
+	if entry == nil {
+		return false
+	}
+	for _, route := range entry.routes {
+		if route.FaceID == faceID {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRibRouteExpiration(t *testing.T) {
+	resetRib()
+	name, _ := enc.NameFromStr("/rib/expire")
+
+	expiration := 100 * time.Millisecond
+	Rib.AddEncRoute(name, &Route{FaceID: 1, ExpirationPeriod: &expiration})
+	Rib.AddEncRoute(name, &Route{FaceID: 2})
+	assert.True(t, ribHasRoute(name, 1))
+	assert.Equal(t, 2, len(FibStrategyTable.FindNextHopsEnc(name)))
+
+	// Routes without expiration period are permanent
+	assert.Eventually(t, func() bool { return !ribHasRoute(name, 1) }, time.Second, 10*time.Millisecond)
+	assert.True(t, ribHasRoute(name, 2))
+	assert.Equal(t, 1, len(FibStrategyTable.FindNextHopsEnc(name)))
+
+	// Refreshing the route restarts the timer
+	Rib.AddEncRoute(name, &Route{FaceID: 1, ExpirationPeriod: &expiration})
+	time.Sleep(60 * time.Millisecond)
+	Rib.AddEncRoute(name, &Route{FaceID: 1, ExpirationPeriod: &expiration})
+	time.Sleep(60 * time.Millisecond)
+	assert.True(t, ribHasRoute(name, 1))
+
+	// Removed routes do not expire later
+	Rib.RemoveRouteEnc(name, 1, 0)
+	Rib.RemoveRouteEnc(name, 2, 0)
+	assert.False(t, ribHasRoute(name, 1))
+	time.Sleep(120 * time.Millisecond)
+	assert.Nil(t, Rib.root.findExactMatchEntryEnc(name))
+}
+
+func TestRibPrefixAnnouncementReadvertise(t *testing.T) {
+	resetRib()
+	readvertiser := &countingReadvertiser{}
+	Rib.AddReadvertiser(readvertiser)
+
+	name, _ := enc.NameFromStr("/rib/announce")
+	Rib.AddEncRoute(name, &Route{FaceID: 1, PrefixAnnouncement: []byte{0x06, 0x01}})
+	assert.Equal(t, 1, readvertiser.announced)
+
+	// The same announcement is not readvertised again
+	Rib.AddEncRoute(name, &Route{FaceID: 1, PrefixAnnouncement: []byte{0x06, 0x01}})
+	assert.Equal(t, 1, readvertiser.announced)
+
+	// A new announcement is readvertised
+	Rib.AddEncRoute(name, &Route{FaceID: 1, PrefixAnnouncement: []byte{0x06, 0x02}})
+	assert.Equal(t, 2, readvertiser.announced)
+
+	Rib.RemoveRouteEnc(name, 1, 0)
+	assert.Equal(t, 1, readvertiser.withdrawn)
+}
+
+func TestRibFindPrefixAnn(t *testing.T) {
+	resetRib()
+
+	Rib.AddEncRoute(ribName("/a"), &Route{FaceID: 1, PrefixAnnouncement: []byte{0x06, 0x01}})
+	Rib.AddEncRoute(ribName("/a/b"), &Route{FaceID: 1})
+	Rib.AddEncRoute(ribName("/a/b"), &Route{FaceID: 2, PrefixAnnouncement: []byte{0x06, 0x02}})
+
+	// The longest prefix route of the face with an announcement is found
+	prefix, pa := Rib.FindPrefixAnn(ribName("/a/b/c"), 1)
+	assert.True(t, ribName("/a").Equal(prefix))
+	assert.Equal(t, []byte{0x06, 0x01}, pa)
+
+	prefix, pa = Rib.FindPrefixAnn(ribName("/a/b/c"), 2)
+	assert.True(t, ribName("/a/b").Equal(prefix))
+	assert.Equal(t, []byte{0x06, 0x02}, pa)
+
+	// No route of the face covers the name
+	prefix, pa = Rib.FindPrefixAnn(ribName("/a/c"), 2)
+	assert.Nil(t, prefix)
+	assert.Nil(t, pa)
+	_, pa = Rib.FindPrefixAnn(ribName("/b"), 1)
+	assert.Nil(t, pa)
+}
+
+// resetRib clears the RIB and the FIB.
+func resetRib() {
+	newFibStrategyTableTree()
+	Rib = NewRibTable(FibStrategyTable)
+}
+
+// ribName parses a name in a test.
+func ribName(name string) enc.Name {
+	n, _ := enc.NameFromStr(name)
+	return n
+}
+
+// fibNextHops returns the FIB nexthops of the name as a map of face ID to cost.
+func fibNextHops(name string) map[uint64]uint64 {
+	nexthops := make(map[uint64]uint64)
+	for _, nexthop := range FibStrategyTable.FindNextHopsEnc(ribName(name)) {
+		nexthops[nexthop.Nexthop] = nexthop.Cost
+	}
+	return nexthops
+}
+
+// addRoute adds a route to the RIB.
+func addRoute(name string, faceID uint64, cost uint64, flags spec_mgmt.RouteFlag) {
+	Rib.AddEncRoute(ribName(name), &Route{FaceID: faceID, Cost: cost, Flags: uint64(flags)})
+}
+
+const (
+	inherit = spec_mgmt.RouteFlagChildInherit
+	capture = spec_mgmt.RouteFlagCapture
+)
+
+func TestRibFibNewNamespace(t *testing.T) {
+	resetRib()
+
+	// Routes without child-inherit only apply to their own namespace
+	addRoute("/a", 1, 10, 0)
+	addRoute("/a/b", 2, 20, 0)
+	assert.Equal(t, map[uint64]uint64{1: 10}, fibNextHops("/a"))
+	assert.Equal(t, map[uint64]uint64{2: 20}, fibNextHops("/a/b"))
+
+	// Child-inherit routes apply to descendant namespaces
+	addRoute("/x", 1, 10, inherit)
+	addRoute("/x/y/z", 2, 20, 0)
+	assert.Equal(t, map[uint64]uint64{1: 10, 2: 20}, fibNextHops("/x/y/z"))
+
+	// Capture routes block inheritance from ancestors
+	addRoute("/x/c", 3, 30, capture)
+	assert.Equal(t, map[uint64]uint64{3: 30}, fibNextHops("/x/c"))
+}
+
+func TestRibFibInheritedRoutePrecedence(t *testing.T) {
+	resetRib()
+
+	// Routes of the namespace take precedence over inherited routes for the same face
+	addRoute("/a", 1, 10, inherit)
+	addRoute("/a/b", 1, 50, 0)
+	assert.Equal(t, map[uint64]uint64{1: 50}, fibNextHops("/a/b"))
+
+	// Nearer ancestors take precedence over farther ancestors
+	addRoute("/a/b", 1, 30, inherit)
+	addRoute("/a/b/c", 2, 20, 0)
+	assert.Equal(t, map[uint64]uint64{1: 30, 2: 20}, fibNextHops("/a/b/c"))
+
+	// The lowest cost is used among routes of one namespace with different origins
+	Rib.AddEncRoute(ribName("/a/b/c"), &Route{FaceID: 2, Origin: 128, Cost: 5})
+	assert.Equal(t, map[uint64]uint64{1: 30, 2: 5}, fibNextHops("/a/b/c"))
+}
+
+func TestRibFibNewFaceUpdatesDescendants(t *testing.T) {
+	resetRib()
+	addRoute("/a/b", 2, 20, 0)
+	addRoute("/a/b/c", 3, 30, 0)
+
+	// A new child-inherit route is added to all descendants
+	addRoute("/a", 1, 10, inherit)
+	assert.Equal(t, map[uint64]uint64{1: 10, 2: 20}, fibNextHops("/a/b"))
+	assert.Equal(t, map[uint64]uint64{1: 10, 3: 30}, fibNextHops("/a/b/c"))
+
+	// A new capture route stops inheritance below it
+	addRoute("/a/b", 4, 40, capture|inherit)
+	assert.Equal(t, map[uint64]uint64{2: 20, 4: 40}, fibNextHops("/a/b"))
+	assert.Equal(t, map[uint64]uint64{3: 30, 4: 40}, fibNextHops("/a/b/c"))
+}
+
+func TestRibFibEditFlags(t *testing.T) {
+	resetRib()
+	addRoute("/a", 1, 10, 0)
+	addRoute("/a/b", 2, 20, 0)
+	addRoute("/a/b/c", 3, 30, 0)
+	assert.Equal(t, map[uint64]uint64{3: 30}, fibNextHops("/a/b/c"))
+
+	// Setting child-inherit updates the descendants
+	addRoute("/a", 1, 10, inherit)
+	assert.Equal(t, map[uint64]uint64{1: 10, 2: 20}, fibNextHops("/a/b"))
+	assert.Equal(t, map[uint64]uint64{1: 10, 3: 30}, fibNextHops("/a/b/c"))
+
+	// Setting capture removes inherited routes from the namespace and below
+	addRoute("/a/b", 2, 20, capture)
+	assert.Equal(t, map[uint64]uint64{2: 20}, fibNextHops("/a/b"))
+	assert.Equal(t, map[uint64]uint64{3: 30}, fibNextHops("/a/b/c"))
+
+	// Clearing capture restores them
+	addRoute("/a/b", 2, 20, inherit)
+	assert.Equal(t, map[uint64]uint64{1: 10, 2: 20}, fibNextHops("/a/b"))
+	assert.Equal(t, map[uint64]uint64{1: 10, 2: 20, 3: 30}, fibNextHops("/a/b/c"))
+
+	// Clearing child-inherit removes the route from descendants
+	addRoute("/a", 1, 10, 0)
+	assert.Equal(t, map[uint64]uint64{1: 10}, fibNextHops("/a"))
+	assert.Equal(t, map[uint64]uint64{2: 20}, fibNextHops("/a/b"))
+	assert.Equal(t, map[uint64]uint64{2: 20, 3: 30}, fibNextHops("/a/b/c"))
+}
+
+func TestRibFibRemoveRoute(t *testing.T) {
+	resetRib()
+	addRoute("/a", 1, 10, inherit)
+	addRoute("/a/b", 2, 20, capture)
+	addRoute("/a/b/c", 3, 30, 0)
+	addRoute("/a/d", 4, 40, 0)
+	assert.Equal(t, map[uint64]uint64{3: 30}, fibNextHops("/a/b/c"))
+
+	// Removing the capture route restores inheritance below it
+	Rib.RemoveRouteEnc(ribName("/a/b"), 2, 0)
+	assert.Equal(t, map[uint64]uint64{1: 10, 3: 30}, fibNextHops("/a/b/c"))
+
+	// Removing an inherited route removes it from all descendants
+	Rib.RemoveRouteEnc(ribName("/a"), 1, 0)
+	assert.Equal(t, map[uint64]uint64{3: 30}, fibNextHops("/a/b/c"))
+	assert.Equal(t, map[uint64]uint64{4: 40}, fibNextHops("/a/d"))
+
+	// Removing a face removes its inherited routes from all descendants
+	addRoute("/a", 5, 50, inherit)
+	Rib.AddEncRoute(ribName("/a"), &Route{FaceID: 5, Origin: 128, Cost: 60, Flags: uint64(inherit)})
+	assert.Equal(t, map[uint64]uint64{3: 30, 5: 50}, fibNextHops("/a/b/c"))
+	Rib.CleanUpFace(5)
+	assert.Equal(t, map[uint64]uint64{3: 30}, fibNextHops("/a/b/c"))
+	assert.Equal(t, map[uint64]uint64{4: 40}, fibNextHops("/a/d"))
+}
+
+// countingFib counts the changes of the next hops of the FIB.
+type countingFib struct {
+	FibStrategy
+	changes int
+}
+
+func (f *countingFib) InsertNextHopEnc(name enc.Name, nextHop uint64, cost uint64) {
+	f.changes++
+	f.FibStrategy.InsertNextHopEnc(name, nextHop, cost)
+}
+
+func (f *countingFib) ClearNextHopsEnc(name enc.Name) {
+	f.changes++
+	f.FibStrategy.ClearNextHopsEnc(name)
+}
+
+func (f *countingFib) RemoveNextHopEnc(name enc.Name, nextHop uint64) {
+	f.changes++
+	f.FibStrategy.RemoveNextHopEnc(name, nextHop)
+}
+
+func TestRibRenewRoute(t *testing.T) {
+	resetRib()
+	fib := &countingFib{FibStrategy: FibStrategyTable}
+	FibStrategyTable = fib
+	Rib = NewRibTable(fib)
+	name, _ := enc.NameFromStr("/rib/renew")
+	origin := uint64(spec_mgmt.RouteOriginPrefixAnn)
+
+	expiration := 100 * time.Millisecond
+	assert.False(t, Rib.RenewRoute(name, 1, origin, expiration))
+	Rib.AddEncRoute(name, &Route{FaceID: 1, Origin: origin, Cost: 5, ExpirationPeriod: &expiration})
+	assert.Positive(t, fib.changes)
+
+	// Renewing restarts the expiration without changing the FIB
+	fib.changes = 0
+	time.Sleep(60 * time.Millisecond)
+	assert.True(t, Rib.RenewRoute(name, 1, origin, expiration))
+	time.Sleep(60 * time.Millisecond)
+	assert.True(t, ribHasRoute(name, 1))
+	assert.False(t, Rib.RenewRoute(name, 1, 0, expiration))
+	assert.Zero(t, fib.changes)
+
+	// Adding the same route again does not change the FIB either
+	Rib.AddEncRoute(name, &Route{FaceID: 1, Origin: origin, Cost: 5, ExpirationPeriod: &expiration})
+	assert.Zero(t, fib.changes)
+	assert.Len(t, FibStrategyTable.FindNextHopsEnc(name), 1)
+
+	// Changing the cost updates the FIB
+	Rib.AddEncRoute(name, &Route{FaceID: 1, Origin: origin, Cost: 7, ExpirationPeriod: &expiration})
+	assert.Positive(t, fib.changes)
+	assert.Equal(t, uint64(7), FibStrategyTable.FindNextHopsEnc(name)[0].Cost)
+
+	assert.Eventually(t, func() bool { return !ribHasRoute(name, 1) }, time.Second, 10*time.Millisecond)
+	assert.Empty(t, FibStrategyTable.FindNextHopsEnc(name))
+}
@@ -0,0 +1,75 @@
+/* YaNFD - Yet another NDN Forwarding Daemon
+ *
+ * Copyright (C) 2020-2021 Eric Newberry.
+ *
+ * This file is licensed under the terms of the MIT License, as found in LICENSE.md.
+ */
+
+package table
+
+import "time"
+
+const (
+	rttInitialRto = 1 * time.Second
+	rttMinRto     = 200 * time.Millisecond
+	rttMaxRto     = 60 * time.Second
+	rttAlpha      = 0.125
+	rttBeta       = 0.25
+	rttK          = 4
+)
+
+// RttEstimator estimates the RTT and retransmission timeout of a face,
+// following the algorithm of RFC 6298.
+type RttEstimator struct {
+	srtt   time.Duration
+	rttVar time.Duration
+	rto    time.Duration
+	nRtt   uint64
+}
+
+// NewRttEstimator creates a new RTT estimator without any measurement.
+func NewRttEstimator() RttEstimator {
+	return RttEstimator{rto: rttInitialRto}
+}
+
+// AddMeasurement adds a new RTT sample to the estimator.
+func (r *RttEstimator) AddMeasurement(rtt time.Duration) {
+	if r.nRtt == 0 {
+		r.srtt = rtt
+		r.rttVar = rtt / 2
+	} else {
+		diff := r.srtt - rtt
+		if diff < 0 {
+			diff = -diff
+		}
+		r.rttVar = time.Duration((1-rttBeta)*float64(r.rttVar) + rttBeta*float64(diff))
+		r.srtt = time.Duration((1-rttAlpha)*float64(r.srtt) + rttAlpha*float64(rtt))
+	}
+	r.nRtt++
+	r.rto = min(max(r.srtt+rttK*r.rttVar, rttMinRto), rttMaxRto)
+}
+
+// Backoff doubles the retransmission timeout after a timeout.
+func (r *RttEstimator) Backoff() {
+	r.rto = min(2*r.rto, rttMaxRto)
+}
+
+// HasMeasurement returns whether at least one RTT sample was added.
+func (r *RttEstimator) HasMeasurement() bool {
+	return r.nRtt > 0
+}
+
+// Srtt returns the smoothed RTT.
+func (r *RttEstimator) Srtt() time.Duration {
+	return r.srtt
+}
+
+// RttVar returns the RTT variation.
+func (r *RttEstimator) RttVar() time.Duration {
+	return r.rttVar
+}
+
+// Rto returns the retransmission timeout.
+func (r *RttEstimator) Rto() time.Duration {
+	return r.rto
+}
@@ -1,8 +1,8 @@
 module github.com/named-data/ndnd
 
-go 1.24.0
+go 1.25.0
 
-toolchain go1.24.3
+toolchain go1.25.1
 
 require (
 	github.com/cespare/xxhash v1.1.0
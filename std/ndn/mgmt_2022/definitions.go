@@ -5,6 +5,7 @@ import (
 	"time"
 
 	enc "github.com/named-data/ndnd/std/encoding"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
 	"github.com/named-data/ndnd/std/types/optional"
 )
 
@@ -37,6 +38,8 @@ const (
 	FaceEventDestroyed = uint64(2)
 	FaceEventUp        = uint64(3)
 	FaceEventDown      = uint64(4)
+	// YaNFD extension: the persistency, MTU or flags of the face changed
+	FaceEventUpdated = uint64(5)
 )
 
 const (
@@ -87,6 +90,9 @@ type ControlArgs struct {
 	DefaultCongestionThreshold optional.Optional[uint64] `tlv:"0x88"`
 	//+field:natural:optional
 	Mtu optional.Optional[uint64] `tlv:"0x89"`
+	// CapacityBytes is a YaNFD extension and has no assigned NFD TLV number.
+	//+field:natural:optional
+	CapacityBytes optional.Optional[uint64] `tlv:"0xd1"`
 }
 
 // +tlv-model:dict
@@ -99,6 +105,14 @@ type ControlResponseVal struct {
 	Params *ControlArgs `tlv:"0x68"`
 }
 
+// PrefixAnnouncement is the content of a Prefix Announcement object.
+type PrefixAnnouncement struct {
+	//+field:natural
+	ExpirationPeriod uint64 `tlv:"0x6d"`
+	//+field:struct:spec.ValidityPeriod
+	ValidityPeriod *spec.ValidityPeriod `tlv:"0xfd"`
+}
+
 type ControlParameters struct {
 	//+field:struct:ControlArgs
 	Val *ControlArgs `tlv:"0x68"`
@@ -225,6 +239,12 @@ type FaceStatus struct {
 	NInBytes uint64 `tlv:"0x94"`
 	//+field:natural
 	NOutBytes uint64 `tlv:"0x95"`
+	//+field:natural:optional
+	NAcknowledged optional.Optional[uint64] `tlv:"0xcd"`
+	//+field:natural:optional
+	NRetransmitted optional.Optional[uint64] `tlv:"0xce"`
+	//+field:natural:optional
+	NRetxExhausted optional.Optional[uint64] `tlv:"0xcf"`
 
 	//+field:natural
 	Flags uint64 `tlv:"0x6c"`
@@ -324,6 +344,11 @@ type CsInfo struct {
 	NHits uint64 `tlv:"0x81"`
 	//+field:natural
 	NMisses uint64 `tlv:"0x82"`
+	// CapacityBytes and NCsBytes are YaNFD extensions and have no assigned NFD TLV numbers.
+	//+field:natural:optional
+	CapacityBytes optional.Optional[uint64] `tlv:"0xd1"`
+	//+field:natural:optional
+	NCsBytes optional.Optional[uint64] `tlv:"0xd2"`
 }
 
 type CsInfoMsg struct {
@@ -331,9 +356,70 @@ type CsInfoMsg struct {
 	CsInfo *CsInfo `tlv:"0x80"`
 }
 
-// No Tlv numbers assigned yet
+// MeasurementsFaceRecord is a YaNFD extension and has no assigned NFD TLV numbers.
+type MeasurementsFaceRecord struct {
+	//+field:natural
+	FaceId uint64 `tlv:"0x69"`
+	// Smoothed RTT in nanoseconds
+	//+field:natural
+	Srtt uint64 `tlv:"0x82"`
+	// RTT variation in nanoseconds
+	//+field:natural
+	RttVar uint64 `tlv:"0x83"`
+	// Retransmission timeout in nanoseconds
+	//+field:natural
+	Rto uint64 `tlv:"0x84"`
+	//+field:natural
+	NSatisfied uint64 `tlv:"0x85"`
+	//+field:natural
+	NTimeouts uint64 `tlv:"0x86"`
+}
+
+type MeasurementsEntry struct {
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+	//+field:natural
+	ThreadId uint64 `tlv:"0x82"`
+	//+field:natural:optional
+	ExpirationPeriod optional.Optional[uint64] `tlv:"0x6d"`
+	//+field:natural:optional
+	LastWorkingFace optional.Optional[uint64] `tlv:"0x83"`
+	//+field:sequence:*MeasurementsFaceRecord:struct:MeasurementsFaceRecord
+	Faces []*MeasurementsFaceRecord `tlv:"0x81"`
+}
+
+type MeasurementsStatus struct {
+	//+field:sequence:*MeasurementsEntry:struct:MeasurementsEntry
+	Entries []*MeasurementsEntry `tlv:"0x80"`
+}
+
+// CsQueryFilterValue is a YaNFD extension and has no assigned NFD TLV numbers.
+type CsQueryFilterValue struct {
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+	//+field:natural:optional
+	Count optional.Optional[uint64] `tlv:"0x84"`
+}
+
+type CsQueryFilter struct {
+	//+field:struct:CsQueryFilterValue
+	Val *CsQueryFilterValue `tlv:"0x96"`
+}
+
+// CsQuery is a YaNFD extension and has no assigned NFD TLV numbers.
 type CsQuery struct {
-	Name            enc.Name
-	PacketSize      uint64
-	FreshnessPeriod uint64
+	//+field:name
+	Name enc.Name `tlv:"0x07"`
+	//+field:natural
+	ThreadId uint64 `tlv:"0x82"`
+	//+field:natural
+	PacketSize uint64 `tlv:"0x83"`
+	// Remaining freshness in milliseconds, absent if the Data is stale
+	//+field:natural:optional
+	FreshnessPeriod optional.Optional[uint64] `tlv:"0x84"`
+}
+
+type CsQueryStatus struct {
+	//+field:sequence:*CsQuery:struct:CsQuery
+	Entries []*CsQuery `tlv:"0x80"`
 }
@@ -0,0 +1,139 @@
+package mgmt_2022
+
+import (
+	"sync/atomic"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/types/optional"
+	"github.com/named-data/ndnd/std/utils"
+)
+
+// FaceEventLifetime is the lifetime of Interests for the face event notification stream.
+const FaceEventLifetime = 60 * time.Second
+
+// faceEventRetryInterval is the delay before resubscribing after a Nack or error.
+const faceEventRetryInterval = time.Second
+
+// FaceEventKindList maps face event kinds to their names.
+var FaceEventKindList = map[uint64]string{
+	FaceEventCreated:   "created",
+	FaceEventDestroyed: "destroyed",
+	FaceEventUp:        "up",
+	FaceEventDown:      "down",
+	FaceEventUpdated:   "updated",
+}
+
+// FaceEventsName returns the name of the face event notification stream of the local forwarder.
+func FaceEventsName() enc.Name {
+	return enc.Name{
+		enc.LOCALHOST,
+		enc.NewGenericComponent("nfd"),
+		enc.NewGenericComponent("faces"),
+		enc.NewGenericComponent("events"),
+	}
+}
+
+// FaceEventSubscriber receives the face event notification stream of the local forwarder.
+// Each notification is a Data packet whose name is the stream name followed by a
+// sequence number. The subscriber first fetches the latest notification, and then
+// requests each following sequence number in turn, so no notification is missed
+// after the first one is received.
+type FaceEventSubscriber struct {
+	engine   ndn.Engine
+	callback func(*FaceEventNotificationValue)
+
+	// next sequence number, or none to fetch the latest notification
+	next    optional.Optional[uint64]
+	stopped atomic.Bool
+}
+
+// SubscribeFaceEvents starts receiving face event notifications through the engine.
+// The callback is called from the engine goroutine and must not block.
+func SubscribeFaceEvents(engine ndn.Engine, callback func(*FaceEventNotificationValue)) *FaceEventSubscriber {
+	s := &FaceEventSubscriber{
+		engine:   engine,
+		callback: callback,
+	}
+	s.express()
+	return s
+}
+
+// String returns the log identifier of the subscriber.
+func (s *FaceEventSubscriber) String() string {
+	return "face-event-subscriber"
+}
+
+// Stop stops receiving notifications. The pending Interest is left to expire.
+func (s *FaceEventSubscriber) Stop() {
+	s.stopped.Store(true)
+}
+
+// express sends the Interest for the next notification.
+func (s *FaceEventSubscriber) express() {
+	if s.stopped.Load() {
+		return
+	}
+
+	name := FaceEventsName()
+	next, hasNext := s.next.Get()
+	if hasNext {
+		name = name.Append(enc.NewSequenceNumComponent(next))
+	}
+
+	config := &ndn.InterestConfig{
+		CanBePrefix: !hasNext,
+		MustBeFresh: true,
+		Lifetime:    optional.Some(FaceEventLifetime),
+		Nonce:       utils.ConvertNonce(s.engine.Timer().Nonce()),
+	}
+	interest, err := s.engine.Spec().MakeInterest(name, config, nil, nil)
+	if err != nil {
+		log.Error(s, "Unable to make face event Interest", "err", err)
+		return
+	}
+
+	err = s.engine.Express(interest, s.onResult)
+	if err != nil {
+		log.Warn(s, "Unable to express face event Interest", "err", err)
+		s.engine.Timer().Schedule(faceEventRetryInterval, s.express)
+	}
+}
+
+// onResult handles the result of a face event Interest.
+func (s *FaceEventSubscriber) onResult(args ndn.ExpressCallbackArgs) {
+	switch args.Result {
+	case ndn.InterestResultData:
+		// handled below
+	case ndn.InterestResultTimeout:
+		// No event happened during the Interest lifetime, so keep waiting for
+		// the same sequence number to not miss the next notification.
+		s.express()
+		return
+	default:
+		// Continue from the last seen notification once the forwarder is reachable
+		log.Debug(s, "Face event Interest failed", "result", args.Result)
+		s.engine.Timer().Schedule(faceEventRetryInterval, s.express)
+		return
+	}
+
+	name := args.Data.Name()
+	if len(name) == 0 || !name[len(name)-1].IsSequenceNum() {
+		log.Warn(s, "Received face event with invalid name", "name", name)
+		s.engine.Timer().Schedule(faceEventRetryInterval, s.express)
+		return
+	}
+	s.next = optional.Some(name[len(name)-1].NumberVal() + 1)
+	s.express()
+
+	notif, err := ParseFaceEventNotification(enc.NewWireView(args.Data.Content()), true)
+	if err != nil || notif.Val == nil {
+		log.Warn(s, "Unable to parse face event", "name", name, "err", err)
+		return
+	}
+	if !s.stopped.Load() {
+		s.callback(notif.Val)
+	}
+}
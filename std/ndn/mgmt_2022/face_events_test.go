@@ -0,0 +1,100 @@
+package mgmt_2022_test
+
+import (
+	"testing"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	basic_engine "github.com/named-data/ndnd/std/engine/basic"
+	"github.com/named-data/ndnd/std/engine/face"
+	"github.com/named-data/ndnd/std/ndn"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	sig "github.com/named-data/ndnd/std/security/signer"
+	"github.com/named-data/ndnd/std/types/optional"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+// consumeEventInterest returns the next face event Interest sent by the engine.
+func consumeEventInterest(t *testing.T, face *face.DummyFace) *spec.Interest {
+	buf := tu.NoErr(face.Consume())
+	pkt, _, err := spec.ReadPacket(enc.NewBufferView(buf))
+	require.NoError(t, err)
+	require.NotNil(t, pkt.Interest)
+	require.True(t, mgmt.FaceEventsName().IsPrefix(pkt.Interest.Name()))
+	return pkt.Interest
+}
+
+// feedEvent feeds a face event notification with a sequence number to the engine.
+func feedEvent(t *testing.T, face *face.DummyFace, seq uint64, kind uint64, faceID uint64) {
+	notif := &mgmt.FaceEventNotification{
+		Val: &mgmt.FaceEventNotificationValue{
+			FaceEventKind: kind,
+			FaceId:        faceID,
+			Uri:           "udp4://127.0.0.1:6363",
+			LocalUri:      "udp4://127.0.0.1:6363",
+		},
+	}
+	data, err := spec.Spec{}.MakeData(
+		mgmt.FaceEventsName().Append(enc.NewSequenceNumComponent(seq)),
+		&ndn.DataConfig{
+			ContentType: optional.Some(ndn.ContentTypeBlob),
+			Freshness:   optional.Some(time.Second),
+		},
+		notif.Encode(),
+		sig.NewSha256Signer(),
+	)
+	require.NoError(t, err)
+	require.NoError(t, face.FeedPacket(data.Wire.Join()))
+}
+
+func TestFaceEventSubscriber(t *testing.T) {
+	tu.SetT(t)
+
+	face := face.NewDummyFace()
+	timer := basic_engine.NewDummyTimer()
+	engine := basic_engine.NewEngine(face, timer)
+	require.NoError(t, engine.Start())
+	defer engine.Stop()
+
+	events := make([]*mgmt.FaceEventNotificationValue, 0)
+	subscriber := mgmt.SubscribeFaceEvents(engine, func(event *mgmt.FaceEventNotificationValue) {
+		events = append(events, event)
+	})
+	defer subscriber.Stop()
+
+	// The first Interest fetches the latest notification
+	interest := consumeEventInterest(t, face)
+	require.True(t, interest.CanBePrefix())
+	require.Equal(t, len(mgmt.FaceEventsName()), len(interest.Name()))
+
+	feedEvent(t, face, 5, mgmt.FaceEventCreated, 7)
+	require.Len(t, events, 1)
+	require.Equal(t, mgmt.FaceEventCreated, events[0].FaceEventKind)
+	require.Equal(t, uint64(7), events[0].FaceId)
+
+	// Then each following sequence number is requested
+	interest = consumeEventInterest(t, face)
+	require.False(t, interest.CanBePrefix())
+	require.Equal(t, uint64(6), interest.Name().At(-1).NumberVal())
+
+	// Timeouts continue from the last seen notification instead of
+	// fetching the latest one, which would miss events
+	timer.MoveForward(mgmt.FaceEventLifetime + time.Second)
+	interest = consumeEventInterest(t, face)
+	require.False(t, interest.CanBePrefix())
+	require.Equal(t, uint64(6), interest.Name().At(-1).NumberVal())
+
+	feedEvent(t, face, 6, mgmt.FaceEventDown, 7)
+	require.Len(t, events, 2)
+	require.Equal(t, mgmt.FaceEventDown, events[1].FaceEventKind)
+
+	interest = consumeEventInterest(t, face)
+	require.Equal(t, uint64(7), interest.Name().At(-1).NumberVal())
+
+	// No notification is delivered after the subscriber is stopped
+	subscriber.Stop()
+	feedEvent(t, face, 7, mgmt.FaceEventUp, 7)
+	require.Len(t, events, 2)
+}
@@ -2,12 +2,14 @@
 package mgmt_2022
 
 import (
+	"encoding/binary"
 	"io"
 	"strings"
 
 	"time"
 
 	enc "github.com/named-data/ndnd/std/encoding"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
 )
 
 type StrategyEncoder struct {
@@ -265,6 +267,10 @@ func (encoder *ControlArgsEncoder) Init(value *ControlArgs) {
 		l += 1
 		l += uint(1 + enc.Nat(optval).EncodingLength())
 	}
+	if optval, ok := value.CapacityBytes.Get(); ok {
+		l += 1
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
 	encoder.Length = l
 
 }
@@ -406,6 +412,14 @@ func (encoder *ControlArgsEncoder) EncodeInto(value *ControlArgs, buf []byte) {
 		pos += uint(1 + buf[pos])
 
 	}
+	if optval, ok := value.CapacityBytes.Get(); ok {
+		buf[pos] = byte(209)
+		pos += 1
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
 }
 
 func (encoder *ControlArgsEncoder) Encode(value *ControlArgs) enc.Wire {
@@ -436,6 +450,7 @@ func (context *ControlArgsParsingContext) Parse(reader enc.WireView, ignoreCriti
 	var handled_BaseCongestionMarkInterval bool = false
 	var handled_DefaultCongestionThreshold bool = false
 	var handled_Mtu bool = false
+	var handled_CapacityBytes bool = false
 
 	progress := -1
 	_ = progress
@@ -775,6 +790,29 @@ func (context *ControlArgsParsingContext) Parse(reader enc.WireView, ignoreCriti
 						value.Mtu.Set(optval)
 					}
 				}
+			case 209:
+				if true {
+					handled = true
+					handled_CapacityBytes = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.CapacityBytes.Set(optval)
+					}
+				}
 			default:
 				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
 					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
@@ -841,6 +879,9 @@ func (context *ControlArgsParsingContext) Parse(reader enc.WireView, ignoreCriti
 	if !handled_Mtu && err == nil {
 		value.Mtu.Unset()
 	}
+	if !handled_CapacityBytes && err == nil {
+		value.CapacityBytes.Unset()
+	}
 
 	if err != nil {
 		return nil, err
@@ -915,6 +956,9 @@ func (value *ControlArgs) ToDict() map[string]any {
 	if optval, ok := value.Mtu.Get(); ok {
 		dict["Mtu"] = optval
 	}
+	if optval, ok := value.CapacityBytes.Get(); ok {
+		dict["CapacityBytes"] = optval
+	}
 	return dict
 }
 
@@ -1113,6 +1157,18 @@ func DictToControlArgs(dict map[string]any) (*ControlArgs, error) {
 	if err != nil {
 		return nil, err
 	}
+	if vv, ok := dict["CapacityBytes"]; ok {
+		if v, ok := vv.(uint64); ok {
+			value.CapacityBytes.Set(v)
+		} else {
+			err = enc.ErrIncompatibleType{Name: "CapacityBytes", TypeNum: 209, ValType: "uint64", Value: vv}
+		}
+	} else {
+		value.CapacityBytes.Unset()
+	}
+	if err != nil {
+		return nil, err
+	}
 	return value, nil
 }
 
@@ -1358,6 +1414,173 @@ func DictToControlResponseVal(dict map[string]any) (*ControlResponseVal, error)
 	return value, nil
 }
 
+type PrefixAnnouncementEncoder struct {
+	Length uint
+
+	ValidityPeriod_encoder spec.ValidityPeriodEncoder
+}
+
+type PrefixAnnouncementParsingContext struct {
+	ValidityPeriod_context spec.ValidityPeriodParsingContext
+}
+
+func (encoder *PrefixAnnouncementEncoder) Init(value *PrefixAnnouncement) {
+
+	if value.ValidityPeriod != nil {
+		encoder.ValidityPeriod_encoder.Init(value.ValidityPeriod)
+	}
+
+	l := uint(0)
+	l += 1
+	l += uint(1 + enc.Nat(value.ExpirationPeriod).EncodingLength())
+	if value.ValidityPeriod != nil {
+		l += 3
+		l += uint(enc.TLNum(encoder.ValidityPeriod_encoder.Length).EncodingLength())
+		l += encoder.ValidityPeriod_encoder.Length
+	}
+	encoder.Length = l
+
+}
+
+func (context *PrefixAnnouncementParsingContext) Init() {
+
+	context.ValidityPeriod_context.Init()
+}
+
+func (encoder *PrefixAnnouncementEncoder) EncodeInto(value *PrefixAnnouncement, buf []byte) {
+
+	pos := uint(0)
+
+	buf[pos] = byte(109)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.ExpirationPeriod).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if value.ValidityPeriod != nil {
+		buf[pos] = 253
+		binary.BigEndian.PutUint16(buf[pos+1:], uint16(253))
+		pos += 3
+		pos += uint(enc.TLNum(encoder.ValidityPeriod_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.ValidityPeriod_encoder.Length > 0 {
+			encoder.ValidityPeriod_encoder.EncodeInto(value.ValidityPeriod, buf[pos:])
+			pos += encoder.ValidityPeriod_encoder.Length
+		}
+	}
+}
+
+func (encoder *PrefixAnnouncementEncoder) Encode(value *PrefixAnnouncement) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *PrefixAnnouncementParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*PrefixAnnouncement, error) {
+
+	var handled_ExpirationPeriod bool = false
+	var handled_ValidityPeriod bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &PrefixAnnouncement{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 109:
+				if true {
+					handled = true
+					handled_ExpirationPeriod = true
+					value.ExpirationPeriod = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.ExpirationPeriod = uint64(value.ExpirationPeriod<<8) | uint64(x)
+						}
+					}
+				}
+			case 253:
+				if true {
+					handled = true
+					handled_ValidityPeriod = true
+					value.ValidityPeriod, err = context.ValidityPeriod_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_ExpirationPeriod && err == nil {
+		err = enc.ErrSkipRequired{Name: "ExpirationPeriod", TypeNum: 109}
+	}
+	if !handled_ValidityPeriod && err == nil {
+		value.ValidityPeriod = nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *PrefixAnnouncement) Encode() enc.Wire {
+	encoder := PrefixAnnouncementEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *PrefixAnnouncement) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParsePrefixAnnouncement(reader enc.WireView, ignoreCritical bool) (*PrefixAnnouncement, error) {
+	context := PrefixAnnouncementParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
 type ControlParametersEncoder struct {
 	Length uint
 
@@ -3085,6 +3308,18 @@ func (encoder *FaceStatusEncoder) Init(value *FaceStatus) {
 	l += uint(1 + enc.Nat(value.NInBytes).EncodingLength())
 	l += 1
 	l += uint(1 + enc.Nat(value.NOutBytes).EncodingLength())
+	if optval, ok := value.NAcknowledged.Get(); ok {
+		l += 1
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
+	if optval, ok := value.NRetransmitted.Get(); ok {
+		l += 1
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
+	if optval, ok := value.NRetxExhausted.Get(); ok {
+		l += 1
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
 	l += 1
 	l += uint(1 + enc.Nat(value.Flags).EncodingLength())
 	encoder.Length = l
@@ -3201,6 +3436,30 @@ func (encoder *FaceStatusEncoder) EncodeInto(value *FaceStatus, buf []byte) {
 
 	buf[pos] = byte(enc.Nat(value.NOutBytes).EncodeInto(buf[pos+1:]))
 	pos += uint(1 + buf[pos])
+	if optval, ok := value.NAcknowledged.Get(); ok {
+		buf[pos] = byte(205)
+		pos += 1
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
+	if optval, ok := value.NRetransmitted.Get(); ok {
+		buf[pos] = byte(206)
+		pos += 1
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
+	if optval, ok := value.NRetxExhausted.Get(); ok {
+		buf[pos] = byte(207)
+		pos += 1
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
 	buf[pos] = byte(108)
 	pos += 1
 
@@ -3238,6 +3497,9 @@ func (context *FaceStatusParsingContext) Parse(reader enc.WireView, ignoreCritic
 	var handled_NOutNacks bool = false
 	var handled_NInBytes bool = false
 	var handled_NOutBytes bool = false
+	var handled_NAcknowledged bool = false
+	var handled_NRetransmitted bool = false
+	var handled_NRetxExhausted bool = false
 	var handled_Flags bool = false
 
 	progress := -1
@@ -3609,31 +3871,100 @@ func (context *FaceStatusParsingContext) Parse(reader enc.WireView, ignoreCritic
 						}
 					}
 				}
-			case 108:
+			case 205:
 				if true {
 					handled = true
-					handled_Flags = true
-					value.Flags = uint64(0)
+					handled_NAcknowledged = true
 					{
-						for i := 0; i < int(l); i++ {
-							x := byte(0)
-							x, err = reader.ReadByte()
-							if err != nil {
-								if err == io.EOF {
-									err = io.ErrUnexpectedEOF
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
 								}
-								break
+								optval = uint64(optval<<8) | uint64(x)
 							}
-							value.Flags = uint64(value.Flags<<8) | uint64(x)
 						}
+						value.NAcknowledged.Set(optval)
 					}
 				}
-			default:
-				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
-					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+			case 206:
+				if true {
+					handled = true
+					handled_NRetransmitted = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.NRetransmitted.Set(optval)
+					}
 				}
-				handled = true
-				err = reader.Skip(int(l))
+			case 207:
+				if true {
+					handled = true
+					handled_NRetxExhausted = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.NRetxExhausted.Set(optval)
+					}
+				}
+			case 108:
+				if true {
+					handled = true
+					handled_Flags = true
+					value.Flags = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Flags = uint64(value.Flags<<8) | uint64(x)
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
 			}
 			if err == nil && !handled {
 			}
@@ -3700,6 +4031,15 @@ func (context *FaceStatusParsingContext) Parse(reader enc.WireView, ignoreCritic
 	if !handled_NOutBytes && err == nil {
 		err = enc.ErrSkipRequired{Name: "NOutBytes", TypeNum: 149}
 	}
+	if !handled_NAcknowledged && err == nil {
+		value.NAcknowledged.Unset()
+	}
+	if !handled_NRetransmitted && err == nil {
+		value.NRetransmitted.Unset()
+	}
+	if !handled_NRetxExhausted && err == nil {
+		value.NRetxExhausted.Unset()
+	}
 	if !handled_Flags && err == nil {
 		err = enc.ErrSkipRequired{Name: "Flags", TypeNum: 108}
 	}
@@ -6094,6 +6434,14 @@ func (encoder *CsInfoEncoder) Init(value *CsInfo) {
 	l += uint(1 + enc.Nat(value.NHits).EncodingLength())
 	l += 1
 	l += uint(1 + enc.Nat(value.NMisses).EncodingLength())
+	if optval, ok := value.CapacityBytes.Get(); ok {
+		l += 1
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
+	if optval, ok := value.NCsBytes.Get(); ok {
+		l += 1
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
 	encoder.Length = l
 
 }
@@ -6131,6 +6479,22 @@ func (encoder *CsInfoEncoder) EncodeInto(value *CsInfo, buf []byte) {
 
 	buf[pos] = byte(enc.Nat(value.NMisses).EncodeInto(buf[pos+1:]))
 	pos += uint(1 + buf[pos])
+	if optval, ok := value.CapacityBytes.Get(); ok {
+		buf[pos] = byte(209)
+		pos += 1
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
+	if optval, ok := value.NCsBytes.Get(); ok {
+		buf[pos] = byte(210)
+		pos += 1
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
 }
 
 func (encoder *CsInfoEncoder) Encode(value *CsInfo) enc.Wire {
@@ -6150,6 +6514,8 @@ func (context *CsInfoParsingContext) Parse(reader enc.WireView, ignoreCritical b
 	var handled_NCsEntries bool = false
 	var handled_NHits bool = false
 	var handled_NMisses bool = false
+	var handled_CapacityBytes bool = false
+	var handled_NCsBytes bool = false
 
 	progress := -1
 	_ = progress
@@ -6271,6 +6637,52 @@ func (context *CsInfoParsingContext) Parse(reader enc.WireView, ignoreCritical b
 						}
 					}
 				}
+			case 209:
+				if true {
+					handled = true
+					handled_CapacityBytes = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.CapacityBytes.Set(optval)
+					}
+				}
+			case 210:
+				if true {
+					handled = true
+					handled_NCsBytes = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.NCsBytes.Set(optval)
+					}
+				}
 			default:
 				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
 					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
@@ -6304,6 +6716,12 @@ func (context *CsInfoParsingContext) Parse(reader enc.WireView, ignoreCritical b
 	if !handled_NMisses && err == nil {
 		err = enc.ErrSkipRequired{Name: "NMisses", TypeNum: 130}
 	}
+	if !handled_CapacityBytes && err == nil {
+		value.CapacityBytes.Unset()
+	}
+	if !handled_NCsBytes && err == nil {
+		value.NCsBytes.Unset()
+	}
 
 	if err != nil {
 		return nil, err
@@ -6461,3 +6879,1579 @@ func ParseCsInfoMsg(reader enc.WireView, ignoreCritical bool) (*CsInfoMsg, error
 	context.Init()
 	return context.Parse(reader, ignoreCritical)
 }
+
+type MeasurementsFaceRecordEncoder struct {
+	Length uint
+}
+
+type MeasurementsFaceRecordParsingContext struct {
+}
+
+func (encoder *MeasurementsFaceRecordEncoder) Init(value *MeasurementsFaceRecord) {
+
+	l := uint(0)
+	l += 1
+	l += uint(1 + enc.Nat(value.FaceId).EncodingLength())
+	l += 1
+	l += uint(1 + enc.Nat(value.Srtt).EncodingLength())
+	l += 1
+	l += uint(1 + enc.Nat(value.RttVar).EncodingLength())
+	l += 1
+	l += uint(1 + enc.Nat(value.Rto).EncodingLength())
+	l += 1
+	l += uint(1 + enc.Nat(value.NSatisfied).EncodingLength())
+	l += 1
+	l += uint(1 + enc.Nat(value.NTimeouts).EncodingLength())
+	encoder.Length = l
+
+}
+
+func (context *MeasurementsFaceRecordParsingContext) Init() {
+
+}
+
+func (encoder *MeasurementsFaceRecordEncoder) EncodeInto(value *MeasurementsFaceRecord, buf []byte) {
+
+	pos := uint(0)
+
+	buf[pos] = byte(105)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.FaceId).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = byte(130)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.Srtt).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = byte(131)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.RttVar).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = byte(132)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.Rto).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = byte(133)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.NSatisfied).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = byte(134)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.NTimeouts).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+}
+
+func (encoder *MeasurementsFaceRecordEncoder) Encode(value *MeasurementsFaceRecord) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *MeasurementsFaceRecordParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*MeasurementsFaceRecord, error) {
+
+	var handled_FaceId bool = false
+	var handled_Srtt bool = false
+	var handled_RttVar bool = false
+	var handled_Rto bool = false
+	var handled_NSatisfied bool = false
+	var handled_NTimeouts bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &MeasurementsFaceRecord{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 105:
+				if true {
+					handled = true
+					handled_FaceId = true
+					value.FaceId = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.FaceId = uint64(value.FaceId<<8) | uint64(x)
+						}
+					}
+				}
+			case 130:
+				if true {
+					handled = true
+					handled_Srtt = true
+					value.Srtt = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Srtt = uint64(value.Srtt<<8) | uint64(x)
+						}
+					}
+				}
+			case 131:
+				if true {
+					handled = true
+					handled_RttVar = true
+					value.RttVar = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.RttVar = uint64(value.RttVar<<8) | uint64(x)
+						}
+					}
+				}
+			case 132:
+				if true {
+					handled = true
+					handled_Rto = true
+					value.Rto = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.Rto = uint64(value.Rto<<8) | uint64(x)
+						}
+					}
+				}
+			case 133:
+				if true {
+					handled = true
+					handled_NSatisfied = true
+					value.NSatisfied = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.NSatisfied = uint64(value.NSatisfied<<8) | uint64(x)
+						}
+					}
+				}
+			case 134:
+				if true {
+					handled = true
+					handled_NTimeouts = true
+					value.NTimeouts = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.NTimeouts = uint64(value.NTimeouts<<8) | uint64(x)
+						}
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_FaceId && err == nil {
+		err = enc.ErrSkipRequired{Name: "FaceId", TypeNum: 105}
+	}
+	if !handled_Srtt && err == nil {
+		err = enc.ErrSkipRequired{Name: "Srtt", TypeNum: 130}
+	}
+	if !handled_RttVar && err == nil {
+		err = enc.ErrSkipRequired{Name: "RttVar", TypeNum: 131}
+	}
+	if !handled_Rto && err == nil {
+		err = enc.ErrSkipRequired{Name: "Rto", TypeNum: 132}
+	}
+	if !handled_NSatisfied && err == nil {
+		err = enc.ErrSkipRequired{Name: "NSatisfied", TypeNum: 133}
+	}
+	if !handled_NTimeouts && err == nil {
+		err = enc.ErrSkipRequired{Name: "NTimeouts", TypeNum: 134}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *MeasurementsFaceRecord) Encode() enc.Wire {
+	encoder := MeasurementsFaceRecordEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *MeasurementsFaceRecord) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseMeasurementsFaceRecord(reader enc.WireView, ignoreCritical bool) (*MeasurementsFaceRecord, error) {
+	context := MeasurementsFaceRecordParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type MeasurementsEntryEncoder struct {
+	Length uint
+
+	Name_length uint
+
+	Faces_subencoder []struct {
+		Faces_encoder MeasurementsFaceRecordEncoder
+	}
+}
+
+type MeasurementsEntryParsingContext struct {
+	Faces_context MeasurementsFaceRecordParsingContext
+}
+
+func (encoder *MeasurementsEntryEncoder) Init(value *MeasurementsEntry) {
+	if value.Name != nil {
+		encoder.Name_length = 0
+		for _, c := range value.Name {
+			encoder.Name_length += uint(c.EncodingLength())
+		}
+	}
+
+	{
+		Faces_l := len(value.Faces)
+		encoder.Faces_subencoder = make([]struct {
+			Faces_encoder MeasurementsFaceRecordEncoder
+		}, Faces_l)
+		for i := 0; i < Faces_l; i++ {
+			pseudoEncoder := &encoder.Faces_subencoder[i]
+			pseudoValue := struct {
+				Faces *MeasurementsFaceRecord
+			}{
+				Faces: value.Faces[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Faces != nil {
+					encoder.Faces_encoder.Init(value.Faces)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Name_length).EncodingLength())
+		l += encoder.Name_length
+	}
+	l += 1
+	l += uint(1 + enc.Nat(value.ThreadId).EncodingLength())
+	if optval, ok := value.ExpirationPeriod.Get(); ok {
+		l += 1
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
+	if optval, ok := value.LastWorkingFace.Get(); ok {
+		l += 1
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
+	if value.Faces != nil {
+		for seq_i, seq_v := range value.Faces {
+			pseudoEncoder := &encoder.Faces_subencoder[seq_i]
+			pseudoValue := struct {
+				Faces *MeasurementsFaceRecord
+			}{
+				Faces: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Faces != nil {
+					l += 1
+					l += uint(enc.TLNum(encoder.Faces_encoder.Length).EncodingLength())
+					l += encoder.Faces_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *MeasurementsEntryParsingContext) Init() {
+
+	context.Faces_context.Init()
+}
+
+func (encoder *MeasurementsEntryEncoder) EncodeInto(value *MeasurementsEntry, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = byte(7)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Name_length).EncodeInto(buf[pos:]))
+		for _, c := range value.Name {
+			pos += uint(c.EncodeInto(buf[pos:]))
+		}
+	}
+	buf[pos] = byte(130)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.ThreadId).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if optval, ok := value.ExpirationPeriod.Get(); ok {
+		buf[pos] = byte(109)
+		pos += 1
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
+	if optval, ok := value.LastWorkingFace.Get(); ok {
+		buf[pos] = byte(131)
+		pos += 1
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
+	if value.Faces != nil {
+		for seq_i, seq_v := range value.Faces {
+			pseudoEncoder := &encoder.Faces_subencoder[seq_i]
+			pseudoValue := struct {
+				Faces *MeasurementsFaceRecord
+			}{
+				Faces: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Faces != nil {
+					buf[pos] = byte(129)
+					pos += 1
+					pos += uint(enc.TLNum(encoder.Faces_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Faces_encoder.Length > 0 {
+						encoder.Faces_encoder.EncodeInto(value.Faces, buf[pos:])
+						pos += encoder.Faces_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *MeasurementsEntryEncoder) Encode(value *MeasurementsEntry) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *MeasurementsEntryParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*MeasurementsEntry, error) {
+
+	var handled_Name bool = false
+	var handled_ThreadId bool = false
+	var handled_ExpirationPeriod bool = false
+	var handled_LastWorkingFace bool = false
+	var handled_Faces bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &MeasurementsEntry{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 7:
+				if true {
+					handled = true
+					handled_Name = true
+					delegate := reader.Delegate(int(l))
+					value.Name, err = delegate.ReadName()
+				}
+			case 130:
+				if true {
+					handled = true
+					handled_ThreadId = true
+					value.ThreadId = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.ThreadId = uint64(value.ThreadId<<8) | uint64(x)
+						}
+					}
+				}
+			case 109:
+				if true {
+					handled = true
+					handled_ExpirationPeriod = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.ExpirationPeriod.Set(optval)
+					}
+				}
+			case 131:
+				if true {
+					handled = true
+					handled_LastWorkingFace = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.LastWorkingFace.Set(optval)
+					}
+				}
+			case 129:
+				if true {
+					handled = true
+					handled_Faces = true
+					if value.Faces == nil {
+						value.Faces = make([]*MeasurementsFaceRecord, 0)
+					}
+					{
+						pseudoValue := struct {
+							Faces *MeasurementsFaceRecord
+						}{}
+						{
+							value := &pseudoValue
+							value.Faces, err = context.Faces_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Faces = append(value.Faces, pseudoValue.Faces)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_ThreadId && err == nil {
+		err = enc.ErrSkipRequired{Name: "ThreadId", TypeNum: 130}
+	}
+	if !handled_ExpirationPeriod && err == nil {
+		value.ExpirationPeriod.Unset()
+	}
+	if !handled_LastWorkingFace && err == nil {
+		value.LastWorkingFace.Unset()
+	}
+	if !handled_Faces && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *MeasurementsEntry) Encode() enc.Wire {
+	encoder := MeasurementsEntryEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *MeasurementsEntry) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseMeasurementsEntry(reader enc.WireView, ignoreCritical bool) (*MeasurementsEntry, error) {
+	context := MeasurementsEntryParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type MeasurementsStatusEncoder struct {
+	Length uint
+
+	Entries_subencoder []struct {
+		Entries_encoder MeasurementsEntryEncoder
+	}
+}
+
+type MeasurementsStatusParsingContext struct {
+	Entries_context MeasurementsEntryParsingContext
+}
+
+func (encoder *MeasurementsStatusEncoder) Init(value *MeasurementsStatus) {
+	{
+		Entries_l := len(value.Entries)
+		encoder.Entries_subencoder = make([]struct {
+			Entries_encoder MeasurementsEntryEncoder
+		}, Entries_l)
+		for i := 0; i < Entries_l; i++ {
+			pseudoEncoder := &encoder.Entries_subencoder[i]
+			pseudoValue := struct {
+				Entries *MeasurementsEntry
+			}{
+				Entries: value.Entries[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					encoder.Entries_encoder.Init(value.Entries)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Entries != nil {
+		for seq_i, seq_v := range value.Entries {
+			pseudoEncoder := &encoder.Entries_subencoder[seq_i]
+			pseudoValue := struct {
+				Entries *MeasurementsEntry
+			}{
+				Entries: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					l += 1
+					l += uint(enc.TLNum(encoder.Entries_encoder.Length).EncodingLength())
+					l += encoder.Entries_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *MeasurementsStatusParsingContext) Init() {
+	context.Entries_context.Init()
+}
+
+func (encoder *MeasurementsStatusEncoder) EncodeInto(value *MeasurementsStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Entries != nil {
+		for seq_i, seq_v := range value.Entries {
+			pseudoEncoder := &encoder.Entries_subencoder[seq_i]
+			pseudoValue := struct {
+				Entries *MeasurementsEntry
+			}{
+				Entries: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					buf[pos] = byte(128)
+					pos += 1
+					pos += uint(enc.TLNum(encoder.Entries_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Entries_encoder.Length > 0 {
+						encoder.Entries_encoder.EncodeInto(value.Entries, buf[pos:])
+						pos += encoder.Entries_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *MeasurementsStatusEncoder) Encode(value *MeasurementsStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *MeasurementsStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*MeasurementsStatus, error) {
+
+	var handled_Entries bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &MeasurementsStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 128:
+				if true {
+					handled = true
+					handled_Entries = true
+					if value.Entries == nil {
+						value.Entries = make([]*MeasurementsEntry, 0)
+					}
+					{
+						pseudoValue := struct {
+							Entries *MeasurementsEntry
+						}{}
+						{
+							value := &pseudoValue
+							value.Entries, err = context.Entries_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Entries = append(value.Entries, pseudoValue.Entries)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Entries && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *MeasurementsStatus) Encode() enc.Wire {
+	encoder := MeasurementsStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *MeasurementsStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseMeasurementsStatus(reader enc.WireView, ignoreCritical bool) (*MeasurementsStatus, error) {
+	context := MeasurementsStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type CsQueryFilterValueEncoder struct {
+	Length uint
+
+	Name_length uint
+}
+
+type CsQueryFilterValueParsingContext struct {
+}
+
+func (encoder *CsQueryFilterValueEncoder) Init(value *CsQueryFilterValue) {
+	if value.Name != nil {
+		encoder.Name_length = 0
+		for _, c := range value.Name {
+			encoder.Name_length += uint(c.EncodingLength())
+		}
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Name_length).EncodingLength())
+		l += encoder.Name_length
+	}
+	if optval, ok := value.Count.Get(); ok {
+		l += 1
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
+	encoder.Length = l
+
+}
+
+func (context *CsQueryFilterValueParsingContext) Init() {
+
+}
+
+func (encoder *CsQueryFilterValueEncoder) EncodeInto(value *CsQueryFilterValue, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = byte(7)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Name_length).EncodeInto(buf[pos:]))
+		for _, c := range value.Name {
+			pos += uint(c.EncodeInto(buf[pos:]))
+		}
+	}
+	if optval, ok := value.Count.Get(); ok {
+		buf[pos] = byte(132)
+		pos += 1
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
+}
+
+func (encoder *CsQueryFilterValueEncoder) Encode(value *CsQueryFilterValue) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *CsQueryFilterValueParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*CsQueryFilterValue, error) {
+
+	var handled_Name bool = false
+	var handled_Count bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &CsQueryFilterValue{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 7:
+				if true {
+					handled = true
+					handled_Name = true
+					delegate := reader.Delegate(int(l))
+					value.Name, err = delegate.ReadName()
+				}
+			case 132:
+				if true {
+					handled = true
+					handled_Count = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.Count.Set(optval)
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_Count && err == nil {
+		value.Count.Unset()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *CsQueryFilterValue) Encode() enc.Wire {
+	encoder := CsQueryFilterValueEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *CsQueryFilterValue) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseCsQueryFilterValue(reader enc.WireView, ignoreCritical bool) (*CsQueryFilterValue, error) {
+	context := CsQueryFilterValueParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type CsQueryFilterEncoder struct {
+	Length uint
+
+	Val_encoder CsQueryFilterValueEncoder
+}
+
+type CsQueryFilterParsingContext struct {
+	Val_context CsQueryFilterValueParsingContext
+}
+
+func (encoder *CsQueryFilterEncoder) Init(value *CsQueryFilter) {
+	if value.Val != nil {
+		encoder.Val_encoder.Init(value.Val)
+	}
+
+	l := uint(0)
+	if value.Val != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Val_encoder.Length).EncodingLength())
+		l += encoder.Val_encoder.Length
+	}
+	encoder.Length = l
+
+}
+
+func (context *CsQueryFilterParsingContext) Init() {
+	context.Val_context.Init()
+}
+
+func (encoder *CsQueryFilterEncoder) EncodeInto(value *CsQueryFilter, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Val != nil {
+		buf[pos] = byte(150)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Val_encoder.Length).EncodeInto(buf[pos:]))
+		if encoder.Val_encoder.Length > 0 {
+			encoder.Val_encoder.EncodeInto(value.Val, buf[pos:])
+			pos += encoder.Val_encoder.Length
+		}
+	}
+}
+
+func (encoder *CsQueryFilterEncoder) Encode(value *CsQueryFilter) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *CsQueryFilterParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*CsQueryFilter, error) {
+
+	var handled_Val bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &CsQueryFilter{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 150:
+				if true {
+					handled = true
+					handled_Val = true
+					value.Val, err = context.Val_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Val && err == nil {
+		value.Val = nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *CsQueryFilter) Encode() enc.Wire {
+	encoder := CsQueryFilterEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *CsQueryFilter) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseCsQueryFilter(reader enc.WireView, ignoreCritical bool) (*CsQueryFilter, error) {
+	context := CsQueryFilterParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type CsQueryEncoder struct {
+	Length uint
+
+	Name_length uint
+}
+
+type CsQueryParsingContext struct {
+}
+
+func (encoder *CsQueryEncoder) Init(value *CsQuery) {
+	if value.Name != nil {
+		encoder.Name_length = 0
+		for _, c := range value.Name {
+			encoder.Name_length += uint(c.EncodingLength())
+		}
+	}
+
+	l := uint(0)
+	if value.Name != nil {
+		l += 1
+		l += uint(enc.TLNum(encoder.Name_length).EncodingLength())
+		l += encoder.Name_length
+	}
+	l += 1
+	l += uint(1 + enc.Nat(value.ThreadId).EncodingLength())
+	l += 1
+	l += uint(1 + enc.Nat(value.PacketSize).EncodingLength())
+	if optval, ok := value.FreshnessPeriod.Get(); ok {
+		l += 1
+		l += uint(1 + enc.Nat(optval).EncodingLength())
+	}
+	encoder.Length = l
+
+}
+
+func (context *CsQueryParsingContext) Init() {
+
+}
+
+func (encoder *CsQueryEncoder) EncodeInto(value *CsQuery, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Name != nil {
+		buf[pos] = byte(7)
+		pos += 1
+		pos += uint(enc.TLNum(encoder.Name_length).EncodeInto(buf[pos:]))
+		for _, c := range value.Name {
+			pos += uint(c.EncodeInto(buf[pos:]))
+		}
+	}
+	buf[pos] = byte(130)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.ThreadId).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	buf[pos] = byte(131)
+	pos += 1
+
+	buf[pos] = byte(enc.Nat(value.PacketSize).EncodeInto(buf[pos+1:]))
+	pos += uint(1 + buf[pos])
+	if optval, ok := value.FreshnessPeriod.Get(); ok {
+		buf[pos] = byte(132)
+		pos += 1
+
+		buf[pos] = byte(enc.Nat(optval).EncodeInto(buf[pos+1:]))
+		pos += uint(1 + buf[pos])
+
+	}
+}
+
+func (encoder *CsQueryEncoder) Encode(value *CsQuery) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *CsQueryParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*CsQuery, error) {
+
+	var handled_Name bool = false
+	var handled_ThreadId bool = false
+	var handled_PacketSize bool = false
+	var handled_FreshnessPeriod bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &CsQuery{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 7:
+				if true {
+					handled = true
+					handled_Name = true
+					delegate := reader.Delegate(int(l))
+					value.Name, err = delegate.ReadName()
+				}
+			case 130:
+				if true {
+					handled = true
+					handled_ThreadId = true
+					value.ThreadId = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.ThreadId = uint64(value.ThreadId<<8) | uint64(x)
+						}
+					}
+				}
+			case 131:
+				if true {
+					handled = true
+					handled_PacketSize = true
+					value.PacketSize = uint64(0)
+					{
+						for i := 0; i < int(l); i++ {
+							x := byte(0)
+							x, err = reader.ReadByte()
+							if err != nil {
+								if err == io.EOF {
+									err = io.ErrUnexpectedEOF
+								}
+								break
+							}
+							value.PacketSize = uint64(value.PacketSize<<8) | uint64(x)
+						}
+					}
+				}
+			case 132:
+				if true {
+					handled = true
+					handled_FreshnessPeriod = true
+					{
+						optval := uint64(0)
+						optval = uint64(0)
+						{
+							for i := 0; i < int(l); i++ {
+								x := byte(0)
+								x, err = reader.ReadByte()
+								if err != nil {
+									if err == io.EOF {
+										err = io.ErrUnexpectedEOF
+									}
+									break
+								}
+								optval = uint64(optval<<8) | uint64(x)
+							}
+						}
+						value.FreshnessPeriod.Set(optval)
+					}
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Name && err == nil {
+		value.Name = nil
+	}
+	if !handled_ThreadId && err == nil {
+		err = enc.ErrSkipRequired{Name: "ThreadId", TypeNum: 130}
+	}
+	if !handled_PacketSize && err == nil {
+		err = enc.ErrSkipRequired{Name: "PacketSize", TypeNum: 131}
+	}
+	if !handled_FreshnessPeriod && err == nil {
+		value.FreshnessPeriod.Unset()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *CsQuery) Encode() enc.Wire {
+	encoder := CsQueryEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *CsQuery) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseCsQuery(reader enc.WireView, ignoreCritical bool) (*CsQuery, error) {
+	context := CsQueryParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
+
+type CsQueryStatusEncoder struct {
+	Length uint
+
+	Entries_subencoder []struct {
+		Entries_encoder CsQueryEncoder
+	}
+}
+
+type CsQueryStatusParsingContext struct {
+	Entries_context CsQueryParsingContext
+}
+
+func (encoder *CsQueryStatusEncoder) Init(value *CsQueryStatus) {
+	{
+		Entries_l := len(value.Entries)
+		encoder.Entries_subencoder = make([]struct {
+			Entries_encoder CsQueryEncoder
+		}, Entries_l)
+		for i := 0; i < Entries_l; i++ {
+			pseudoEncoder := &encoder.Entries_subencoder[i]
+			pseudoValue := struct {
+				Entries *CsQuery
+			}{
+				Entries: value.Entries[i],
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					encoder.Entries_encoder.Init(value.Entries)
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+
+	l := uint(0)
+	if value.Entries != nil {
+		for seq_i, seq_v := range value.Entries {
+			pseudoEncoder := &encoder.Entries_subencoder[seq_i]
+			pseudoValue := struct {
+				Entries *CsQuery
+			}{
+				Entries: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					l += 1
+					l += uint(enc.TLNum(encoder.Entries_encoder.Length).EncodingLength())
+					l += encoder.Entries_encoder.Length
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+	encoder.Length = l
+
+}
+
+func (context *CsQueryStatusParsingContext) Init() {
+	context.Entries_context.Init()
+}
+
+func (encoder *CsQueryStatusEncoder) EncodeInto(value *CsQueryStatus, buf []byte) {
+
+	pos := uint(0)
+
+	if value.Entries != nil {
+		for seq_i, seq_v := range value.Entries {
+			pseudoEncoder := &encoder.Entries_subencoder[seq_i]
+			pseudoValue := struct {
+				Entries *CsQuery
+			}{
+				Entries: seq_v,
+			}
+			{
+				encoder := pseudoEncoder
+				value := &pseudoValue
+				if value.Entries != nil {
+					buf[pos] = byte(128)
+					pos += 1
+					pos += uint(enc.TLNum(encoder.Entries_encoder.Length).EncodeInto(buf[pos:]))
+					if encoder.Entries_encoder.Length > 0 {
+						encoder.Entries_encoder.EncodeInto(value.Entries, buf[pos:])
+						pos += encoder.Entries_encoder.Length
+					}
+				}
+				_ = encoder
+				_ = value
+			}
+		}
+	}
+}
+
+func (encoder *CsQueryStatusEncoder) Encode(value *CsQueryStatus) enc.Wire {
+
+	wire := make(enc.Wire, 1)
+	wire[0] = make([]byte, encoder.Length)
+	buf := wire[0]
+	encoder.EncodeInto(value, buf)
+
+	return wire
+}
+
+func (context *CsQueryStatusParsingContext) Parse(reader enc.WireView, ignoreCritical bool) (*CsQueryStatus, error) {
+
+	var handled_Entries bool = false
+
+	progress := -1
+	_ = progress
+
+	value := &CsQueryStatus{}
+	var err error
+	var startPos int
+	for {
+		startPos = reader.Pos()
+		if startPos >= reader.Length() {
+			break
+		}
+		typ := enc.TLNum(0)
+		l := enc.TLNum(0)
+		typ, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+		l, err = reader.ReadTLNum()
+		if err != nil {
+			return nil, enc.ErrFailToParse{TypeNum: 0, Err: err}
+		}
+
+		err = nil
+		if handled := false; true {
+			switch typ {
+			case 128:
+				if true {
+					handled = true
+					handled_Entries = true
+					if value.Entries == nil {
+						value.Entries = make([]*CsQuery, 0)
+					}
+					{
+						pseudoValue := struct {
+							Entries *CsQuery
+						}{}
+						{
+							value := &pseudoValue
+							value.Entries, err = context.Entries_context.Parse(reader.Delegate(int(l)), ignoreCritical)
+							_ = value
+						}
+						value.Entries = append(value.Entries, pseudoValue.Entries)
+					}
+					progress--
+				}
+			default:
+				if !ignoreCritical && ((typ <= 31) || ((typ & 1) == 1)) {
+					return nil, enc.ErrUnrecognizedField{TypeNum: typ}
+				}
+				handled = true
+				err = reader.Skip(int(l))
+			}
+			if err == nil && !handled {
+			}
+			if err != nil {
+				return nil, enc.ErrFailToParse{TypeNum: typ, Err: err}
+			}
+		}
+	}
+
+	startPos = reader.Pos()
+	err = nil
+
+	if !handled_Entries && err == nil {
+		// sequence - skip
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (value *CsQueryStatus) Encode() enc.Wire {
+	encoder := CsQueryStatusEncoder{}
+	encoder.Init(value)
+	return encoder.Encode(value)
+}
+
+func (value *CsQueryStatus) Bytes() []byte {
+	return value.Encode().Join()
+}
+
+func ParseCsQueryStatus(reader enc.WireView, ignoreCritical bool) (*CsQueryStatus, error) {
+	context := CsQueryStatusParsingContext{}
+	context.Init()
+	return context.Parse(reader, ignoreCritical)
+}
@@ -12,14 +12,24 @@ import (
 
 // ValidateData verifies the signature of a Data packet with a certificate.
 func ValidateData(data ndn.Data, sigCovered enc.Wire, cert ndn.Data) (bool, error) {
-	switch data.Signature().SigType() {
+	return ValidateSignature(data.Signature(), sigCovered, cert)
+}
+
+// ValidateInterest verifies the signature of a signed Interest with a certificate.
+func ValidateInterest(interest ndn.Interest, sigCovered enc.Wire, cert ndn.Data) (bool, error) {
+	return ValidateSignature(interest.Signature(), sigCovered, cert)
+}
+
+// ValidateSignature verifies a signature of a packet with the public key of a certificate.
+func ValidateSignature(sig ndn.Signature, sigCovered enc.Wire, cert ndn.Data) (bool, error) {
+	switch sig.SigType() {
 	case ndn.SignatureSha256WithRsa:
 		pkey, err := x509.ParsePKIXPublicKey(cert.Content().Join())
 		if err != nil {
 			return false, err
 		}
 		if pub, ok := pkey.(*rsa.PublicKey); ok {
-			return ValidateRsa(sigCovered, data.Signature(), pub), nil
+			return ValidateRsa(sigCovered, sig, pub), nil
 		}
 	case ndn.SignatureSha256WithEcdsa:
 		pkey, err := x509.ParsePKIXPublicKey(cert.Content().Join())
@@ -27,7 +37,7 @@ func ValidateData(data ndn.Data, sigCovered enc.Wire, cert ndn.Data) (bool, erro
 			return false, err
 		}
 		if pub, ok := pkey.(*ecdsa.PublicKey); ok {
-			return validateEcdsa(sigCovered, data.Signature(), pub), nil
+			return validateEcdsa(sigCovered, sig, pub), nil
 		}
 	case ndn.SignatureEd25519:
 		pkey, err := x509.ParsePKIXPublicKey(cert.Content().Join())
@@ -35,12 +45,12 @@ func ValidateData(data ndn.Data, sigCovered enc.Wire, cert ndn.Data) (bool, erro
 			return false, err
 		}
 		if pub, ok := pkey.(ed25519.PublicKey); ok {
-			return validateEd25519(sigCovered, data.Signature(), pub), nil
+			return validateEd25519(sigCovered, sig, pub), nil
 		}
 	}
 
 	return false, ndn.ErrInvalidValue{
 		Item:  "Signature.SigType",
-		Value: data.Signature().SigType(),
+		Value: sig.SigType(),
 	}
 }
@@ -5,10 +5,12 @@ import (
 	"crypto/x509"
 	"encoding/base64"
 	"testing"
+	"time"
 
 	enc "github.com/named-data/ndnd/std/encoding"
 	"github.com/named-data/ndnd/std/ndn"
 	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/security"
 	sig "github.com/named-data/ndnd/std/security/signer"
 	"github.com/named-data/ndnd/std/types/optional"
 	tu "github.com/named-data/ndnd/std/utils/testutils"
@@ -142,3 +144,47 @@ func TestSignatureSize(t *testing.T) {
 	testSignSize(t, 2048)
 	testSignSize(t, 4096)
 }
+
+// TestValidateInterest tests the validation of signed Interests with a certificate.
+func TestValidateInterest(t *testing.T) {
+	tu.SetT(t)
+
+	// Make a key and its self-signed certificate
+	keyName := tu.NoErr(enc.NameFromStr("/ndn/alice/KEY/1"))
+	signer := tu.NoErr(sig.KeygenEd25519(keyName))
+	certWire := tu.NoErr(security.SelfSign(security.SignCertArgs{
+		Signer:    signer,
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Hour),
+	}))
+	cert, _, err := spec.Spec{}.ReadData(enc.NewWireView(certWire))
+	require.NoError(t, err)
+
+	// Make a signed Interest
+	encInterest, err := spec.Spec{}.MakeInterest(
+		tu.NoErr(enc.NameFromStr("/localhop/nfd/rib/register")),
+		&ndn.InterestConfig{
+			SigNonce: []byte{0x01, 0x02, 0x03, 0x04},
+			SigTime:  optional.Some(time.Duration(time.Now().UnixMilli()) * time.Millisecond),
+		},
+		enc.Wire{},
+		signer,
+	)
+	require.NoError(t, err)
+
+	// Validate the signature
+	interest, sigCov, err := spec.Spec{}.ReadInterest(enc.NewWireView(encInterest.Wire))
+	require.NoError(t, err)
+	require.True(t, tu.NoErr(sig.ValidateInterest(interest, sigCov, cert)))
+
+	// Validate with a different certificate
+	other := tu.NoErr(sig.KeygenEd25519(tu.NoErr(enc.NameFromStr("/ndn/bob/KEY/2"))))
+	otherWire := tu.NoErr(security.SelfSign(security.SignCertArgs{
+		Signer:    other,
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Hour),
+	}))
+	otherCert, _, err := spec.Spec{}.ReadData(enc.NewWireView(otherWire))
+	require.NoError(t, err)
+	require.False(t, tu.NoErr(sig.ValidateInterest(interest, sigCov, otherCert)))
+}
@@ -0,0 +1,351 @@
+// Package pcapng reads and writes packet captures in the pcapng format.
+//
+// Only the blocks needed for NDN captures are supported: the section header,
+// interface description and enhanced packet blocks. Other blocks are skipped
+// when reading.
+package pcapng
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// Block types
+const (
+	blockSectionHeader        = 0x0A0D0D0A
+	blockInterfaceDescription = 0x00000001
+	blockEnhancedPacket       = 0x00000006
+)
+
+// Option codes
+const (
+	optEndOfOpt    = 0
+	optIfName      = 2
+	optIfDesc      = 3
+	optIfTsResol   = 9
+	optEpbFlags    = 2
+	byteOrderMagic = 0x1A2B3C4D
+)
+
+// LinkTypeEthernet is the link type of Ethernet frames.
+const LinkTypeEthernet = 1
+
+// EtherTypeNDN is the EtherType of NDN packets in Ethernet frames.
+const EtherTypeNDN = 0x8624
+
+// Direction is the direction of a packet on its interface.
+type Direction uint8
+
+const (
+	DirectionUnknown Direction = iota
+	DirectionInbound
+	DirectionOutbound
+)
+
+// String returns in, out or ? for the direction.
+func (d Direction) String() string {
+	switch d {
+	case DirectionInbound:
+		return "in"
+	case DirectionOutbound:
+		return "out"
+	default:
+		return "?"
+	}
+}
+
+// Interface describes a capture interface.
+type Interface struct {
+	LinkType    uint16
+	Name        string
+	Description string
+
+	// Timestamp units per second
+	tsUnits uint64
+}
+
+// Packet is a captured packet.
+type Packet struct {
+	Interface uint32
+	Time      time.Time
+	Direction Direction
+	Data      []byte
+}
+
+// Writer writes a pcapng section in little-endian byte order.
+// Timestamps are written with nanosecond resolution.
+type Writer struct {
+	w      io.Writer
+	nIface uint32
+	buf    bytes.Buffer
+}
+
+// NewWriter creates a writer, and writes the section header.
+func NewWriter(w io.Writer) (*Writer, error) {
+	wr := &Writer{w: w}
+
+	body := make([]byte, 16)
+	binary.LittleEndian.PutUint32(body[0:], byteOrderMagic)
+	binary.LittleEndian.PutUint16(body[4:], 1) // major version
+	binary.LittleEndian.PutUint16(body[6:], 0) // minor version
+	binary.LittleEndian.PutUint64(body[8:], math.MaxUint64)
+	return wr, wr.writeBlock(blockSectionHeader, body, nil)
+}
+
+// AddInterface writes an interface description, and returns the ID of the interface.
+func (w *Writer) AddInterface(iface Interface) (uint32, error) {
+	body := make([]byte, 8)
+	binary.LittleEndian.PutUint16(body[0:], iface.LinkType)
+	binary.LittleEndian.PutUint32(body[4:], 0) // no snap length
+
+	var opts bytes.Buffer
+	if iface.Name != "" {
+		appendOption(&opts, optIfName, []byte(iface.Name))
+	}
+	if iface.Description != "" {
+		appendOption(&opts, optIfDesc, []byte(iface.Description))
+	}
+	appendOption(&opts, optIfTsResol, []byte{9})
+
+	if err := w.writeBlock(blockInterfaceDescription, body, opts.Bytes()); err != nil {
+		return 0, err
+	}
+	w.nIface++
+	return w.nIface - 1, nil
+}
+
+// WritePacket writes an enhanced packet block.
+func (w *Writer) WritePacket(pkt Packet) error {
+	if pkt.Interface >= w.nIface {
+		return fmt.Errorf("unknown interface %d", pkt.Interface)
+	}
+
+	ts := uint64(pkt.Time.UnixNano())
+	body := make([]byte, 20, 20+len(pkt.Data)+3)
+	binary.LittleEndian.PutUint32(body[0:], pkt.Interface)
+	binary.LittleEndian.PutUint32(body[4:], uint32(ts>>32))
+	binary.LittleEndian.PutUint32(body[8:], uint32(ts))
+	binary.LittleEndian.PutUint32(body[12:], uint32(len(pkt.Data)))
+	binary.LittleEndian.PutUint32(body[16:], uint32(len(pkt.Data)))
+	body = append(body, pkt.Data...)
+	body = append(body, make([]byte, pad4(len(pkt.Data)))...)
+
+	var opts bytes.Buffer
+	if pkt.Direction != DirectionUnknown {
+		flags := make([]byte, 4)
+		binary.LittleEndian.PutUint32(flags, uint32(pkt.Direction))
+		appendOption(&opts, optEpbFlags, flags)
+	}
+
+	return w.writeBlock(blockEnhancedPacket, body, opts.Bytes())
+}
+
+// writeBlock writes a block with the given body and options, which are
+// terminated by an end-of-options option if any.
+func (w *Writer) writeBlock(typ uint32, body []byte, opts []byte) error {
+	total := 12 + len(body) + len(opts)
+	if len(opts) > 0 {
+		total += 4
+	}
+
+	w.buf.Reset()
+	binary.Write(&w.buf, binary.LittleEndian, [2]uint32{typ, uint32(total)})
+	w.buf.Write(body)
+	if len(opts) > 0 {
+		w.buf.Write(opts)
+		w.buf.Write([]byte{0, 0, 0, 0}) // opt_endofopt
+	}
+	binary.Write(&w.buf, binary.LittleEndian, uint32(total))
+
+	_, err := w.w.Write(w.buf.Bytes())
+	return err
+}
+
+// appendOption appends an option padded to 32 bits.
+func appendOption(b *bytes.Buffer, code uint16, value []byte) {
+	binary.Write(b, binary.LittleEndian, [2]uint16{code, uint16(len(value))})
+	b.Write(value)
+	b.Write(make([]byte, pad4(len(value))))
+}
+
+// pad4 returns the padding needed to align n to 32 bits.
+func pad4(n int) int {
+	return (4 - n%4) % 4
+}
+
+// Reader reads the packets of a pcapng stream, in either byte order.
+type Reader struct {
+	r      io.Reader
+	order  binary.ByteOrder
+	ifaces []Interface
+}
+
+// NewReader creates a reader, and reads the first section header.
+func NewReader(r io.Reader) (*Reader, error) {
+	rd := &Reader{r: r}
+	typ, _, err := rd.readBlock()
+	if err != nil {
+		return nil, err
+	}
+	if typ != blockSectionHeader {
+		return nil, errors.New("not a pcapng stream")
+	}
+	return rd, nil
+}
+
+// Interface returns the description of an interface of the current section.
+func (r *Reader) Interface(id uint32) (Interface, bool) {
+	if int(id) >= len(r.ifaces) {
+		return Interface{}, false
+	}
+	return r.ifaces[id], true
+}
+
+// Next returns the next packet. It returns io.EOF at the end of the stream.
+func (r *Reader) Next() (Packet, error) {
+	for {
+		typ, body, err := r.readBlock()
+		if err != nil {
+			return Packet{}, err
+		}
+
+		switch typ {
+		case blockSectionHeader:
+			r.ifaces = nil
+		case blockInterfaceDescription:
+			if len(body) < 8 {
+				return Packet{}, errors.New("interface description block is too short")
+			}
+			r.ifaces = append(r.ifaces, r.parseInterface(body))
+		case blockEnhancedPacket:
+			return r.parsePacket(body)
+		}
+	}
+}
+
+// readBlock reads a block, and returns its type and body without the trailing length.
+// The byte order is set by each section header.
+func (r *Reader) readBlock() (uint32, []byte, error) {
+	var hdr [8]byte
+	if _, err := io.ReadFull(r.r, hdr[:]); err != nil {
+		return 0, nil, err
+	}
+
+	// The byte order magic follows the header of a section header block
+	if binary.LittleEndian.Uint32(hdr[0:]) == blockSectionHeader {
+		var magic [4]byte
+		if _, err := io.ReadFull(r.r, magic[:]); err != nil {
+			return 0, nil, io.ErrUnexpectedEOF
+		}
+		switch {
+		case binary.LittleEndian.Uint32(magic[:]) == byteOrderMagic:
+			r.order = binary.LittleEndian
+		case binary.BigEndian.Uint32(magic[:]) == byteOrderMagic:
+			r.order = binary.BigEndian
+		default:
+			return 0, nil, errors.New("invalid byte order magic")
+		}
+
+		total := r.order.Uint32(hdr[4:])
+		if total < 28 || total%4 != 0 {
+			return 0, nil, fmt.Errorf("invalid block length %d", total)
+		}
+		rest := make([]byte, total-12)
+		if _, err := io.ReadFull(r.r, rest); err != nil {
+			return 0, nil, io.ErrUnexpectedEOF
+		}
+		return blockSectionHeader, append(magic[:], rest[:len(rest)-4]...), nil
+	}
+
+	if r.order == nil {
+		return 0, nil, errors.New("missing section header")
+	}
+
+	total := r.order.Uint32(hdr[4:])
+	if total < 12 || total%4 != 0 {
+		return 0, nil, fmt.Errorf("invalid block length %d", total)
+	}
+	rest := make([]byte, total-8)
+	if _, err := io.ReadFull(r.r, rest); err != nil {
+		return 0, nil, io.ErrUnexpectedEOF
+	}
+	return r.order.Uint32(hdr[0:]), rest[:len(rest)-4], nil
+}
+
+// parseInterface parses the body of an interface description block.
+func (r *Reader) parseInterface(body []byte) Interface {
+	iface := Interface{
+		LinkType: r.order.Uint16(body[0:]),
+		tsUnits:  1e6,
+	}
+
+	r.forEachOption(body[8:], func(code uint16, value []byte) {
+		switch code {
+		case optIfName:
+			iface.Name = string(value)
+		case optIfDesc:
+			iface.Description = string(value)
+		case optIfTsResol:
+			if len(value) == 1 {
+				exp := uint64(value[0] & 0x7f)
+				base := uint64(10)
+				if value[0]&0x80 != 0 {
+					base = 2
+				}
+				iface.tsUnits = 1
+				for range exp {
+					iface.tsUnits *= base
+				}
+			}
+		}
+	})
+	return iface
+}
+
+// parsePacket parses the body of an enhanced packet block.
+func (r *Reader) parsePacket(body []byte) (Packet, error) {
+	if len(body) < 20 {
+		return Packet{}, errors.New("enhanced packet block is too short")
+	}
+
+	pkt := Packet{Interface: r.order.Uint32(body[0:])}
+	iface, ok := r.Interface(pkt.Interface)
+	if !ok {
+		return Packet{}, fmt.Errorf("unknown interface %d", pkt.Interface)
+	}
+
+	ts := uint64(r.order.Uint32(body[4:]))<<32 | uint64(r.order.Uint32(body[8:]))
+	sec, frac := ts/iface.tsUnits, ts%iface.tsUnits
+	pkt.Time = time.Unix(int64(sec), int64(frac*1e9/iface.tsUnits))
+
+	capLen := int(r.order.Uint32(body[12:]))
+	end := 20 + capLen
+	if end > len(body) {
+		return Packet{}, errors.New("captured length exceeds block")
+	}
+	pkt.Data = body[20:end]
+
+	r.forEachOption(body[end+pad4(capLen):], func(code uint16, value []byte) {
+		if code == optEpbFlags && len(value) == 4 {
+			pkt.Direction = Direction(r.order.Uint32(value) & 0x3)
+		}
+	})
+	return pkt, nil
+}
+
+// forEachOption calls fn for each option until the end of options.
+func (r *Reader) forEachOption(opts []byte, fn func(code uint16, value []byte)) {
+	for len(opts) >= 4 {
+		code, length := r.order.Uint16(opts[0:]), int(r.order.Uint16(opts[2:]))
+		if code == optEndOfOpt || 4+length > len(opts) {
+			return
+		}
+		fn(code, opts[4:4+length])
+		opts = opts[min(len(opts), 4+length+pad4(length)):]
+	}
+}
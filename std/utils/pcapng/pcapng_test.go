@@ -0,0 +1,77 @@
+package pcapng_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/named-data/ndnd/std/utils/pcapng"
+	tu "github.com/named-data/ndnd/std/utils/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteRead(t *testing.T) {
+	tu.SetT(t)
+
+	var buf bytes.Buffer
+	w := tu.NoErr(pcapng.NewWriter(&buf))
+
+	id0 := tu.NoErr(w.AddInterface(pcapng.Interface{
+		LinkType:    pcapng.LinkTypeEthernet,
+		Name:        "face 1",
+		Description: "udp4://127.0.0.1:6363",
+	}))
+	id1 := tu.NoErr(w.AddInterface(pcapng.Interface{LinkType: pcapng.LinkTypeEthernet}))
+	require.Equal(t, uint32(0), id0)
+	require.Equal(t, uint32(1), id1)
+
+	ts := time.Unix(1700000000, 123456789)
+	require.NoError(t, w.WritePacket(pcapng.Packet{
+		Interface: id0,
+		Time:      ts,
+		Direction: pcapng.DirectionInbound,
+		Data:      []byte{0x05, 0x03, 0x07, 0x01, 0x08},
+	}))
+	require.NoError(t, w.WritePacket(pcapng.Packet{
+		Interface: id1,
+		Time:      ts.Add(time.Second),
+		Direction: pcapng.DirectionOutbound,
+		Data:      []byte{0x06, 0x00, 0x01, 0x02},
+	}))
+	require.Error(t, w.WritePacket(pcapng.Packet{Interface: 2}))
+	require.Zero(t, buf.Len()%4)
+
+	r := tu.NoErr(pcapng.NewReader(&buf))
+
+	pkt := tu.NoErr(r.Next())
+	require.Equal(t, id0, pkt.Interface)
+	require.True(t, ts.Equal(pkt.Time))
+	require.Equal(t, pcapng.DirectionInbound, pkt.Direction)
+	require.Equal(t, []byte{0x05, 0x03, 0x07, 0x01, 0x08}, pkt.Data)
+
+	iface, ok := r.Interface(pkt.Interface)
+	require.True(t, ok)
+	require.Equal(t, uint16(pcapng.LinkTypeEthernet), iface.LinkType)
+	require.Equal(t, "face 1", iface.Name)
+	require.Equal(t, "udp4://127.0.0.1:6363", iface.Description)
+
+	pkt = tu.NoErr(r.Next())
+	require.Equal(t, id1, pkt.Interface)
+	require.True(t, ts.Add(time.Second).Equal(pkt.Time))
+	require.Equal(t, pcapng.DirectionOutbound, pkt.Direction)
+	require.Equal(t, []byte{0x06, 0x00, 0x01, 0x02}, pkt.Data)
+
+	_, err := r.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestReadInvalid(t *testing.T) {
+	tu.SetT(t)
+
+	_, err := pcapng.NewReader(bytes.NewReader([]byte{1, 0, 0, 0, 12, 0, 0, 0, 12, 0, 0, 0}))
+	require.Error(t, err)
+
+	_, err = pcapng.NewReader(bytes.NewReader(nil))
+	require.Error(t, err)
+}
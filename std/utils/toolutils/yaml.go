@@ -9,16 +9,23 @@ import (
 
 // (AI GENERATED DESCRIPTION): Parses a YAML file into the supplied destination object using strict decoding, terminating the program with an error message if the file cannot be opened or parsed.
 func ReadYaml(dest any, file string) {
+	if err := LoadYaml(dest, file); err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(3)
+	}
+}
+
+// LoadYaml parses a YAML file into the supplied destination object using strict decoding.
+func LoadYaml(dest any, file string) error {
 	f, err := os.Open(file)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to open configuration file: %+v\n", err)
-		os.Exit(3)
+		return fmt.Errorf("unable to open configuration file: %w", err)
 	}
 	defer f.Close()
 
 	dec := yaml.NewDecoder(f, yaml.Strict())
 	if err = dec.Decode(dest); err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to parse configuration file: %+v\n", err)
-		os.Exit(3)
+		return fmt.Errorf("unable to parse configuration file: %w", err)
 	}
+	return nil
 }
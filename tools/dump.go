@@ -0,0 +1,374 @@
+package tools
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"runtime"
+	"strings"
+	"syscall"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/log"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	"github.com/named-data/ndnd/std/utils/pcapng"
+	"github.com/spf13/cobra"
+)
+
+// Dump prints the packets captured by the forwarder, or writes them to a pcapng file.
+type Dump struct {
+	socket string
+	faces  []uint
+	write  string
+	read   string
+}
+
+// dumpFilter is the capture filter sent to the forwarder.
+type dumpFilter struct {
+	Faces    []uint64 `json:"faces"`
+	Prefixes []string `json:"prefixes"`
+}
+
+// dumpNackReasons are the names of the Nack reasons.
+var dumpNackReasons = map[uint64]string{
+	0:   "None",
+	50:  "Congestion",
+	100: "Duplicate",
+	150: "NoRoute",
+}
+
+// CmdDump creates the dump command.
+func CmdDump() *cobra.Command {
+	d := Dump{}
+
+	socket := "/run/nfd/capture.sock"
+	if runtime.GOOS == "darwin" {
+		socket = "/var/run/nfd/capture.sock"
+	}
+
+	cmd := &cobra.Command{
+		GroupID: "tools",
+		Use:     "dump [PREFIX...]",
+		Short:   "Print the packets crossing the forwarder faces",
+		Long: `Print the Interests, Data, Nacks and LP headers crossing the faces
+of the local forwarder, optionally only those under the given prefixes.
+Packet capture must be enabled in the forwarder configuration.`,
+		Example: `  ndnd dump /my/prefix
+  ndnd dump --face 262 -w capture.pcapng
+  ndnd dump -w - | wireshark -k -i -`,
+		Run: d.run,
+	}
+
+	cmd.Flags().StringVarP(&d.socket, "socket", "s", socket, "Unix socket path of the forwarder packet capture")
+	cmd.Flags().UintSliceVarP(&d.faces, "face", "f", nil, "capture only the given face IDs")
+	cmd.Flags().StringVarP(&d.write, "write", "w", "", "write a pcapng file instead of printing (- for stdout)")
+	cmd.Flags().StringVarP(&d.read, "read", "r", "", "print the packets of a pcapng file instead of capturing")
+	return cmd
+}
+
+// String returns the log identifier of the dump tool.
+func (d *Dump) String() string {
+	return "dump"
+}
+
+// run captures packets until interrupted, or reads a capture file.
+func (d *Dump) run(_ *cobra.Command, args []string) {
+	for _, prefix := range args {
+		if _, err := enc.NameFromStr(prefix); err != nil {
+			log.Fatal(d, "Invalid prefix", "prefix", prefix, "err", err)
+			return
+		}
+	}
+
+	if d.read != "" {
+		file, err := os.Open(d.read)
+		if err != nil {
+			log.Fatal(d, "Unable to open capture file", "err", err)
+			return
+		}
+		defer file.Close()
+		d.print(file, args)
+		return
+	}
+
+	conn, err := net.Dial("unix", d.socket)
+	if err != nil {
+		log.Fatal(d, "Unable to connect to forwarder packet capture", "socket", d.socket, "err", err)
+		return
+	}
+	defer conn.Close()
+
+	filter := dumpFilter{Prefixes: args}
+	for _, face := range d.faces {
+		filter.Faces = append(filter.Faces, uint64(face))
+	}
+	filterJson, _ := json.Marshal(filter)
+	if _, err := conn.Write(append(filterJson, '\n')); err != nil {
+		log.Fatal(d, "Unable to send capture filter", "err", err)
+		return
+	}
+
+	// Stop on interrupt
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigchan
+		conn.Close()
+	}()
+
+	if d.write != "" {
+		d.save(conn)
+	} else {
+		d.print(conn, nil)
+	}
+}
+
+// save copies the capture stream to the output file.
+func (d *Dump) save(stream io.Reader) {
+	out := os.Stdout
+	if d.write != "-" {
+		file, err := os.Create(d.write)
+		if err != nil {
+			log.Fatal(d, "Unable to create capture file", "err", err)
+			return
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if _, err := io.Copy(out, stream); err != nil && !errors.Is(err, net.ErrClosed) {
+		log.Error(d, "Capture stopped", "err", err)
+	}
+}
+
+// print prints each packet of a capture stream. If prefixes are given,
+// only the packets under them are printed.
+func (d *Dump) print(stream io.Reader, prefixes []string) {
+	r, err := pcapng.NewReader(bufio.NewReader(stream))
+	if err != nil {
+		if !errors.Is(err, net.ErrClosed) {
+			log.Fatal(d, "Unable to read capture", "err", err)
+		}
+		return
+	}
+
+	names := make([]enc.Name, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		name, _ := enc.NameFromStr(prefix)
+		names = append(names, name)
+	}
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+
+	for {
+		pkt, err := r.Next()
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				log.Error(d, "Unable to read capture", "err", err)
+			}
+			return
+		}
+
+		iface, _ := r.Interface(pkt.Interface)
+		frame := pkt.Data
+		if iface.LinkType == pcapng.LinkTypeEthernet {
+			if len(frame) < 14 || binary.BigEndian.Uint16(frame[12:]) != pcapng.EtherTypeNDN {
+				continue
+			}
+			frame = frame[14:]
+		}
+
+		line, name := dumpFrame(frame)
+		if len(names) > 0 && !dumpMatches(names, name) {
+			continue
+		}
+
+		source := iface.Name
+		if source == "" {
+			source = fmt.Sprintf("if %d", pkt.Interface)
+		}
+		fmt.Fprintf(out, "%s %s %-3s %s\n", pkt.Time.Format("15:04:05.000000"), source, pkt.Direction, line)
+
+		// Print as packets arrive when capturing live
+		if d.read == "" {
+			out.Flush()
+		}
+	}
+}
+
+// dumpMatches returns whether the name is under any of the prefixes.
+func dumpMatches(prefixes []enc.Name, name enc.Name) bool {
+	for _, prefix := range prefixes {
+		if name != nil && prefix.IsPrefix(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// dumpFrame describes an LP frame or a bare network packet in one line,
+// and returns the name of the packet if any.
+func dumpFrame(frame []byte) (string, enc.Name) {
+	ctx := spec.PacketParsingContext{}
+	ctx.Init()
+	pkt, err := ctx.Parse(enc.NewBufferView(frame), false)
+	if err != nil {
+		return fmt.Sprintf("MALFORMED %d bytes: %s", len(frame), err), nil
+	}
+
+	lp := pkt.LpPacket
+	if lp == nil {
+		return dumpPacket(pkt, false)
+	}
+
+	var sb strings.Builder
+	var name enc.Name
+	fragIndex, fragCount := lp.FragIndex.GetOr(0), lp.FragCount.GetOr(1)
+
+	switch {
+	case len(lp.Fragment) == 0:
+		sb.WriteString("IDLE")
+	case fragCount > 1:
+		fmt.Fprintf(&sb, "FRAGMENT %d/%d %d bytes", fragIndex+1, fragCount, lp.Fragment.Length())
+	default:
+		innerCtx := spec.PacketParsingContext{}
+		innerCtx.Init()
+		inner, err := innerCtx.Parse(enc.NewWireView(lp.Fragment), false)
+		if err != nil {
+			fmt.Fprintf(&sb, "MALFORMED fragment: %s", err)
+			break
+		}
+		var line string
+		line, name = dumpPacket(inner, lp.Nack != nil)
+		sb.WriteString(line)
+	}
+
+	// LP header fields
+	fields := make([]string, 0, 8)
+	if lp.Nack != nil {
+		reason, ok := dumpNackReasons[lp.Nack.Reason]
+		if !ok {
+			reason = fmt.Sprint(lp.Nack.Reason)
+		}
+		fields = append(fields, "nack="+reason)
+	}
+	if len(lp.PitToken) > 0 {
+		fields = append(fields, "pit-token="+hex.EncodeToString(lp.PitToken))
+	}
+	if v, ok := lp.CongestionMark.Get(); ok {
+		fields = append(fields, fmt.Sprintf("congestion-mark=%d", v))
+	}
+	if v, ok := lp.Sequence.Get(); ok {
+		fields = append(fields, fmt.Sprintf("seq=%d", v))
+	}
+	if v, ok := lp.TxSequence.Get(); ok {
+		fields = append(fields, fmt.Sprintf("tx-seq=%d", v))
+	}
+	if v, ok := lp.Ack.Get(); ok {
+		fields = append(fields, fmt.Sprintf("ack=%d", v))
+	}
+	if v, ok := lp.IncomingFaceId.Get(); ok {
+		fields = append(fields, fmt.Sprintf("incoming-face=%d", v))
+	}
+	if v, ok := lp.NextHopFaceId.Get(); ok {
+		fields = append(fields, fmt.Sprintf("next-hop-face=%d", v))
+	}
+	if lp.CachePolicy != nil {
+		fields = append(fields, fmt.Sprintf("cache-policy=%d", lp.CachePolicy.CachePolicyType))
+	}
+	if lp.PrefixAnnouncement != nil {
+		fields = append(fields, "prefix-announcement")
+	}
+	if len(fields) > 0 {
+		fmt.Fprintf(&sb, " lp[%s]", strings.Join(fields, " "))
+	}
+
+	return sb.String(), name
+}
+
+// dumpPacket describes an Interest, Data or Nack in one line, and returns its name.
+func dumpPacket(pkt *spec.Packet, nack bool) (string, enc.Name) {
+	var sb strings.Builder
+
+	switch {
+	case pkt.Interest != nil:
+		interest := pkt.Interest
+		if nack {
+			sb.WriteString("NACK ")
+		} else {
+			sb.WriteString("INTEREST ")
+		}
+		sb.WriteString(interest.NameV.String())
+		if interest.CanBePrefixV {
+			sb.WriteString(" CanBePrefix")
+		}
+		if interest.MustBeFreshV {
+			sb.WriteString(" MustBeFresh")
+		}
+		if v, ok := interest.NonceV.Get(); ok {
+			fmt.Fprintf(&sb, " nonce=%08x", v)
+		}
+		if v, ok := interest.InterestLifetimeV.Get(); ok {
+			fmt.Fprintf(&sb, " lifetime=%s", v)
+		}
+		if interest.HopLimitV != nil {
+			fmt.Fprintf(&sb, " hop-limit=%d", *interest.HopLimitV)
+		}
+		if interest.ForwardingHintV != nil {
+			hints := make([]string, 0, len(interest.ForwardingHintV.Names))
+			for _, hint := range interest.ForwardingHintV.Names {
+				hints = append(hints, hint.String())
+			}
+			fmt.Fprintf(&sb, " hint=%s", strings.Join(hints, ","))
+		}
+		if interest.ApplicationParameters != nil {
+			fmt.Fprintf(&sb, " params=%dB", interest.ApplicationParameters.Length())
+		}
+		dumpSignature(&sb, interest.SignatureInfo)
+		return sb.String(), interest.NameV
+
+	case pkt.Data != nil:
+		data := pkt.Data
+		sb.WriteString("DATA ")
+		sb.WriteString(data.NameV.String())
+		if data.MetaInfo != nil {
+			if v, ok := data.MetaInfo.ContentType.Get(); ok {
+				fmt.Fprintf(&sb, " type=%d", v)
+			}
+			if v, ok := data.MetaInfo.FreshnessPeriod.Get(); ok {
+				fmt.Fprintf(&sb, " freshness=%s", v)
+			}
+			if data.MetaInfo.FinalBlockID != nil {
+				if c, err := enc.ComponentFromBytes(data.MetaInfo.FinalBlockID); err == nil {
+					fmt.Fprintf(&sb, " final-block=%s", c)
+				}
+			}
+		}
+		fmt.Fprintf(&sb, " content=%dB", data.ContentV.Length())
+		dumpSignature(&sb, data.SignatureInfo)
+		return sb.String(), data.NameV
+
+	default:
+		return "UNKNOWN", nil
+	}
+}
+
+// dumpSignature describes the signature type and key locator.
+func dumpSignature(sb *strings.Builder, info *spec.SignatureInfo) {
+	if info == nil {
+		return
+	}
+	fmt.Fprintf(sb, " sig=%s", ndn.SigType(info.SignatureType))
+	if info.KeyLocator != nil && info.KeyLocator.Name != nil {
+		fmt.Fprintf(sb, " key=%s", info.KeyLocator.Name)
+	}
+}
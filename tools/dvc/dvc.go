@@ -18,6 +18,26 @@ func Cmds() []*cobra.Command {
 		Short: "Print general status of the router",
 		Args:  cobra.NoArgs,
 		Run:   t.RunDvStatus,
+	}, {
+		Use:   "rib-list",
+		Short: "List reachable routers and their next hops",
+		Args:  cobra.NoArgs,
+		Run:   t.RunDvRibList,
+	}, {
+		Use:   "prefix-list",
+		Short: "List prefixes announced by each exit router",
+		Args:  cobra.NoArgs,
+		Run:   t.RunDvPrefixList,
+	}, {
+		Use:   "neighbor-list",
+		Short: "List neighbors and their liveness",
+		Args:  cobra.NoArgs,
+		Run:   t.RunDvNeighborList,
+	}, {
+		Use:   "fib-list",
+		Short: "List next hops installed in the forwarder",
+		Args:  cobra.NoArgs,
+		Run:   t.RunDvFibList,
 	}, {
 		Use:   "link-create NEIGHBOR-URI",
 		Short: "Create a new active neighbor link",
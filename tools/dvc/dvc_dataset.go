@@ -0,0 +1,142 @@
+package dvc
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	spec_dv "github.com/named-data/ndnd/dv/tlv"
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/object"
+	"github.com/spf13/cobra"
+)
+
+// fetchDataset fetches the latest version of a router dataset under /localhost/nlsr.
+func (t *Tool) fetchDataset(module string) (enc.Wire, error) {
+	// consume-only client, no need for a store
+	client := object.NewClient(t.engine, nil, nil)
+	client.Start()
+	defer client.Stop()
+
+	name := enc.Name{
+		enc.LOCALHOST,
+		enc.NewGenericComponent("nlsr"),
+		enc.NewGenericComponent(module),
+		enc.NewGenericComponent("list"),
+	}
+
+	ch := make(chan ndn.ConsumeState)
+	client.ConsumeExt(ndn.ConsumeExtArgs{
+		Name:       name,
+		NoMetadata: true,
+		Callback:   func(status ndn.ConsumeState) { ch <- status },
+	})
+
+	state := <-ch
+	if err := state.Error(); err != nil {
+		return nil, err
+	}
+
+	return state.Content(), nil
+}
+
+// mustFetchDataset fetches a router dataset, and exits on failure.
+func (t *Tool) mustFetchDataset(module string) enc.Wire {
+	data, err := t.fetchDataset(module)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching %s dataset: %+v\n", module, err)
+		os.Exit(1)
+	}
+	return data
+}
+
+// RunDvRibList prints the reachable routers, with the loop-free next hops to each.
+func (t *Tool) RunDvRibList(_ *cobra.Command, args []string) {
+	t.Start()
+	defer t.Stop()
+
+	status, err := spec_dv.ParseRibStatus(enc.NewWireView(t.mustFetchDataset("rib")), true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing RIB status: %+v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("RIB:")
+	for _, entry := range status.Entries {
+		nexthops := make([]string, 0, len(entry.NextHops))
+		for _, hop := range entry.NextHops {
+			nexthops = append(nexthops, fmt.Sprintf("%s faceid=%d (cost=%d)", hop.Neighbor, hop.FaceId, hop.Cost))
+		}
+		fmt.Printf("  %s cost=%d nexthops={%s}\n", entry.Name, entry.Cost, strings.Join(nexthops, ", "))
+	}
+}
+
+// RunDvPrefixList prints the prefixes announced by each exit router.
+func (t *Tool) RunDvPrefixList(_ *cobra.Command, args []string) {
+	t.Start()
+	defer t.Stop()
+
+	status, err := spec_dv.ParsePrefixStatus(enc.NewWireView(t.mustFetchDataset("prefix")), true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing prefix status: %+v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Prefixes:")
+	for _, router := range status.Routers {
+		fmt.Printf("  %s\n", router.ExitRouter)
+		for _, entry := range router.Prefixes {
+			fmt.Printf("    %s cost=%d\n", entry.Name, entry.Cost)
+		}
+	}
+}
+
+// RunDvNeighborList prints the neighbors with their liveness and latest advertisement.
+func (t *Tool) RunDvNeighborList(_ *cobra.Command, args []string) {
+	t.Start()
+	defer t.Stop()
+
+	status, err := spec_dv.ParseNeighborsStatus(enc.NewWireView(t.mustFetchDataset("neighbor")), true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing neighbor status: %+v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("Neighbors:")
+	for _, neighbor := range status.Neighbors {
+		state := "dead"
+		if neighbor.Alive {
+			state = "alive"
+		}
+		srtt := "unknown"
+		if us, ok := neighbor.Srtt_us.Get(); ok {
+			srtt = (time.Duration(us) * time.Microsecond).String()
+		}
+		lastSeen := (time.Duration(neighbor.LastSeen_ms) * time.Millisecond).String()
+		fmt.Printf("  %s state=%s faceid=%d cost=%d srtt=%s seq=%d last-seen=%s\n",
+			neighbor.Name, state, neighbor.FaceId, neighbor.Cost, srtt, neighbor.AdvertSeq, lastSeen)
+	}
+}
+
+// RunDvFibList prints the next hops installed in the forwarder for each prefix.
+func (t *Tool) RunDvFibList(_ *cobra.Command, args []string) {
+	t.Start()
+	defer t.Stop()
+
+	status, err := spec_dv.ParseFibStatus(enc.NewWireView(t.mustFetchDataset("fib")), true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing FIB status: %+v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Println("FIB:")
+	for _, entry := range status.Entries {
+		nexthops := make([]string, 0, len(entry.NextHops))
+		for _, hop := range entry.NextHops {
+			nexthops = append(nexthops, fmt.Sprintf("faceid=%d (cost=%d)", hop.FaceId, hop.Cost))
+		}
+		fmt.Printf("  %s nexthops={%s}\n", entry.Name, strings.Join(nexthops, ", "))
+	}
+}
@@ -51,7 +51,7 @@ func (t *Tool) DvStatus() (*spec_dv.Status, error) {
 	return status, nil
 }
 
-// (AI GENERATED DESCRIPTION): Retrieves the DV router status and prints general status metrics to stdout.
+// RunDvStatus prints the general status of the router, and the neighbors with the cost of their links.
 func (t *Tool) RunDvStatus(_ *cobra.Command, args []string) {
 	t.Start()
 	defer t.Stop()
@@ -67,7 +67,21 @@ func (t *Tool) RunDvStatus(_ *cobra.Command, args []string) {
 	p.Print("version", status.Version)
 	p.Print("routerName", status.RouterName.Name)
 	p.Print("networkName", status.NetworkName.Name)
+	if status.AreaName != nil {
+		p.Print("areaName", status.AreaName.Name)
+	}
 	p.Print("nRibEntries", status.NRibEntries)
 	p.Print("nNeighbors", status.NNeighbors)
 	p.Print("nFibEntries", status.NFibEntries)
+	p.Print("costInfinity", status.CostInfinity)
+
+	fmt.Println()
+	fmt.Println("Neighbors:")
+	for _, neighbor := range status.Neighbors {
+		srtt := "unknown"
+		if us, ok := neighbor.Srtt_us.Get(); ok {
+			srtt = (time.Duration(us) * time.Microsecond).String()
+		}
+		fmt.Printf("  %s faceid=%d cost=%d srtt=%s\n", neighbor.Name, neighbor.FaceId, neighbor.Cost, srtt)
+	}
 }
@@ -7,6 +7,8 @@ import (
 	enc "github.com/named-data/ndnd/std/encoding"
 	"github.com/named-data/ndnd/std/engine"
 	"github.com/named-data/ndnd/std/ndn"
+	"github.com/named-data/ndnd/std/object/storage"
+	"github.com/named-data/ndnd/std/security/keychain"
 	"github.com/spf13/cobra"
 )
 
@@ -36,11 +38,21 @@ func Cmds() []*cobra.Command {
 		Run: cmd("faces", "create", []string{
 			"persistency=persistent",
 		}),
+	}, {
+		Use:   "face-update [params]",
+		Short: "Update a face",
+		Args:  cobra.ArbitraryArgs,
+		Run:   cmd("faces", "update", []string{}),
 	}, {
 		Use:   "face-destroy [params]",
 		Short: "Destroy a face",
 		Args:  cobra.ArbitraryArgs,
 		Run:   cmd("faces", "destroy", []string{}),
+	}, {
+		Use:   "face-events",
+		Short: "Print face events as they happen",
+		Args:  cobra.NoArgs,
+		Run:   t.ExecFaceEvents,
 	}, {
 		Use:   "route-list",
 		Short: "Print RIB routes",
@@ -70,6 +82,26 @@ func Cmds() []*cobra.Command {
 		Short: "Print content store info",
 		Args:  cobra.NoArgs,
 		Run:   t.ExecCsInfo,
+	}, {
+		Use:   "cs-config [params]",
+		Short: "Change content store configuration",
+		Args:  cobra.ArbitraryArgs,
+		Run:   cmd("cs", "config", []string{}),
+	}, {
+		Use:   "cs-list [params]",
+		Short: "Print cached Data in the content store",
+		Args:  cobra.ArbitraryArgs,
+		Run:   t.ExecCsList,
+	}, {
+		Use:   "cs-erase [params]",
+		Short: "Erase cached Data from the content store",
+		Args:  cobra.ArbitraryArgs,
+		Run:   cmd("cs", "erase", []string{}),
+	}, {
+		Use:   "measurements-list",
+		Short: "Print strategy measurements",
+		Args:  cobra.NoArgs,
+		Run:   t.ExecMeasurementsList,
 	}, {
 		Use:   "strategy-list",
 		Short: "Print strategy choices",
@@ -99,6 +131,12 @@ func (t *Tool) Start() {
 	}
 
 	t.engine = engine.NewBasicEngine(engine.NewDefaultFace())
+	if err := t.configureCmdSigner(); err != nil {
+		fmt.Fprintf(os.Stderr, "Unable to configure command signer: %+v\n", err)
+		os.Exit(1)
+		return
+	}
+
 	err := t.engine.Start()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Unable to start engine: %+v\n", err)
@@ -107,6 +145,48 @@ func (t *Tool) Start() {
 	}
 }
 
+// configureCmdSigner signs control commands with a key of the keychain in the
+// NDN_CLIENT_KEYCHAIN environment variable (e.g. dir:///etc/ndn/keys).
+// The identity is selected by NDN_CLIENT_IDENTITY, or is the only identity of the keychain.
+// The responses must then be signed by a key trusted by the same anchor, see newCmdValidator.
+func (t *Tool) configureCmdSigner() error {
+	uri := os.Getenv("NDN_CLIENT_KEYCHAIN")
+	if uri == "" {
+		return nil
+	}
+
+	store := storage.NewMemoryStore()
+	kc, err := keychain.NewKeyChain(uri, store)
+	if err != nil {
+		return err
+	}
+
+	var identity ndn.KeyChainIdentity
+	if idStr := os.Getenv("NDN_CLIENT_IDENTITY"); idStr != "" {
+		idName, err := enc.NameFromStr(idStr)
+		if err != nil {
+			return err
+		}
+		identity = kc.IdentityByName(idName)
+	} else if ids := kc.Identities(); len(ids) == 1 {
+		identity = ids[0]
+	} else {
+		return fmt.Errorf("keychain has %d identities, set NDN_CLIENT_IDENTITY", len(ids))
+	}
+	if identity == nil || len(identity.Keys()) == 0 {
+		return fmt.Errorf("no key for identity in keychain")
+	}
+
+	key := identity.Keys()[0]
+	validator, err := newCmdValidator(store, key)
+	if err != nil {
+		return err
+	}
+
+	t.engine.SetCmdSec(key.Signer(), validator.validate)
+	return nil
+}
+
 // (AI GENERATED DESCRIPTION): Stops the tool by invoking its underlying engine’s `Stop` method, terminating network activity.
 func (t *Tool) Stop() {
 	t.engine.Stop()
@@ -66,7 +66,7 @@ func (n *Tool) preprocessArg(
 	if key == "face" && strings.Contains(val, "://") {
 		// query the existing face (without attempting to create a new one)
 		// for faces/create, we require specifying "remote" and/or "local" instead
-		if (mod == "faces" && cmd == "destroy") ||
+		if (mod == "faces" && (cmd == "destroy" || cmd == "update")) ||
 			(mod == "rib" && cmd == "unregister") {
 
 			filter := mgmt.FaceQueryFilter{
@@ -166,6 +166,21 @@ func (n *Tool) convCmdArg(ctrlArgs *mgmt.ControlArgs, key string, val string) {
 		return name
 	}
 
+	// helper function to set a face flag from on/off values
+	setFlag := func(flag uint64, val string) {
+		ctrlArgs.Mask = optional.Some(ctrlArgs.Mask.GetOr(0) | flag)
+		flags := ctrlArgs.Flags.GetOr(0)
+		switch val {
+		case "on", "true":
+			ctrlArgs.Flags = optional.Some(flags | flag)
+		case "off", "false":
+			ctrlArgs.Flags = optional.Some(flags &^ flag)
+		default:
+			fmt.Fprintf(os.Stderr, "Invalid value for %s: %s (should be on or off)\n", key, val)
+			os.Exit(9)
+		}
+	}
+
 	// convert key-value pairs to command arguments
 	switch key {
 	// face arguments
@@ -184,6 +199,8 @@ func (n *Tool) convCmdArg(ctrlArgs *mgmt.ControlArgs, key string, val string) {
 			os.Exit(9)
 		}
 		ctrlArgs.FacePersistency = optional.Some(uint64(persistency))
+	case "reliability":
+		setFlag(mgmt.FaceFlagLpReliabilityEnabled, val)
 
 	// route arguments
 	case "prefix":
@@ -195,6 +212,18 @@ func (n *Tool) convCmdArg(ctrlArgs *mgmt.ControlArgs, key string, val string) {
 	case "expires":
 		ctrlArgs.ExpirationPeriod = optional.Some(parseUint(val))
 
+	// content store arguments
+	case "count":
+		ctrlArgs.Count = optional.Some(parseUint(val))
+	case "capacity":
+		ctrlArgs.Capacity = optional.Some(parseUint(val))
+	case "capacity_bytes":
+		ctrlArgs.CapacityBytes = optional.Some(parseUint(val))
+	case "admit":
+		setFlag(mgmt.CsEnableAdmit, val)
+	case "serve":
+		setFlag(mgmt.CsEnableServe, val)
+
 	// strategy arguments
 	case "strategy":
 		ctrlArgs.Strategy = &mgmt.Strategy{Name: parseName(val)}
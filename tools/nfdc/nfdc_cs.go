@@ -3,6 +3,8 @@ package nfdc
 import (
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	enc "github.com/named-data/ndnd/std/encoding"
 	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
@@ -36,12 +38,78 @@ func (t *Tool) ExecCsInfo(_ *cobra.Command, args []string) {
 
 	info := status.CsInfo
 
-	p := toolutils.StatusPrinter{File: os.Stdout, Padding: 10}
+	p := toolutils.StatusPrinter{File: os.Stdout, Padding: 13}
 	fmt.Println("CS information:")
 	p.Print("capacity", info.Capacity)
+	if capacityBytes, ok := info.CapacityBytes.Get(); ok {
+		p.Print("capacityBytes", capacityBytes)
+	}
 	p.Print("admit", info.Flags&mgmt.CsEnableAdmit != 0)
 	p.Print("serve", info.Flags&mgmt.CsEnableServe != 0)
 	p.Print("nEntries", info.NCsEntries)
+	if nBytes, ok := info.NCsBytes.Get(); ok {
+		p.Print("nBytes", nBytes)
+	}
 	p.Print("nHits", info.NHits)
 	p.Print("nMisses", info.NMisses)
 }
+
+// ExecCsList fetches the CS query dataset and prints the cached Data
+// under an optional prefix, with their size, freshness and owning thread.
+func (t *Tool) ExecCsList(_ *cobra.Command, args []string) {
+	t.Start()
+	defer t.Stop()
+
+	// parse filter arguments
+	ctrlArgs := mgmt.ControlArgs{}
+	for _, arg := range args {
+		kv := strings.SplitN(arg, "=", 2)
+		if len(kv) != 2 || (kv[0] != "prefix" && kv[0] != "count") {
+			fmt.Fprintf(os.Stderr, "Invalid argument: %s (should be prefix=<prefix> or count=<count>)\n", arg)
+			os.Exit(9)
+			return
+		}
+		t.convCmdArg(&ctrlArgs, kv[0], kv[1])
+	}
+
+	filter := mgmt.CsQueryFilter{
+		Val: &mgmt.CsQueryFilterValue{Name: ctrlArgs.Name, Count: ctrlArgs.Count},
+	}
+	if filter.Val.Name == nil {
+		filter.Val.Name = enc.Name{}
+	}
+
+	suffix := enc.Name{
+		enc.NewGenericComponent("cs"),
+		enc.NewGenericComponent("query"),
+		enc.NewGenericBytesComponent(filter.Encode().Join()),
+	}
+
+	data, err := t.fetchStatusDataset(suffix)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching status dataset: %+v\n", err)
+		os.Exit(1)
+		return
+	}
+
+	status, err := mgmt.ParseCsQueryStatus(enc.NewWireView(data), true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing CS entries: %+v\n", err)
+		os.Exit(1)
+		return
+	}
+
+	for _, entry := range status.Entries {
+		info := []string{
+			fmt.Sprintf("name=%s", entry.Name),
+			fmt.Sprintf("thread=%d", entry.ThreadId),
+			fmt.Sprintf("size=%d", entry.PacketSize),
+		}
+		if fresh, ok := entry.FreshnessPeriod.Get(); ok {
+			info = append(info, fmt.Sprintf("fresh=%s", time.Duration(fresh)*time.Millisecond))
+		} else {
+			info = append(info, "stale")
+		}
+		fmt.Println(strings.Join(info, " "))
+	}
+}
@@ -3,7 +3,9 @@ package nfdc
 import (
 	"fmt"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	enc "github.com/named-data/ndnd/std/encoding"
@@ -61,10 +63,47 @@ func (t *Tool) ExecFaceList(_ *cobra.Command, args []string) {
 			entry.NInInterests, entry.NInData, entry.NInNacks, entry.NInBytes,
 			entry.NOutInterests, entry.NOutData, entry.NOutNacks, entry.NOutBytes))
 
+		if acked, ok := entry.NAcknowledged.Get(); ok {
+			info = append(info, fmt.Sprintf("reliability={acked=%d retx=%d exhausted=%d}",
+				acked, entry.NRetransmitted.GetOr(0), entry.NRetxExhausted.GetOr(0)))
+		}
+
 		flags := []string{}
 		flags = append(flags, strings.ToLower(mgmt.Persistency(entry.FacePersistency).String()))
+		if entry.Flags&mgmt.FaceFlagLpReliabilityEnabled != 0 {
+			flags = append(flags, "reliability")
+		}
 		info = append(info, fmt.Sprintf("flags={%s}", strings.Join(flags, " ")))
 
 		fmt.Printf("%s\n", strings.Join(info, " "))
 	}
 }
+
+// ExecFaceEvents subscribes to the face event notification stream and prints
+// each event until interrupted.
+func (t *Tool) ExecFaceEvents(_ *cobra.Command, args []string) {
+	t.Start()
+	defer t.Stop()
+
+	sub := mgmt.SubscribeFaceEvents(t.engine, func(event *mgmt.FaceEventNotificationValue) {
+		kind, ok := mgmt.FaceEventKindList[event.FaceEventKind]
+		if !ok {
+			kind = fmt.Sprintf("unknown(%d)", event.FaceEventKind)
+		}
+
+		info := []string{}
+		info = append(info, fmt.Sprintf("kind=%s", kind))
+		info = append(info, fmt.Sprintf("faceid=%d", event.FaceId))
+		info = append(info, fmt.Sprintf("remote=%s", event.Uri))
+		info = append(info, fmt.Sprintf("local=%s", event.LocalUri))
+		info = append(info, fmt.Sprintf("persistency=%s", mgmt.Persistency(event.FacePersistency)))
+		info = append(info, fmt.Sprintf("flags=%d", event.Flags))
+
+		fmt.Printf("%s %s\n", time.Now().Format(time.RFC3339), strings.Join(info, " "))
+	})
+	defer sub.Stop()
+
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, os.Interrupt, syscall.SIGTERM)
+	<-sigchan
+}
@@ -0,0 +1,61 @@
+package nfdc
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	mgmt "github.com/named-data/ndnd/std/ndn/mgmt_2022"
+	"github.com/spf13/cobra"
+)
+
+// ExecMeasurementsList fetches the Measurements dataset and prints each entry
+// with the per-face RTT estimates and counters recorded by the strategies.
+func (t *Tool) ExecMeasurementsList(_ *cobra.Command, args []string) {
+	t.Start()
+	defer t.Stop()
+
+	suffix := enc.Name{
+		enc.NewGenericComponent("measurements"),
+		enc.NewGenericComponent("list"),
+	}
+
+	data, err := t.fetchStatusDataset(suffix)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching status dataset: %+v\n", err)
+		os.Exit(1)
+		return
+	}
+
+	status, err := mgmt.ParseMeasurementsStatus(enc.NewWireView(data), true)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error parsing measurements: %+v\n", err)
+		os.Exit(1)
+		return
+	}
+
+	for _, entry := range status.Entries {
+		info := []string{
+			fmt.Sprintf("prefix=%s", entry.Name),
+			fmt.Sprintf("thread=%d", entry.ThreadId),
+		}
+		if expiry, ok := entry.ExpirationPeriod.Get(); ok {
+			info = append(info, fmt.Sprintf("expires=%s", time.Duration(expiry)*time.Millisecond))
+		}
+		if face, ok := entry.LastWorkingFace.Get(); ok {
+			info = append(info, fmt.Sprintf("last-working-face=%d", face))
+		}
+
+		faces := make([]string, 0, len(entry.Faces))
+		for _, face := range entry.Faces {
+			faces = append(faces, fmt.Sprintf("faceid=%d (srtt=%s rttvar=%s rto=%s satisfied=%d timeouts=%d)",
+				face.FaceId, time.Duration(face.Srtt), time.Duration(face.RttVar), time.Duration(face.Rto),
+				face.NSatisfied, face.NTimeouts))
+		}
+		info = append(info, fmt.Sprintf("faces={%s}", strings.Join(faces, ", ")))
+
+		fmt.Println(strings.Join(info, " "))
+	}
+}
@@ -0,0 +1,103 @@
+package nfdc
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	enc "github.com/named-data/ndnd/std/encoding"
+	"github.com/named-data/ndnd/std/ndn"
+	spec "github.com/named-data/ndnd/std/ndn/spec_2022"
+	sec "github.com/named-data/ndnd/std/security"
+	"github.com/named-data/ndnd/std/security/signer"
+)
+
+// maxCertChainLength is the maximum number of certificates between a response and the trust anchor.
+const maxCertChainLength = 8
+
+// cmdValidator validates the responses to control commands. The responses must be
+// signed by a key whose certificate chain, found in the keychain, ends at the trust anchor.
+type cmdValidator struct {
+	store  ndn.Store
+	anchor enc.Name
+}
+
+// newCmdValidator creates a validator trusting the anchor in the NDN_CLIENT_TRUST_ANCHOR
+// environment variable, or by default the anchor of the certificate of the signing key.
+func newCmdValidator(store ndn.Store, key ndn.KeyChainKey) (*cmdValidator, error) {
+	v := &cmdValidator{store: store}
+
+	if anchorStr := os.Getenv("NDN_CLIENT_TRUST_ANCHOR"); anchorStr != "" {
+		anchor, err := enc.NameFromStr(anchorStr)
+		if err != nil {
+			return nil, err
+		}
+		v.anchor = anchor
+		return v, nil
+	}
+
+	// Follow the certificate chain of the signing key to its self-signed root
+	cert, _, err := v.findCert(key.KeyName())
+	for i := 0; err == nil; i++ {
+		issuer := cert.Signature().KeyName()
+		if issuer.IsPrefix(cert.Name()) {
+			v.anchor = cert.Name()
+			return v, nil
+		}
+		if i == maxCertChainLength {
+			break
+		}
+		cert, _, err = v.findCert(issuer)
+	}
+	return nil, fmt.Errorf("no trust anchor for %s in keychain, set NDN_CLIENT_TRUST_ANCHOR", key.KeyName())
+}
+
+// validate checks the signature of a response. This is the engine command validator.
+func (v *cmdValidator) validate(name enc.Name, sigCovered enc.Wire, sig ndn.Signature) bool {
+	if err := v.validateChain(sig, sigCovered); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid signature of response %s: %+v\n", name, err)
+		return false
+	}
+	return true
+}
+
+// validateChain checks a signature, and the certificates of the signer up to the trust anchor.
+func (v *cmdValidator) validateChain(sig ndn.Signature, sigCovered enc.Wire) error {
+	for range maxCertChainLength {
+		if sig == nil || len(sig.KeyName()) == 0 {
+			return errors.New("not signed by a key")
+		}
+
+		cert, certSigCovered, err := v.findCert(sig.KeyName())
+		if err != nil {
+			return err
+		}
+		if sec.CertIsExpired(cert) {
+			return fmt.Errorf("certificate is expired: %s", cert.Name())
+		}
+
+		valid, err := signer.ValidateSignature(sig, sigCovered, cert)
+		if err != nil {
+			return err
+		}
+		if !valid {
+			return fmt.Errorf("signature is not valid for %s", cert.Name())
+		}
+
+		// The anchor is trusted without checking its own signature
+		if v.anchor.IsPrefix(cert.Name()) {
+			return nil
+		}
+		sig, sigCovered = cert.Signature(), certSigCovered
+	}
+	return errors.New("certificate chain does not end at the trust anchor")
+}
+
+// findCert returns the latest certificate in the keychain whose name starts with the key locator.
+func (v *cmdValidator) findCert(keyLocator enc.Name) (ndn.Data, enc.Wire, error) {
+	wire, _ := v.store.Get(keyLocator, true)
+	if wire == nil {
+		return nil, nil, fmt.Errorf("certificate not in keychain: %s", keyLocator)
+	}
+	return spec.Spec{}.ReadData(enc.NewBufferView(wire))
+}